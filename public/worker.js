@@ -0,0 +1,105 @@
+// Runs the wasm module off the main thread, see index.html and src/browser/Page.go.
+//
+// The page transfers its canvas and forwards its events and state, self.page stands in for what a worker lacks:
+// window events are dispatched on the worker global scope, document and canvas events on self.page.document and
+// self.page.canvasEvents.
+importScripts('assets/wasm_exec.js');
+
+self.addEventListener('message', (message) => {
+    const data = message.data;
+
+    switch (data.type) {
+        case 'init':
+            start(data);
+            break;
+        case 'event':
+            dispatch(data.target, data.event);
+            break;
+        case 'gamepads':
+            self.page.gamepads = data.gamepads;
+            break;
+    }
+});
+
+function start(init) {
+    const storage = new Map(Object.entries(init.storage));
+
+    self.page = {
+        canvas: init.canvas,
+        document: new EventTarget(),
+        canvasEvents: new EventTarget(),
+
+        search: init.search,
+        innerWidth: init.innerWidth,
+        innerHeight: init.innerHeight,
+        devicePixelRatio: init.devicePixelRatio,
+        hidden: init.hidden,
+        pointerLocked: false,
+        gamepads: [],
+
+        // the copy the page sent, writes are sent back for the page to save
+        localStorage: {
+            getItem: (key) => storage.has(key) ? storage.get(key) : null,
+            setItem: (key, value) => {
+                storage.set(key, String(value));
+                self.postMessage({type: 'setItem', key: key, value: String(value)});
+            },
+            removeItem: (key) => {
+                storage.delete(key);
+                self.postMessage({type: 'removeItem', key: key});
+            },
+        },
+    };
+
+    const go = new Go();
+
+    WebAssembly
+        .instantiateStreaming(fetch('assets/main.wasm'), go.importObject)
+        .then((result) => {
+            console.debug('wasm loaded successfully in worker');
+            go.run(result.instance).then(() => {
+                console.exception('wasm died unexpectedly');
+            });
+        });
+}
+
+// dispatch replays an event of the page, after updating the state it changes.
+function dispatch(target, properties) {
+    const page = self.page;
+    if (!page) {
+        return;
+    }
+
+    switch (properties.type) {
+        case 'resize':
+            page.innerWidth = properties.innerWidth;
+            page.innerHeight = properties.innerHeight;
+            page.devicePixelRatio = properties.devicePixelRatio;
+            break;
+        case 'visibilitychange':
+            page.hidden = properties.hidden;
+            break;
+        case 'pointerlockchange':
+            page.pointerLocked = properties.pointerLocked;
+            break;
+    }
+
+    const event = new Event(properties.type);
+    for (const [key, value] of Object.entries(properties)) {
+        if (!(key in event)) {
+            event[key] = value;
+        }
+    }
+
+    switch (target) {
+        case 'window':
+            self.dispatchEvent(event);
+            break;
+        case 'document':
+            page.document.dispatchEvent(event);
+            break;
+        case 'canvas':
+            page.canvasEvents.dispatchEvent(event);
+            break;
+    }
+}
@@ -1,29 +1,43 @@
+//go:build js && wasm
+// +build js,wasm
+
 package browser
 
 import (
+	"errors"
+	"fmt"
+	"github.com/DrSmithFr/go-webassembly/src/gfx"
 	"github.com/golang/freetype/truetype"
 	"github.com/llgcode/draw2d"
 	"github.com/llgcode/draw2d/draw2dimg"
 	"image"
+	"sync"
 	"syscall/js"
 )
 
-type RenderFunc func(gc *draw2dimg.GraphicContext) bool
+var _ Surface = (*Canvas2d)(nil)
 
 type Canvas2d struct {
-	done chan struct{} // Used as part of 'run forever' in the render handler
-
-	// DOM properties
+	// Lifecycle, mu guards it and reqID as they are used by both the animation frame callback and the page events
+	mu          sync.Mutex
+	lifecycle   lifecycle
+	rf          RenderFunc
+	renderFrame js.Func  // Animation frame callback, created once and released on Destroy
+	reqID       js.Value // Storage of the current annimationFrame requestID - For Cancel, undefined when none is pending
+
+	onBeforeUnload     js.Func
+	onVisibilityChange js.Func
+
+	// DOM properties, in a worker doc stands in for the page document
+	page   *Page
 	window js.Value
 	doc    js.Value
-	body   js.Value
 
 	// Canvas properties
-	canvas  js.Value
-	ctx     js.Value
-	imgData js.Value
-	width   int
-	height  int
+	canvas    js.Value
+	presenter presenter // Copies the shadow frame to the canvas, through WebGL when available
+	width     int
+	height    int
 
 	// Drawing Context
 	gctx     *draw2dimg.GraphicContext // Graphic Context
@@ -31,23 +45,62 @@ type Canvas2d struct {
 	font     *truetype.Font
 	fontData draw2d.FontData
 
-	reqID    js.Value // Storage of the current annimationFrame requestID - For Cancel
-	timeStep float64  // Min Time delay between frames. - Calculated as   maxFPS/1000
-
-	copybuff js.Value
+	limiter frameLimiter
+	dirty   dirtyRegion // Regions changed since the last copy to the canvas
+	stats   FrameStats
+
+	// Render resolution, the canvas is displayed at another size and upscaled by the browser
+	resolution    Resolution
+	dynamic       *DynamicResolution // Overrides resolution.Scale when set
+	displayWidth  int                // Size the canvas takes on the page, in CSS pixels
+	displayHeight int
+	viewport      Viewport
+
+	// Resizes wait for the next animation frame, so they never happen while rendering, and for events to settle
+	resizePending bool
+	resizeWidth   int
+	resizeHeight  int
+	resizeAt      float64 // Timestamp of the last resize request, in milliseconds
+
+	observer  js.Value // ResizeObserver watching wrapper, undefined when the window size is used
+	onObserve js.Func
+	wrapper   js.Value // Element around the canvas taking the size the page gives, the canvas is laid out in it
 }
 
+// Resizes are applied once no other was requested for this long, in milliseconds. The browser stretches the previous
+// frame meanwhile.
+const resizeDebounce = 100
+
 func NewCanvas2d(create bool) (*Canvas2d, error) {
 
 	var c Canvas2d
 
-	c.window = js.Global()
-	c.doc = c.window.Get("document")
-	c.body = c.doc.Get("body")
+	c.page = CurrentPage()
+	c.window = c.page.Window()
+	c.doc = c.page.Document()
+
+	c.renderFrame = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		c.frame(args[0].Float())
+		return nil
+	})
+
+	// Rendering stops with the page, and pauses while it is hidden. Browsers throttle animation frames of hidden
+	// pages anyway, resuming resets the frame timing so the time spent hidden isn't simulated at once.
+	c.onBeforeUnload = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		c.Stop()
+		return nil
+	})
+	c.onVisibilityChange = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		c.setHidden(c.page.Hidden())
+		return nil
+	})
+
+	c.window.Call("addEventListener", "beforeunload", c.onBeforeUnload)
+	c.doc.Call("addEventListener", "visibilitychange", c.onVisibilityChange)
 
 	// If create, make a canvas that fills the windows
 	if create {
-		c.Create(int(c.window.Get("innerWidth").Int()), int(c.window.Get("innerHeight").Int()))
+		c.Create(c.page.Size())
 	}
 
 	return &c, nil
@@ -55,18 +108,30 @@ func NewCanvas2d(create bool) (*Canvas2d, error) {
 
 // Create a new Canvas in the DOM, and append it to the Body.
 // This also calls Set to create relevant shadow Buffer etc
+// The canvas is displayed at width x height CSS pixels, frames are rendered at the resolution given to SetResolution.
+// In a worker, the OffscreenCanvas the page transferred is used instead.
 
 // TODO suspect this needs to be fleshed out with more options
 func (c *Canvas2d) Create(width int, height int) {
 
-	// Make the Canvas
-	canvas := c.doc.Call("createElement", "canvas")
+	// Make the Canvas, workers can't, the page made it
+	canvas := c.page.Canvas()
+	if !c.page.Worker() {
+		canvas = c.doc.Call("createElement", "canvas")
+	}
+
+	c.displayWidth = width
+	c.displayHeight = height
+	c.viewport = c.layout()
 
-	canvas.Set("height", height)
-	canvas.Set("width", width)
-	c.body.Call("appendChild", canvas)
+	canvas.Set("height", c.viewport.RenderHeight)
+	canvas.Set("width", c.viewport.RenderWidth)
+	if !c.page.Worker() {
+		c.doc.Get("body").Call("appendChild", canvas)
+	}
 
-	c.Set(canvas, width, height)
+	c.Set(canvas, c.viewport.RenderWidth, c.viewport.RenderHeight)
+	c.applyStyle()
 }
 
 // Used to setup with an existing Canvas element which was obtained from JS
@@ -75,60 +140,370 @@ func (c *Canvas2d) Set(canvas js.Value, width int, height int) {
 	c.height = height
 	c.width = width
 
-	// Setup the 2D Drawing context
-	c.ctx = c.canvas.Call("getContext", "2d")
-	c.imgData = c.ctx.Call("createImageData", width, height) // Note Width, then Height
+	if c.presenter != nil {
+		c.presenter.release()
+	}
+	c.presenter = c.newPresenter()
+
 	c.image = image.NewRGBA(image.Rect(0, 0, width, height))
-	c.copybuff = js.Global().Get("Uint8Array").New(len(c.image.Pix)) // Static JS buffer for copying data out to JS. Defined once and re-used to save on un-needed allocations
+	c.dirty.reset(c.image.Bounds())
 
-	c.gctx = draw2dimg.NewGraphicContext(c.image)
+	// init graphic context, with font
+	c.gctx, c.font = newGraphicContext(c.image)
+	c.fontData = defaultFontData
+}
 
-	// init font
-	c.font, _ = truetype.Parse(FontData["font.ttf"])
+// SetSize sets the render resolution. It takes effect on an upcoming animation frame, once resize requests settled,
+// or right away when the canvas isn't running.
+func (c *Canvas2d) SetSize(width int, height int) {
+	c.mu.Lock()
+	c.resizePending = true
+	c.resizeWidth = width
+	c.resizeHeight = height
+	c.resizeAt = c.now()
+	running := c.lifecycle.state == StateRunning
+	c.mu.Unlock()
+
+	if !running {
+		c.applySize(true)
+	}
+}
 
-	c.fontData = draw2d.FontData{
-		Name:   "roboto",
-		Family: draw2d.FontFamilySans,
-		Style:  draw2d.FontStyleNormal,
+// applySize resizes the frame if a resize is pending and settled, or whatever the time when force is set.
+// It must not be called while rendering.
+func (c *Canvas2d) applySize(force bool) {
+	c.mu.Lock()
+	due := c.resizePending && (force || c.now()-c.resizeAt >= resizeDebounce)
+	width, height := c.resizeWidth, c.resizeHeight
+	if due {
+		c.resizePending = false
 	}
-	fontCache := &FontCache{}
-	fontCache.Store(c.fontData, c.font)
+	c.mu.Unlock()
 
-	c.gctx.FontCache = fontCache
-}
+	if !due || c.image == nil || (width == c.width && height == c.height) {
+		return
+	}
 
-func (c *Canvas2d) SetSize(width int, height int) {
-	c.height = height
 	c.width = width
+	c.height = height
 
+	// Setting the size clears the canvas, the whole frame is presented again
 	c.canvas.Set("height", height)
 	c.canvas.Set("width", width)
 
-	// Setup the 2D Drawing context
-	c.ctx = c.canvas.Call("getContext", "2d")
-	c.imgData = c.ctx.Call("createImageData", width, height) // Note Width, then Height
-	c.image = image.NewRGBA(image.Rect(0, 0, width, height))
-	c.copybuff = js.Global().Get("Uint8Array").New(len(c.image.Pix)) // Static JS buffer for copying data out to JS. Defined once and re-used to save on un-needed allocations
+	// Buffers are reused when shrinking, the picture and the drawing state carry over
+	c.presenter.resize(width, height)
+	c.image = resizeImage(c.image, width, height)
+	c.gctx = resizeGraphicContext(c.gctx, c.image)
+	c.dirty.reset(c.image.Bounds())
+}
+
+// SetDisplaySize sets the size the canvas takes on the page in CSS pixels, e.g. the window size. The render resolution
+// follows.
+func (c *Canvas2d) SetDisplaySize(width int, height int) {
+	c.displayWidth = width
+	c.displayHeight = height
+	c.applyResolution()
+}
+
+// SetResolution sets the resolution frames are rendered at, see Resolution. A zero PixelRatio follows the
+// devicePixelRatio of the window.
+func (c *Canvas2d) SetResolution(r Resolution) {
+	c.resolution = r
+	c.applyResolution()
+}
+
+// SetDynamicResolution lowers the render scale while frames go over the budget of d, nil turns it off.
+func (c *Canvas2d) SetDynamicResolution(d *DynamicResolution) {
+	c.dynamic = d
+	c.applyResolution()
+}
+
+// Viewport returns the current render resolution, and where the canvas is displayed.
+func (c *Canvas2d) Viewport() Viewport {
+	return c.viewport
+}
+
+// layout computes the viewport for the current display size and settings.
+func (c *Canvas2d) layout() Viewport {
+	r := c.resolution
 
-	c.gctx = draw2dimg.NewGraphicContext(c.image)
+	if r.PixelRatio <= 0 {
+		r.PixelRatio = c.page.PixelRatio()
+	}
+
+	if c.dynamic != nil {
+		r.Scale = c.dynamic.Scale()
+	}
+
+	return r.Viewport(c.displayWidth, c.displayHeight)
+}
+
+// applyResolution resizes the frame when the render resolution changed, and moves the canvas on the page.
+func (c *Canvas2d) applyResolution() {
+	if c.canvas.IsUndefined() {
+		return // Not created yet, Create lays it out
+	}
+
+	c.viewport = c.layout()
+
+	c.SetSize(c.viewport.RenderWidth, c.viewport.RenderHeight)
+	c.applyStyle()
+}
+
+// applyStyle displays the canvas at the viewport position and size, upscaled without blurring pixels. With
+// ObserveResize the position is relative to the element around the canvas. An OffscreenCanvas has no style, the page
+// applies it.
+func (c *Canvas2d) applyStyle() {
+	if c.page.Worker() {
+		c.page.Post(map[string]interface{}{
+			"type":   "style",
+			"left":   c.viewport.X,
+			"top":    c.viewport.Y,
+			"width":  c.viewport.Width,
+			"height": c.viewport.Height,
+		})
+		return
+	}
+
+	style := c.canvas.Get("style")
+
+	// Unsupported values are ignored, older Firefox only knows crisp-edges
+	style.Set("imageRendering", "crisp-edges")
+	style.Set("imageRendering", "pixelated")
+
+	style.Set("position", "absolute")
+	style.Set("left", fmt.Sprintf("%gpx", c.viewport.X))
+	style.Set("top", fmt.Sprintf("%gpx", c.viewport.Y))
+	style.Set("width", fmt.Sprintf("%gpx", c.viewport.Width))
+	style.Set("height", fmt.Sprintf("%gpx", c.viewport.Height))
+}
+
+// ObserveResize follows the size the page layout gives to an element wrapped around the canvas, with a
+// ResizeObserver, instead of the sizes given to SetDisplaySize. The wrapper fills the parent of the canvas, which is
+// still sized and positioned in it from the Viewport, so integer scaling and aspect ratios are kept.
+// It returns false when the browser has no ResizeObserver, or in a worker where the page follows the window size.
+func (c *Canvas2d) ObserveResize() bool {
+	if c.page.Worker() {
+		return false
+	}
+
+	constructor := c.window.Get("ResizeObserver")
+	if constructor.IsUndefined() || !c.observer.IsUndefined() {
+		return !constructor.IsUndefined()
+	}
+
+	c.onObserve = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		entries := args[0]
+		if entries.Length() == 0 {
+			return nil
+		}
+
+		rect := entries.Index(entries.Length() - 1).Get("contentRect")
+		c.SetDisplaySize(rect.Get("width").Int(), rect.Get("height").Int())
+		return nil
+	})
+
+	// Observing the canvas itself would follow its own size, set from the viewport, the wrapper takes the place of
+	// the canvas in the page and fills it whatever the resolution
+	c.wrapper = c.doc.Call("createElement", "div")
+	style := c.wrapper.Get("style")
+	style.Set("position", "relative")
+	style.Set("overflow", "hidden")
+	style.Set("width", "100%")
+	style.Set("height", "100%")
+
+	if parent := c.canvas.Get("parentNode"); !parent.IsNull() {
+		parent.Call("replaceChild", c.wrapper, c.canvas)
+	}
+	c.wrapper.Call("appendChild", c.canvas)
+
+	c.observer = constructor.New(c.onObserve)
+	c.observer.Call("observe", c.wrapper)
+
+	return true
+}
+
+// newPresenter uses WebGL when the browser supports it, and falls back to the 2D context otherwise.
+func (c *Canvas2d) newPresenter() presenter {
+	webgl, err := newWebGLPresenter(c.canvas, c.width, c.height)
+	if err == nil {
+		return webgl
+	}
+
+	js.Global().Get("console").Call("warn", err.Error()+", falling back to the 2D context")
+
+	if err != errWebGLUnsupported && !c.page.Worker() {
+		// The canvas holds a broken WebGL context and can't provide a 2D one anymore, swap it for a fresh copy
+		fresh := c.canvas.Call("cloneNode", false)
+		if parent := c.canvas.Get("parentNode"); !parent.IsNull() {
+			parent.Call("replaceChild", fresh, c.canvas)
+		}
+		c.canvas = fresh
+	}
+
+	canvas2d, err := newCanvas2dPresenter(c.canvas, c.width, c.height)
+	if err != nil {
+		// An OffscreenCanvas can't be swapped, it keeps its broken WebGL context
+		js.Global().Get("console").Call("error", err.Error()+", frames won't be displayed")
+		return noPresenter{}
+	}
+
+	return canvas2d
+}
+
+// Backend tells how frames reach the canvas, "webgl", "2d", or "none" when the canvas provided no context.
+func (c *Canvas2d) Backend() string {
+	return c.presenter.name()
 }
 
 // Starts the annimationFrame callbacks running.   (Recently seperated from Create / Set to give better control for when things start / stop)
+// Starting a running canvas only replaces its render function.
 func (c *Canvas2d) Start(maxFPS float64, rf RenderFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.lifecycle.state == StateDestroyed {
+		return
+	}
+
 	c.SetFPS(maxFPS)
-	c.initFrameUpdate(rf)
+	c.rf = rf
+
+	if c.lifecycle.start() {
+		if c.image != nil {
+			c.dirty.reset(c.image.Bounds())
+		}
+		c.stats.Reset()
+		c.limiter.reset()
+		c.schedule()
+	}
+}
+
+// Pause stops rendering, keeping the render function for Resume.
+func (c *Canvas2d) Pause() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.lifecycle.pause() {
+		c.cancel()
+	}
 }
 
-// This needs to be called on an 'beforeUnload' trigger, to properly close out the render callback, and prevent browser errors on page Refresh
+// Resume renders again after Pause. The first frame after resuming gets no elapsed time.
+func (c *Canvas2d) Resume() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.lifecycle.resume() {
+		c.limiter.reset()
+		c.schedule()
+	}
+}
+
+// Stop renders no more frames and forgets the render function, Start may be called again.
+// It is called on 'beforeunload', to close out the render callback and prevent browser errors on page refresh.
 func (c *Canvas2d) Stop() {
-	c.window.Call("cancelAnimationFrame", c.reqID)
-	c.done <- struct{}{}
-	close(c.done)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rf = nil
+	if c.lifecycle.stop() {
+		c.cancel()
+	}
+}
+
+// Destroy stops rendering, releases the callbacks and removes the canvas from the page. The canvas can't be used
+// anymore. In a worker, the page is asked to remove it.
+func (c *Canvas2d) Destroy() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rf = nil
+	if c.lifecycle.stop() {
+		c.cancel()
+	}
+
+	if !c.lifecycle.destroy() {
+		return
+	}
+
+	c.window.Call("removeEventListener", "beforeunload", c.onBeforeUnload)
+	c.doc.Call("removeEventListener", "visibilitychange", c.onVisibilityChange)
+	c.onBeforeUnload.Release()
+	c.onVisibilityChange.Release()
+	c.renderFrame.Release()
+
+	if !c.observer.IsUndefined() {
+		c.observer.Call("disconnect")
+		c.onObserve.Release()
+	}
+
+	if c.presenter != nil {
+		c.presenter.release()
+	}
+
+	if c.page.Worker() {
+		c.page.Post(map[string]interface{}{"type": "remove"})
+	} else if !c.wrapper.IsUndefined() {
+		c.wrapper.Call("remove")
+	} else if !c.canvas.IsUndefined() {
+		c.canvas.Call("remove")
+	}
+}
+
+// State returns where the canvas is in its lifecycle.
+func (c *Canvas2d) State() State {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lifecycle.state
+}
+
+// setHidden pauses a running canvas while the page is hidden.
+func (c *Canvas2d) setHidden(hidden bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if hidden && c.lifecycle.hide() {
+		c.cancel()
+	}
+
+	if !hidden && c.lifecycle.show() {
+		c.limiter.reset()
+		c.schedule()
+	}
+}
+
+// schedule requests the next animation frame, unless one is pending. mu must be held.
+func (c *Canvas2d) schedule() {
+	if c.reqID.IsUndefined() {
+		c.reqID = c.window.Call("requestAnimationFrame", c.renderFrame) // Captures the requestID to be used in Close / Cancel
+	}
+}
+
+// cancel drops the pending animation frame. mu must be held.
+func (c *Canvas2d) cancel() {
+	if !c.reqID.IsUndefined() {
+		c.window.Call("cancelAnimationFrame", c.reqID)
+		c.reqID = js.Undefined()
+	}
 }
 
 // Sets the maximum FPS (Frames per Second).  This can be changed on the fly and will take affect next frame.
 func (c *Canvas2d) SetFPS(maxFPS float64) {
-	c.timeStep = 1000 / maxFPS
+	c.limiter.setFPS(maxFPS)
+}
+
+// Invalidate marks r as changed by the render function. When a render function invalidates regions, only those are
+// copied to the canvas, which saves a lot when a small part of the screen changes.
+func (c *Canvas2d) Invalidate(r image.Rectangle) {
+	c.dirty.add(r)
+}
+
+// Stats returns the timings of the last rendered frames.
+func (c *Canvas2d) Stats() *FrameStats {
+	return &c.stats
 }
 
 // Get the Drawing context for the Canvas
@@ -136,6 +511,21 @@ func (c *Canvas2d) Gc() *draw2dimg.GraphicContext {
 	return c.gctx
 }
 
+// Get the canvas DOM element
+func (c *Canvas2d) Element() js.Value {
+	return c.canvas
+}
+
+// Get the shadow frame the Graphic Context draws on, for direct pixel access
+func (c *Canvas2d) Image() *image.RGBA {
+	return c.image
+}
+
+// Get direct pixel access to the shadow frame, until the next resize
+func (c *Canvas2d) Framebuffer() gfx.Framebuffer {
+	return gfx.NewFramebuffer(c.image)
+}
+
 func (c *Canvas2d) Height() int {
 	return c.height
 }
@@ -145,41 +535,140 @@ func (c *Canvas2d) Width() int {
 }
 
 // handles calls from Render, and copies the image over.
-func (c *Canvas2d) initFrameUpdate(rf RenderFunc) {
-	// Hold the callbacks without blocking
-	go func() {
-		var renderFrame js.Func
-		var lastTimestamp float64
-
-		renderFrame = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
-
-			timestamp := args[0].Float()
-			if timestamp-lastTimestamp >= c.timeStep { // Constrain FPS
-				if rf != nil { // If required, call the requested render function, before copying the frame
-					if rf(c.gctx) { // Only copy the image back if RenderFunction returns TRUE. (i.e. stuff has changed.)  This allows Render to return false, saving time this cycle if nothing changed.  (Keep frame as before)
-						c.imgCopy()
-					}
-				} else { // Just do the copy, rendering must be being done elsewhere
-					c.imgCopy()
-				}
-				lastTimestamp = timestamp
+// The lock isn't held while rendering, so the render function may pause or stop the canvas.
+func (c *Canvas2d) frame(timestamp float64) {
+	c.mu.Lock()
+	c.reqID = js.Undefined() // This one is being handled
+	running := c.lifecycle.state == StateRunning
+	rf := c.rf
+	c.mu.Unlock()
+
+	if !running {
+		return
+	}
+
+	c.applySize(false)
+
+	if elapsed, ok := c.limiter.due(timestamp); ok { // Constrain FPS
+		timing := FrameTiming{Interval: elapsed * 1000}
+		start := c.now()
+
+		if rf != nil { // If required, call the requested render function, before copying the frame
+			changed := rf(c.gctx, elapsed)
+			timing.Render = c.now() - start
+
+			if changed { // Only copy the image back if RenderFunction returns TRUE. (i.e. stuff has changed.)  This allows Render to return false, saving time this cycle if nothing changed.  (Keep frame as before)
+				c.imgCopy(&timing)
 			}
+		} else { // Just do the copy, rendering must be being done elsewhere
+			c.imgCopy(&timing)
+		}
 
-			c.reqID = js.Global().Call("requestAnimationFrame", renderFrame) // Captures the requestID to be used in Close / Cancel
-			return nil
-		})
-		defer renderFrame.Release()
-		js.Global().Call("requestAnimationFrame", renderFrame)
-		<-c.done
-	}()
+		c.stats.Record(timing)
+
+		// Time spent in Go, waiting for the display isn't part of it
+		if c.dynamic != nil {
+			if _, changed := c.dynamic.Observe(timing.Total()); changed {
+				c.applyResolution()
+			}
+		}
+	} else {
+		c.stats.Skip()
+	}
+
+	c.mu.Lock()
+	if c.lifecycle.state == StateRunning {
+		c.schedule()
+	}
+	c.mu.Unlock()
 }
 
 // Does the actuall copy over of the image data for the 'render' call.
-func (c *Canvas2d) imgCopy() {
-	// TODO:  This currently does multiple data copies.   go image buffer -> JS Uint8Array,   Then JS Uint8Array -> ImageData,  then ImageData into the Canvas.
-	// Would like to eliminate at least one of them, however currently CopyBytesToJS only supports Uint8Array  rather than the Uint8ClampedArray of ImageData.
+func (c *Canvas2d) imgCopy(timing *FrameTiming) {
+	rects := c.dirty.take()
+
+	start := c.now()
+	c.presenter.copy(c.image, rects)
+	copied := c.now()
+	c.presenter.present(rects)
+
+	timing.Copy = copied - start
+	timing.Present = c.now() - copied
+	timing.Presented = true
+}
+
+// now returns a high resolution timestamp, in milliseconds.
+func (c *Canvas2d) now() float64 {
+	return c.window.Get("performance").Call("now").Float()
+}
+
+// canvas2dPresenter copies frames with putImageData, when WebGL isn't available.
+type canvas2dPresenter struct {
+	ctx     js.Value
+	imgData js.Value
+	pixels  js.Value // Uint8Array view over the ImageData buffer, so frames are copied straight into it
+	buffer  js.Value // ArrayBuffer behind the ImageData, kept when shrinking
+}
 
-	js.CopyBytesToJS(c.copybuff, c.image.Pix)
-	c.imgData.Get("data").Call("set", c.copybuff)
-	c.ctx.Call("putImageData", c.imgData, 0, 0)
+func newCanvas2dPresenter(canvas js.Value, width int, height int) (*canvas2dPresenter, error) {
+	ctx := canvas.Call("getContext", "2d")
+	if ctx.IsNull() || ctx.IsUndefined() {
+		return nil, errors.New("2d: no context, the canvas holds another one")
+	}
+
+	p := &canvas2dPresenter{ctx: ctx}
+	p.resize(width, height)
+
+	return p, nil
+}
+
+func (p *canvas2dPresenter) name() string {
+	return "2d"
+}
+
+func (p *canvas2dPresenter) resize(width int, height int) {
+	size := width * height * 4
+
+	if p.buffer.IsUndefined() || p.buffer.Get("byteLength").Int() < size {
+		p.buffer = js.Global().Get("ArrayBuffer").New(size)
+	}
+
+	// CopyBytesToJS only accepts a Uint8Array, while ImageData holds a Uint8ClampedArray. Both can view the same
+	// ArrayBuffer, which saves copying every frame to a staging Uint8Array first.
+	data := js.Global().Get("Uint8ClampedArray").New(p.buffer, 0, size)
+	p.imgData = js.Global().Get("ImageData").New(data, width, height) // Note Width, then Height
+	p.pixels = js.Global().Get("Uint8Array").New(p.buffer, 0, size)
 }
+
+// copy copies the dirty regions once, into the ImageData.
+func (p *canvas2dPresenter) copy(img *image.RGBA, rects []image.Rectangle) {
+	for _, r := range rects {
+		if r.Empty() {
+			continue
+		}
+
+		// Copy whole rows, a single call is much faster than one per row of the rectangle
+		start, end := img.PixOffset(0, r.Min.Y), img.PixOffset(0, r.Max.Y)
+		js.CopyBytesToJS(p.pixels.Call("subarray", start, end), img.Pix[start:end])
+	}
+}
+
+// present lets the browser draw the dirty regions of the ImageData.
+func (p *canvas2dPresenter) present(rects []image.Rectangle) {
+	for _, r := range rects {
+		if !r.Empty() {
+			p.ctx.Call("putImageData", p.imgData, 0, 0, r.Min.X, r.Min.Y, r.Dx(), r.Dy())
+		}
+	}
+}
+
+func (p *canvas2dPresenter) release() {}
+
+// noPresenter drops frames, for a canvas which provided neither a WebGL nor a 2D context.
+type noPresenter struct{}
+
+func (noPresenter) name() string                                  { return "none" }
+func (noPresenter) resize(width int, height int)                  {}
+func (noPresenter) copy(img *image.RGBA, rects []image.Rectangle) {}
+func (noPresenter) present(rects []image.Rectangle)               {}
+func (noPresenter) release()                                      {}
@@ -1,19 +1,26 @@
+//go:build js && wasm
+// +build js,wasm
+
 package browser
 
 import (
 	"syscall/js"
 )
 
+// LoadDOM works on the page main thread as well as in a worker, where Document stands in for the page document and
+// Body is undefined, see Page.
 func LoadDOM() *DOM {
 	// loading default DOM data
-	window := js.Global()
-	document := window.Get("document")
+	page := CurrentPage()
+	window := page.Window()
+	document := page.Document()
 	body := document.Get("body")
 
 	// loading DOM size data
+	width, height := page.Size()
 	size := Size{
-		Height: window.Get("innerHeight").Float(),
-		Width:  window.Get("innerWidth").Float(),
+		Height: float64(height),
+		Width:  float64(width),
 	}
 
 	// returning DOM
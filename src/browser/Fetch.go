@@ -0,0 +1,67 @@
+//go:build js && wasm
+// +build js,wasm
+
+package browser
+
+import (
+	"fmt"
+	"syscall/js"
+)
+
+// Fetch downloads url and returns the response body.
+// It blocks until the request completes, so it must not be called from a JS callback.
+func (dom *DOM) Fetch(url string) ([]byte, error) {
+	type result struct {
+		data []byte
+		err  error
+	}
+
+	done := make(chan result, 1)
+
+	var onResponse, onBody, onError js.Func
+
+	onResponse = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		response := args[0]
+
+		if !response.Get("ok").Bool() {
+			done <- result{err: fmt.Errorf("fetch %s: %d %s", url, response.Get("status").Int(), response.Get("statusText").String())}
+			return nil
+		}
+
+		return response.Call("arrayBuffer").Call("then", onBody)
+	})
+
+	onBody = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		buffer := js.Global().Get("Uint8Array").New(args[0])
+		data := make([]byte, buffer.Get("length").Int())
+		js.CopyBytesToGo(data, buffer)
+
+		done <- result{data: data}
+		return nil
+	})
+
+	onError = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		done <- result{err: fmt.Errorf("fetch %s: %s", url, args[0].Call("toString").String())}
+		return nil
+	})
+
+	defer onResponse.Release()
+	defer onBody.Release()
+	defer onError.Release()
+
+	dom.Window.Call("fetch", url).Call("then", onResponse).Call("catch", onError)
+
+	r := <-done
+	return r.data, r.err
+}
+
+// QueryParam returns a parameter of the page URL, or fallback when missing.
+func (dom *DOM) QueryParam(name string, fallback string) string {
+	params := js.Global().Get("URLSearchParams").New(CurrentPage().Search())
+
+	if !params.Call("has", name).Bool() {
+		return fallback
+	}
+
+	return params.Call("get", name).String()
+}
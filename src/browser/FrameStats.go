@@ -0,0 +1,168 @@
+package browser
+
+import (
+	"math"
+	"sort"
+)
+
+// Frames kept by FrameStats, about two seconds at 60 FPS
+const frameStatsWindow = 120
+
+// FrameTiming is how long the steps of a rendered frame took, in milliseconds.
+type FrameTiming struct {
+	Interval  float64 // Since the previous rendered frame
+	Render    float64 // In the render function
+	Copy      float64 // Copying the frame to JS memory
+	Present   float64 // Handing the frame to the canvas
+	Skipped   int     // Animation frames skipped before this one to honour the max FPS
+	Presented bool    // False when the render function reported nothing changed
+}
+
+// Total is the time spent in Go on the frame.
+func (t FrameTiming) Total() float64 {
+	return t.Render + t.Copy + t.Present
+}
+
+// Metric is a duration of FrameTiming.
+type Metric int
+
+const (
+	MetricInterval Metric = iota
+	MetricRender
+	MetricCopy
+	MetricPresent
+	MetricTotal
+)
+
+func (m Metric) of(t FrameTiming) float64 {
+	switch m {
+	case MetricInterval:
+		return t.Interval
+	case MetricRender:
+		return t.Render
+	case MetricCopy:
+		return t.Copy
+	case MetricPresent:
+		return t.Present
+	}
+
+	return t.Total()
+}
+
+// FrameStats keeps the timings of the last rendered frames, to compute rolling averages and percentiles.
+type FrameStats struct {
+	timings [frameStatsWindow]FrameTiming // Ring buffer
+	next    int                           // Index the next timing is written at
+	count   int                           // Number of timings in the buffer
+	skipped int                           // Animation frames skipped since the last rendered one
+	frames  uint64                        // Frames rendered since the stats were created
+
+	sorted []float64 // Reused to compute percentiles
+}
+
+// Skip records an animation frame skipped to honour the max FPS.
+func (s *FrameStats) Skip() {
+	s.skipped++
+}
+
+// Record adds the timing of a rendered frame, the skipped frames recorded since the previous one are attached to it.
+func (s *FrameStats) Record(t FrameTiming) {
+	t.Skipped += s.skipped
+	s.skipped = 0
+
+	s.timings[s.next] = t
+	s.next = (s.next + 1) % frameStatsWindow
+
+	if s.count < frameStatsWindow {
+		s.count++
+	}
+
+	s.frames++
+}
+
+// Reset forgets every timing, e.g. when rendering starts again after a pause.
+func (s *FrameStats) Reset() {
+	*s = FrameStats{sorted: s.sorted}
+}
+
+// Len returns the number of frames the statistics are computed on.
+func (s *FrameStats) Len() int {
+	return s.count
+}
+
+// Frames returns the number of frames rendered since the stats were created.
+func (s *FrameStats) Frames() uint64 {
+	return s.frames
+}
+
+// Timing returns the timing of the i-th kept frame, 0 being the oldest.
+func (s *FrameStats) Timing(i int) FrameTiming {
+	return s.timings[(s.next-s.count+i+frameStatsWindow)%frameStatsWindow]
+}
+
+// Last returns the timing of the last rendered frame.
+func (s *FrameStats) Last() FrameTiming {
+	if s.count == 0 {
+		return FrameTiming{}
+	}
+
+	return s.Timing(s.count - 1)
+}
+
+// Average returns the mean of m over the kept frames.
+func (s *FrameStats) Average(m Metric) float64 {
+	if s.count == 0 {
+		return 0
+	}
+
+	sum := 0.0
+	for i := 0; i < s.count; i++ {
+		sum += m.of(s.Timing(i))
+	}
+
+	return sum / float64(s.count)
+}
+
+// Percentile returns the value of m that p percent of the kept frames don't exceed, p going from 0 to 100.
+func (s *FrameStats) Percentile(m Metric, p float64) float64 {
+	if s.count == 0 {
+		return 0
+	}
+
+	s.sorted = s.sorted[:0]
+	for i := 0; i < s.count; i++ {
+		s.sorted = append(s.sorted, m.of(s.Timing(i)))
+	}
+	sort.Float64s(s.sorted)
+
+	// nearest rank
+	rank := int(math.Ceil(p/100*float64(s.count))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= s.count {
+		rank = s.count - 1
+	}
+
+	return s.sorted[rank]
+}
+
+// FPS returns the number of frames rendered per second over the kept frames.
+func (s *FrameStats) FPS() float64 {
+	interval := s.Average(MetricInterval)
+	if interval <= 0 {
+		return 0
+	}
+
+	return 1000 / interval
+}
+
+// Skipped returns the number of animation frames skipped over the kept frames.
+func (s *FrameStats) Skipped() int {
+	skipped := 0
+	for i := 0; i < s.count; i++ {
+		skipped += s.Timing(i).Skipped
+	}
+
+	return skipped
+}
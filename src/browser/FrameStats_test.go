@@ -0,0 +1,101 @@
+package browser
+
+import (
+	"github.com/DrSmithFr/go-webassembly/src/internal/golden"
+	"github.com/llgcode/draw2d/draw2dimg"
+	"image/color"
+	"math"
+	"testing"
+)
+
+func TestFrameStats(t *testing.T) {
+	var s FrameStats
+
+	if s.FPS() != 0 || s.Average(MetricTotal) != 0 || s.Percentile(MetricTotal, 95) != 0 {
+		t.Errorf("empty stats should be zero")
+	}
+
+	// more frames than kept, the first ones are forgotten
+	for i := 1; i <= frameStatsWindow+20; i++ {
+		if i%4 == 0 {
+			s.Skip()
+		}
+
+		s.Record(FrameTiming{Interval: 20, Render: float64(i), Copy: 1, Present: 0.5})
+	}
+
+	if s.Len() != frameStatsWindow || s.Frames() != frameStatsWindow+20 {
+		t.Fatalf("Len() = %d, Frames() = %d", s.Len(), s.Frames())
+	}
+
+	if s.Timing(0).Render != 21 || s.Last().Render != frameStatsWindow+20 {
+		t.Errorf("oldest frame render = %f, last = %f, expected 21 and %d", s.Timing(0).Render, s.Last().Render, frameStatsWindow+20)
+	}
+
+	if s.FPS() != 50 {
+		t.Errorf("FPS() = %f, expected 50", s.FPS())
+	}
+
+	// renders of 21 to 140 ms
+	if avg := s.Average(MetricRender); math.Abs(avg-80.5) > 1e-9 {
+		t.Errorf("average render = %f, expected 80.5", avg)
+	}
+
+	if total := s.Average(MetricTotal); math.Abs(total-82) > 1e-9 {
+		t.Errorf("average total = %f, expected 82", total)
+	}
+
+	for p, expected := range map[float64]float64{0: 21, 50: 80, 95: 134, 100: 140} {
+		if got := s.Percentile(MetricRender, p); got != expected {
+			t.Errorf("render p%g = %f, expected %f", p, got, expected)
+		}
+	}
+
+	if s.Skipped() != frameStatsWindow/4 {
+		t.Errorf("Skipped() = %d, expected %d", s.Skipped(), frameStatsWindow/4)
+	}
+
+	s.Reset()
+	if s.Len() != 0 || s.Frames() != 0 || s.Skipped() != 0 {
+		t.Errorf("Reset() kept frames")
+	}
+}
+
+func TestHeadlessCanvasRecordsFrameStats(t *testing.T) {
+	c := NewHeadlessCanvas(4, 4)
+	c.Start(30, func(gc *draw2dimg.GraphicContext, dt float64) bool {
+		return true
+	})
+
+	// every other frame is skipped, the last skipped one is only attached to the next rendered frame
+	c.Run(1000, 60)
+
+	if s := c.Stats(); s.Len() != 30 || s.Skipped() != 29 || math.Abs(s.Percentile(MetricInterval, 50)-1000.0/30) > 1e-9 {
+		t.Errorf("recorded %d frames, %d skipped, median interval %f", s.Len(), s.Skipped(), s.Percentile(MetricInterval, 50))
+	}
+}
+
+func TestStatsOverlayGolden(t *testing.T) {
+	var s FrameStats
+
+	// steady frames with a few spikes over budget
+	for i := 0; i < frameStatsWindow; i++ {
+		render := 6 + float64(i%7)
+		if i%40 == 39 {
+			render = 24
+		}
+
+		s.Record(FrameTiming{Interval: 1000.0 / 60, Render: render, Copy: 1.5, Present: 0.5})
+	}
+
+	overlay := NewStatsOverlay(&s, 1000.0/60)
+	width, height := overlay.Size()
+
+	c := NewHeadlessCanvas(int(width)+20, int(height)+20)
+	c.Gc().SetFillColor(color.RGBA{0x40, 0x40, 0x80, 0xff})
+	c.Gc().Clear()
+
+	overlay.Draw(c.Gc(), 10, 10)
+
+	golden.Assert(t, "stats_overlay", c.Image(), golden.Default)
+}
@@ -0,0 +1,72 @@
+package browser
+
+import (
+	"github.com/llgcode/draw2d/draw2dimg"
+)
+
+// UpdateFunc advances the simulation by one fixed tick of dt seconds.
+type UpdateFunc func(dt float64)
+
+// DrawFunc draws a frame. alpha, from 0 to 1, tells how far the frame is between the previous tick and the last one,
+// so moving things can be interpolated. It returns true when the frame changed, like RenderFunc.
+type DrawFunc func(gc *draw2dimg.GraphicContext, alpha float64) bool
+
+// Most ticks simulated for a single frame. When the browser stalls for longer, the simulation slows down instead of
+// spending every next frame catching up.
+const maxTicksPerFrame = 10
+
+// GameLoop runs the simulation at a fixed rate, whatever the rate frames are rendered at.
+// Elapsed time is accumulated on each frame and consumed by steps of exactly one tick, so the simulation is
+// deterministic and can be replayed from the same inputs.
+type GameLoop struct {
+	update UpdateFunc
+	draw   DrawFunc
+
+	tick        float64 // Duration of a tick, in seconds
+	accumulator float64 // Elapsed time not simulated yet, in seconds
+	ticks       uint64  // Number of ticks simulated since the loop was created
+}
+
+// NewGameLoop creates a loop calling update tickRate times per second of elapsed time.
+func NewGameLoop(tickRate float64, update UpdateFunc, draw DrawFunc) *GameLoop {
+	return &GameLoop{
+		update: update,
+		draw:   draw,
+		tick:   1 / tickRate,
+	}
+}
+
+// Frame runs the ticks due after elapsed seconds then draws. It is a RenderFunc, to be given to Canvas2d.Start.
+func (l *GameLoop) Frame(gc *draw2dimg.GraphicContext, elapsed float64) bool {
+	l.accumulator += elapsed
+
+	for steps := 0; l.accumulator >= l.tick; steps++ {
+		if steps == maxTicksPerFrame { // Give up on catching up
+			l.accumulator = 0
+			break
+		}
+
+		if l.update != nil {
+			l.update(l.tick)
+		}
+
+		l.accumulator -= l.tick
+		l.ticks++
+	}
+
+	if l.draw == nil {
+		return true
+	}
+
+	return l.draw(gc, l.accumulator/l.tick)
+}
+
+// Ticks returns the number of ticks simulated so far.
+func (l *GameLoop) Ticks() uint64 {
+	return l.ticks
+}
+
+// TickRate returns the number of ticks per second.
+func (l *GameLoop) TickRate() float64 {
+	return 1 / l.tick
+}
@@ -0,0 +1,63 @@
+package browser
+
+import (
+	"github.com/llgcode/draw2d/draw2dimg"
+	"math"
+	"testing"
+)
+
+func TestGameLoopTicksAtFixedRate(t *testing.T) {
+	for _, refreshRate := range []float64{30, 60, 144} {
+		var ticks []float64
+		var alphas []float64
+		var elapsed float64
+
+		loop := NewGameLoop(60, func(dt float64) {
+			ticks = append(ticks, dt)
+		}, func(gc *draw2dimg.GraphicContext, alpha float64) bool {
+			alphas = append(alphas, alpha)
+			return true
+		})
+
+		c := NewHeadlessCanvas(1, 1)
+		c.Start(1000, func(gc *draw2dimg.GraphicContext, dt float64) bool {
+			elapsed += dt
+			return loop.Frame(gc, dt)
+		})
+		c.Run(10000, refreshRate)
+
+		// 60 ticks per second of frames whatever the display, give or take the tick being accumulated
+		if expected := elapsed * 60; math.Abs(float64(len(ticks))-expected) > 1 {
+			t.Errorf("%gHz: %d ticks in %fs, expected %f", refreshRate, len(ticks), elapsed, expected)
+		}
+
+		for _, dt := range ticks {
+			if dt != 1.0/60 {
+				t.Fatalf("%gHz: tick of %fs, expected 1/60s", refreshRate, dt)
+			}
+		}
+
+		for _, alpha := range alphas {
+			if alpha < 0 || alpha >= 1 {
+				t.Fatalf("%gHz: alpha %f out of [0, 1)", refreshRate, alpha)
+			}
+		}
+	}
+}
+
+func TestGameLoopDoesNotSpiral(t *testing.T) {
+	ticks := 0
+	loop := NewGameLoop(60, func(dt float64) { ticks++ }, nil)
+
+	// the tab was in background for a minute
+	loop.Frame(nil, 60)
+
+	if ticks != maxTicksPerFrame {
+		t.Errorf("%d ticks after a long stall, expected %d", ticks, maxTicksPerFrame)
+	}
+
+	loop.Frame(nil, 1.0/60)
+	if ticks != maxTicksPerFrame+1 || loop.Ticks() != uint64(ticks) {
+		t.Errorf("expected the loop to resume at normal pace, %d ticks", ticks)
+	}
+}
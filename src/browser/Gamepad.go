@@ -0,0 +1,145 @@
+package browser
+
+import (
+	"math"
+)
+
+// Buttons of the standard gamepad layout, see https://w3c.github.io/gamepad/#remapping
+const (
+	ButtonA = iota // Bottom face button
+	ButtonB        // Right face button
+	ButtonX        // Left face button
+	ButtonY        // Top face button
+	ButtonLeftBumper
+	ButtonRightBumper
+	ButtonLeftTrigger
+	ButtonRightTrigger
+	ButtonSelect
+	ButtonStart
+	ButtonLeftStick
+	ButtonRightStick
+	ButtonDPadUp
+	ButtonDPadDown
+	ButtonDPadLeft
+	ButtonDPadRight
+	ButtonHome
+)
+
+// Axes of the standard gamepad layout, -1 being left or up.
+const (
+	AxisLeftX = iota
+	AxisLeftY
+	AxisRightX
+	AxisRightY
+)
+
+// Default radial dead zone, as a fraction of the full stick tilt.
+const DefaultDeadZone = 0.15
+
+// Stick is the position of an analog stick, each axis from -1 (left, up) to 1 (right, down).
+type Stick struct {
+	X float64
+	Y float64
+}
+
+// Gamepad reads the first connected gamepad and maps its buttons to actions, like InputMap does for keys.
+type Gamepad struct {
+	DeadZone float64 // Stick tilt ignored around the center, from 0 to 1
+
+	bindings map[int]Action
+
+	index     int // Index of the gamepad in navigator.getGamepads(), -1 when none
+	connected bool
+	axes      []float64
+	buttons   []float64 // Button values, from 0 to 1 for analog triggers
+}
+
+// NewGamepad creates a gamepad source mapping standard layout button indexes to actions.
+func NewGamepad(bindings map[int]Action) *Gamepad {
+	copied := make(map[int]Action, len(bindings))
+	for button, action := range bindings {
+		copied[button] = action
+	}
+
+	return &Gamepad{
+		DeadZone: DefaultDeadZone,
+		bindings: copied,
+		index:    -1,
+	}
+}
+
+// Connected tells whether a gamepad was found by the last Poll.
+func (g *Gamepad) Connected() bool {
+	return g.connected
+}
+
+// LeftStick returns the left stick position, dead zone applied.
+func (g *Gamepad) LeftStick() Stick {
+	return g.stick(AxisLeftX, AxisLeftY)
+}
+
+// RightStick returns the right stick position, dead zone applied.
+func (g *Gamepad) RightStick() Stick {
+	return g.stick(AxisRightX, AxisRightY)
+}
+
+// Pressed tells whether a button bound to action is held down.
+func (g *Gamepad) Pressed(action Action) bool {
+	return g.Value(action) > 0.5
+}
+
+// Value returns how far the buttons bound to action are pushed, from 0 to 1.
+func (g *Gamepad) Value(action Action) float64 {
+	value := 0.0
+
+	for button, a := range g.bindings {
+		if a == action && button < len(g.buttons) {
+			value = math.Max(value, g.buttons[button])
+		}
+	}
+
+	return value
+}
+
+// set stores the state read from the browser.
+func (g *Gamepad) set(connected bool, axes []float64, buttons []float64) {
+	g.connected = connected
+	g.axes = append(g.axes[:0], axes...)
+	g.buttons = append(g.buttons[:0], buttons...)
+}
+
+func (g *Gamepad) stick(axisX, axisY int) Stick {
+	if axisY >= len(g.axes) {
+		return Stick{}
+	}
+
+	x, y := applyDeadZone(g.axes[axisX], g.axes[axisY], g.DeadZone)
+
+	return Stick{X: x, Y: y}
+}
+
+// applyDeadZone ignores small tilts around the center, then rescales the rest so the output grows smoothly from 0 at
+// the dead zone edge to 0.5 at half tilt, and follows the tilt from there: a stick pushed halfway gives half speed.
+// Dead zones of half the range or more are rescaled up to full tilt instead. The zone is radial, so diagonals are not
+// favoured.
+func applyDeadZone(x, y, deadZone float64) (float64, float64) {
+	magnitude := math.Hypot(x, y)
+
+	if magnitude <= deadZone || deadZone >= 1 {
+		return 0, 0
+	}
+
+	scaled := math.Min(magnitude, 1)
+
+	switch {
+	case deadZone >= halfTilt:
+		scaled = math.Min((magnitude-deadZone)/(1-deadZone), 1)
+	case magnitude < halfTilt:
+		scaled = halfTilt * (magnitude - deadZone) / (halfTilt - deadZone)
+	}
+
+	return x / magnitude * scaled, y / magnitude * scaled
+}
+
+// halfTilt is the stick tilt, and speed, applyDeadZone leaves unchanged past the dead zone.
+const halfTilt = 0.5
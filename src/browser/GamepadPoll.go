@@ -0,0 +1,71 @@
+//go:build js && wasm
+// +build js,wasm
+
+package browser
+
+import (
+	"syscall/js"
+)
+
+// Poll reads the current state of the gamepad. Browsers only refresh gamepads when asked, so it must be called every
+// frame.
+func (g *Gamepad) Poll() {
+	pads := CurrentPage().Gamepads()
+	if !pads.Truthy() {
+		g.set(false, nil, nil)
+		return
+	}
+
+	pad := g.pick(pads)
+
+	if !pad.Truthy() {
+		g.index = -1
+		g.set(false, nil, nil)
+		return
+	}
+
+	jsAxes := pad.Get("axes")
+	axes := make([]float64, jsAxes.Length())
+	for i := range axes {
+		axes[i] = jsAxes.Index(i).Float()
+	}
+
+	jsButtons := pad.Get("buttons")
+	buttons := make([]float64, jsButtons.Length())
+	for i := range buttons {
+		buttons[i] = jsButtons.Index(i).Get("value").Float()
+	}
+
+	g.set(true, axes, buttons)
+}
+
+// pick keeps using the same gamepad while it is connected, otherwise takes the first one, preferring the standard
+// layout.
+func (g *Gamepad) pick(pads js.Value) js.Value {
+	if g.index >= 0 && g.index < pads.Length() {
+		if pad := pads.Index(g.index); pad.Truthy() && pad.Get("connected").Bool() {
+			return pad
+		}
+	}
+
+	fallback := js.Null()
+
+	for i := 0; i < pads.Length(); i++ {
+		pad := pads.Index(i)
+		if !pad.Truthy() || !pad.Get("connected").Bool() {
+			continue
+		}
+
+		if pad.Get("mapping").String() == "standard" {
+			g.index = i
+			return pad
+		}
+
+		if fallback.IsNull() {
+			fallback = pad
+			g.index = i
+		}
+	}
+
+	return fallback
+}
@@ -0,0 +1,117 @@
+package browser
+
+import (
+	"math"
+	"testing"
+)
+
+func TestApplyDeadZone(t *testing.T) {
+	tests := []struct {
+		name      string
+		x, y      float64
+		deadZone  float64
+		magnitude float64
+	}{
+		{"center", 0, 0, 0.15, 0},
+		{"inside the dead zone", 0.1, -0.1, 0.15, 0},
+		{"dead zone edge", 0.15, 0, 0.15, 0},
+		{"quarter tilt", 0.25, 0, 0.15, 0.5 * 0.1 / 0.35},
+		{"half tilt", 0.5, 0, 0.15, 0.5},
+		{"half tilt diagonal", 0.5 / math.Sqrt2, 0.5 / math.Sqrt2, 0.15, 0.5},
+		{"three quarter tilt", 0, 0.75, 0.15, 0.75},
+		{"full tilt", 0, -1, 0.15, 1},
+		{"corner clamped", 1, 1, 0.15, 1},
+		{"no dead zone", 0.3, 0.4, 0, 0.5},
+		{"large dead zone", 0.8, 0, 0.6, 0.5},
+		{"everything dead", 0.9, 0, 1, 0},
+	}
+
+	for _, test := range tests {
+		x, y := applyDeadZone(test.x, test.y, test.deadZone)
+
+		if magnitude := math.Hypot(x, y); math.Abs(magnitude-test.magnitude) > 1e-9 {
+			t.Errorf("%s: magnitude %f, expected %f", test.name, magnitude, test.magnitude)
+		}
+
+		// the direction is kept
+		if test.magnitude > 0 && math.Abs(math.Atan2(y, x)-math.Atan2(test.y, test.x)) > 1e-9 {
+			t.Errorf("%s: direction changed from (%f, %f) to (%f, %f)", test.name, test.x, test.y, x, y)
+		}
+	}
+
+	// pushed halfway the player walks at half speed, and faster the further the stick goes
+	if x, _ := applyDeadZone(0.5, 0, DefaultDeadZone); math.Abs(x-0.5) > 1e-9 {
+		t.Errorf("half tilt gives %f, expected 0.5", x)
+	}
+
+	previous := 0.0
+	for tilt := DefaultDeadZone; tilt <= 1; tilt += 0.05 {
+		x, _ := applyDeadZone(tilt, 0, DefaultDeadZone)
+		if x < previous {
+			t.Fatalf("tilt %f gives %f, less than %f for a smaller tilt", tilt, x, previous)
+		}
+		previous = x
+	}
+}
+
+func TestGamepadSticks(t *testing.T) {
+	g := NewGamepad(nil)
+
+	g.set(true, []float64{0.05, -0.05, 1, 0}, nil)
+
+	if s := g.LeftStick(); s != (Stick{}) {
+		t.Errorf("left stick %+v, expected the dead zone to center it", s)
+	}
+
+	if s := g.RightStick(); math.Abs(s.X-1) > 1e-9 || s.Y != 0 {
+		t.Errorf("right stick %+v, expected full right", s)
+	}
+
+	// pads reporting fewer axes than the standard layout
+	g.set(true, []float64{1, 0}, nil)
+	if s := g.RightStick(); s != (Stick{}) {
+		t.Errorf("missing right stick reads %+v, expected centered", s)
+	}
+}
+
+func TestGamepadButtons(t *testing.T) {
+	g := NewGamepad(map[int]Action{
+		ButtonA:            "run",
+		ButtonRightTrigger: "run",
+		ButtonDPadUp:       "forward",
+		ButtonHome:         "menu",
+	})
+
+	buttons := make([]float64, ButtonHome) // no home button
+	buttons[ButtonRightTrigger] = 0.4
+	buttons[ButtonDPadUp] = 1
+	g.set(true, nil, buttons)
+
+	tests := []struct {
+		action  Action
+		value   float64
+		pressed bool
+	}{
+		{"run", 0.4, false}, // analog trigger pushed less than halfway
+		{"forward", 1, true},
+		{"menu", 0, false},
+		{"unbound", 0, false},
+	}
+
+	for _, test := range tests {
+		if value := g.Value(test.action); value != test.value {
+			t.Errorf("%s: value %f, expected %f", test.action, value, test.value)
+		}
+
+		if pressed := g.Pressed(test.action); pressed != test.pressed {
+			t.Errorf("%s: pressed=%v, expected %v", test.action, pressed, test.pressed)
+		}
+	}
+
+	// the most pushed button of an action wins
+	buttons[ButtonA] = 1
+	g.set(true, nil, buttons)
+	if !g.Pressed("run") || g.Value("run") != 1 {
+		t.Errorf("run should be fully pressed with A, got %f", g.Value("run"))
+	}
+}
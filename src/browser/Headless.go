@@ -0,0 +1,272 @@
+package browser
+
+import (
+	"github.com/DrSmithFr/go-webassembly/src/gfx"
+	"github.com/llgcode/draw2d/draw2dimg"
+	"image"
+	"time"
+)
+
+// HeadlessCanvas is a Surface kept in memory, for tests and server side rendering.
+// There is no browser to schedule animation frames, they are triggered by calling Frame with a manual timestamp, or
+// Run to simulate a display refresh rate. Frames are rendered and presented with the same rules as Canvas2d.
+type HeadlessCanvas struct {
+	width  int
+	height int
+
+	// Drawing Context
+	gctx      *draw2dimg.GraphicContext // Graphic Context
+	image     *image.RGBA               // The Shadow frame we actually draw on
+	memory    *memoryPresenter          // Keeps a copy of the presented frames, what a canvas would display
+	presenter presenter                 // Presents frames, memory unless tests measure another way
+
+	dirty     dirtyRegion
+	stats     FrameStats
+	rf        RenderFunc
+	lifecycle lifecycle
+	limiter   frameLimiter
+
+	now    float64 // Timestamp of the last animation frame, in milliseconds
+	frames int     // Number of frames presented since Start
+}
+
+func NewHeadlessCanvas(width int, height int) *HeadlessCanvas {
+	var c HeadlessCanvas
+
+	c.SetSize(width, height)
+
+	return &c
+}
+
+// Start renders on every following animation frame, at most maxFPS times per second.
+// Starting a running canvas only replaces its render function.
+func (c *HeadlessCanvas) Start(maxFPS float64, rf RenderFunc) {
+	if c.lifecycle.state == StateDestroyed {
+		return
+	}
+
+	c.SetFPS(maxFPS)
+	c.rf = rf
+
+	if c.lifecycle.start() {
+		c.frames = 0
+		c.memory.copied = 0
+		c.limiter.reset()
+		c.dirty.reset(c.image.Bounds())
+		c.stats.Reset()
+	}
+}
+
+// Pause ignores the following animation frames, keeping the render function for Resume.
+func (c *HeadlessCanvas) Pause() {
+	c.lifecycle.pause()
+}
+
+// Resume renders again after Pause. The first frame after resuming gets no elapsed time.
+func (c *HeadlessCanvas) Resume() {
+	if c.lifecycle.resume() {
+		c.limiter.reset()
+	}
+}
+
+// Stop ignores the following animation frames and forgets the render function, Start may be called again.
+func (c *HeadlessCanvas) Stop() {
+	c.rf = nil
+	c.lifecycle.stop()
+}
+
+// Destroy stops rendering for good.
+func (c *HeadlessCanvas) Destroy() {
+	c.Stop()
+	c.lifecycle.destroy()
+}
+
+// State returns where the canvas is in its lifecycle.
+func (c *HeadlessCanvas) State() State {
+	return c.lifecycle.state
+}
+
+// SetHidden simulates the page visibility changing, a running canvas is paused while the page is hidden.
+func (c *HeadlessCanvas) SetHidden(hidden bool) {
+	if hidden {
+		c.lifecycle.hide()
+	} else if c.lifecycle.show() {
+		c.limiter.reset()
+	}
+}
+
+// Sets the maximum FPS (Frames per Second).  This can be changed on the fly and will take affect next frame.
+func (c *HeadlessCanvas) SetFPS(maxFPS float64) {
+	c.limiter.setFPS(maxFPS)
+}
+
+// SetSize resizes the frame buffers, keeping the picture in the top left corner and the drawing state.
+func (c *HeadlessCanvas) SetSize(width int, height int) {
+	c.width = width
+	c.height = height
+
+	if c.image == nil {
+		c.image = image.NewRGBA(image.Rect(0, 0, width, height))
+		c.memory = &memoryPresenter{presented: image.NewRGBA(image.Rect(0, 0, width, height))}
+		c.presenter = c.memory
+		c.gctx, _ = newGraphicContext(c.image)
+	} else {
+		c.image = resizeImage(c.image, width, height)
+		c.presenter.resize(width, height)
+		c.gctx = resizeGraphicContext(c.gctx, c.image)
+	}
+
+	c.dirty.reset(c.image.Bounds())
+}
+
+// Invalidate marks r as changed by the render function, only the invalidated regions of a frame are presented.
+func (c *HeadlessCanvas) Invalidate(r image.Rectangle) {
+	c.dirty.add(r)
+}
+
+// Frame simulates the browser calling back requestAnimationFrame at timestamp, in milliseconds since the page
+// loaded. It returns true when a frame was presented.
+func (c *HeadlessCanvas) Frame(timestamp float64) bool {
+	c.now = timestamp
+
+	if c.lifecycle.state != StateRunning {
+		return false
+	}
+
+	elapsed, ok := c.limiter.due(timestamp)
+	if !ok {
+		c.stats.Skip()
+		return false
+	}
+
+	// Durations are measured for real, only the time between frames is simulated
+	timing := FrameTiming{Interval: elapsed * 1000}
+	start := time.Now()
+
+	if c.rf != nil && !c.rf(c.gctx, elapsed) { // Frame did not change, keep the previous one
+		timing.Render = milliseconds(time.Since(start))
+		c.stats.Record(timing)
+		return false
+	}
+
+	rendered := time.Now()
+	timing.Render = milliseconds(rendered.Sub(start))
+
+	c.present()
+
+	timing.Copy = milliseconds(time.Since(rendered))
+	timing.Presented = true
+	c.stats.Record(timing)
+
+	return true
+}
+
+// Run simulates a display refreshing refreshRate times per second during duration milliseconds, starting after the
+// last simulated frame. It returns the number of frames presented.
+func (c *HeadlessCanvas) Run(duration float64, refreshRate float64) int {
+	interval := 1000 / refreshRate
+	frames := 0
+
+	for end := c.now + duration; c.now+interval <= end+1e-9; {
+		if c.Frame(c.now + interval) {
+			frames++
+		}
+	}
+
+	return frames
+}
+
+// Now returns the timestamp of the last simulated animation frame, in milliseconds.
+func (c *HeadlessCanvas) Now() float64 {
+	return c.now
+}
+
+// Frames returns the number of frames presented since Start.
+func (c *HeadlessCanvas) Frames() int {
+	return c.frames
+}
+
+// Stats returns the timings of the last rendered frames.
+func (c *HeadlessCanvas) Stats() *FrameStats {
+	return &c.stats
+}
+
+// BytesCopied returns the number of bytes copied to present frames since Start.
+func (c *HeadlessCanvas) BytesCopied() int {
+	return c.memory.copied
+}
+
+// Presented returns the last presented frame, what a browser canvas would display.
+func (c *HeadlessCanvas) Presented() *image.RGBA {
+	return c.memory.presented
+}
+
+// Get the Drawing context for the Canvas
+func (c *HeadlessCanvas) Gc() *draw2dimg.GraphicContext {
+	return c.gctx
+}
+
+// Get the shadow frame the Graphic Context draws on, for direct pixel access
+func (c *HeadlessCanvas) Image() *image.RGBA {
+	return c.image
+}
+
+// Get direct pixel access to the shadow frame, until the next resize
+func (c *HeadlessCanvas) Framebuffer() gfx.Framebuffer {
+	return gfx.NewFramebuffer(c.image)
+}
+
+func (c *HeadlessCanvas) Height() int {
+	return c.height
+}
+
+func (c *HeadlessCanvas) Width() int {
+	return c.width
+}
+
+// present hands the dirty regions of the shadow frame to the presenter, like Canvas2d does.
+func (c *HeadlessCanvas) present() {
+	rects := c.dirty.take()
+
+	c.presenter.copy(c.image, rects)
+	c.presenter.present(rects)
+
+	c.frames++
+}
+
+var _ presenter = (*memoryPresenter)(nil)
+
+// memoryPresenter copies frames to an image standing for the memory the browser displays from.
+type memoryPresenter struct {
+	presented *image.RGBA
+	copied    int // Number of bytes copied since the counter was reset
+}
+
+func (p *memoryPresenter) name() string {
+	return "memory"
+}
+
+func (p *memoryPresenter) resize(width int, height int) {
+	p.presented = resizeImage(p.presented, width, height)
+}
+
+// copy copies whole rows, from the first to the last row of each region, like the browser presenters.
+func (p *memoryPresenter) copy(img *image.RGBA, rects []image.Rectangle) {
+	for _, r := range rects {
+		if r.Empty() {
+			continue
+		}
+
+		start, end := img.PixOffset(0, r.Min.Y), img.PixOffset(0, r.Max.Y)
+		p.copied += copy(p.presented.Pix[start:end], img.Pix[start:end])
+	}
+}
+
+// present has nothing to do, the copy is what gets displayed.
+func (p *memoryPresenter) present(rects []image.Rectangle) {}
+
+func (p *memoryPresenter) release() {}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
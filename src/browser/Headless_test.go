@@ -0,0 +1,439 @@
+package browser
+
+import (
+	"github.com/llgcode/draw2d/draw2dimg"
+	"github.com/llgcode/draw2d/draw2dkit"
+	"image"
+	"image/color"
+	"math"
+	"testing"
+)
+
+func TestHeadlessCanvasLimitsFPS(t *testing.T) {
+	c := NewHeadlessCanvas(4, 4)
+
+	var elapsed []float64
+	c.Start(30, func(gc *draw2dimg.GraphicContext, dt float64) bool {
+		elapsed = append(elapsed, dt)
+		return true
+	})
+
+	// a 60Hz display only gets every other frame rendered at 30 FPS
+	if frames := c.Run(1000, 60); frames != 30 {
+		t.Errorf("presented %d frames in a second, expected 30", frames)
+	}
+
+	if elapsed[0] != 0 {
+		t.Errorf("first frame got dt=%f, expected 0", elapsed[0])
+	}
+
+	for _, dt := range elapsed[1:] {
+		if math.Abs(dt-1.0/30) > 1e-9 {
+			t.Fatalf("got dt=%f, expected 1/30s between frames", dt)
+		}
+	}
+}
+
+func TestHeadlessCanvasPresentsChangedFrames(t *testing.T) {
+	c := NewHeadlessCanvas(4, 4)
+	red := color.RGBA{0xff, 0x00, 0x00, 0xff}
+
+	changed := true
+	c.Start(60, func(gc *draw2dimg.GraphicContext, dt float64) bool {
+		gc.SetFillColor(red)
+		gc.Clear()
+		return changed
+	})
+
+	c.Frame(0)
+	if c.Presented().RGBAAt(1, 1) != red || c.Frames() != 1 {
+		t.Fatalf("frame was not presented: %v, %d frames", c.Presented().RGBAAt(1, 1), c.Frames())
+	}
+
+	// nothing changed, the previous frame stays
+	changed = false
+	if c.Frame(100) || c.Frames() != 1 {
+		t.Errorf("unchanged frame should not be presented")
+	}
+
+	c.Stop()
+	changed = true
+	if c.Run(1000, 60) != 0 {
+		t.Errorf("stopped canvas should not present frames")
+	}
+
+	c.Start(60, nil)
+	if !c.Frame(c.Now() + 20) {
+		t.Errorf("restarted canvas without render function should present frames")
+	}
+}
+
+func TestHeadlessCanvasCopiesFramesOnce(t *testing.T) {
+	c := NewHeadlessCanvas(8, 4)
+	c.Start(60, nil)
+
+	frames := c.Run(1000, 60)
+
+	if expected := frames * len(c.Image().Pix); c.BytesCopied() != expected {
+		t.Errorf("copied %d bytes for %d frames, expected %d", c.BytesCopied(), frames, expected)
+	}
+}
+
+// stagedPresenter copies frames through an intermediate buffer first, as Canvas2d did before copying straight into the
+// ImageData buffer.
+type stagedPresenter struct {
+	*memoryPresenter
+	staging *image.RGBA
+}
+
+func (p *stagedPresenter) name() string {
+	return "staged"
+}
+
+func (p *stagedPresenter) resize(width int, height int) {
+	p.memoryPresenter.resize(width, height)
+	p.staging = resizeImage(p.staging, width, height)
+}
+
+func (p *stagedPresenter) copy(img *image.RGBA, rects []image.Rectangle) {
+	if p.staging == nil {
+		p.staging = image.NewRGBA(img.Rect)
+	}
+
+	for _, r := range rects {
+		if !r.Empty() {
+			start, end := img.PixOffset(0, r.Min.Y), img.PixOffset(0, r.Max.Y)
+			p.memoryPresenter.copied += copy(p.staging.Pix[start:end], img.Pix[start:end])
+		}
+	}
+
+	p.memoryPresenter.copy(p.staging, rects)
+}
+
+// BenchmarkPresent compares the bytes copied per frame of a 640x400 canvas, before and after the frame was copied
+// straight into the ImageData buffer.
+func BenchmarkPresent(b *testing.B) {
+	const width, height = 640, 400
+
+	for _, staged := range []bool{true, false} {
+		c := NewHeadlessCanvas(width, height)
+		if staged {
+			// Go image -> Uint8Array -> ImageData, as Canvas2d used to, instead of Go image -> ImageData
+			c.presenter = &stagedPresenter{memoryPresenter: c.memory}
+		}
+
+		b.Run(c.presenter.name(), func(b *testing.B) {
+			c.Start(1000, nil)
+
+			b.SetBytes(int64(len(c.Image().Pix)))
+			b.ResetTimer()
+
+			// frames 1ms apart, all rendered at 1000 FPS
+			for i := 0; i < b.N; i++ {
+				c.Frame(float64(i))
+			}
+
+			b.ReportMetric(float64(c.BytesCopied())/float64(c.Frames()), "copied-bytes/frame")
+		})
+	}
+}
+
+func TestHeadlessCanvasStagedPresenterCopiesTwice(t *testing.T) {
+	c := NewHeadlessCanvas(8, 4)
+	c.presenter = &stagedPresenter{memoryPresenter: c.memory}
+	c.Start(60, nil)
+
+	frames := c.Run(1000, 60)
+
+	if expected := 2 * frames * len(c.Image().Pix); c.BytesCopied() != expected {
+		t.Errorf("copied %d bytes for %d frames, expected %d", c.BytesCopied(), frames, expected)
+	}
+}
+
+func TestHeadlessCanvasPresentsDirtyRects(t *testing.T) {
+	c := NewHeadlessCanvas(20, 10)
+	red := color.RGBA{0xff, 0x00, 0x00, 0xff}
+	blue := color.RGBA{0x00, 0x00, 0xff, 0xff}
+
+	fill := red
+	c.Start(60, func(gc *draw2dimg.GraphicContext, dt float64) bool {
+		gc.SetFillColor(fill)
+		gc.Clear()
+
+		if fill == blue {
+			c.Invalidate(image.Rect(2, 2, 6, 4))
+			c.Invalidate(image.Rect(4, 3, 8, 4))    // overlaps, merged with the first one
+			c.Invalidate(image.Rect(18, 8, 30, 30)) // clipped to the frame
+		}
+		return true
+	})
+
+	// the first frame is always presented whole
+	c.Frame(0)
+	if copied := c.BytesCopied(); copied != len(c.Image().Pix) {
+		t.Fatalf("first frame copied %d bytes, expected the whole frame", copied)
+	}
+
+	fill = blue
+	c.Frame(100)
+
+	// whole rows are copied, like the browser presenters do: rows 2 and 3, then 8 and 9
+	if expected := len(c.Image().Pix) + 4*20*4; c.BytesCopied() != expected {
+		t.Errorf("copied %d bytes in total, expected %d", c.BytesCopied(), expected)
+	}
+
+	for _, p := range []image.Point{{2, 2}, {7, 2}, {12, 3}, {19, 9}, {0, 8}} {
+		if got := c.Presented().RGBAAt(p.X, p.Y); got != blue {
+			t.Errorf("invalidated pixel %v = %v, expected %v", p, got, blue)
+		}
+	}
+
+	for _, p := range []image.Point{{0, 0}, {9, 1}, {10, 5}, {19, 7}} {
+		if got := c.Presented().RGBAAt(p.X, p.Y); got != red {
+			t.Errorf("pixel %v outside the dirty rows = %v, expected the previous frame", p, got)
+		}
+	}
+}
+
+func TestHeadlessCanvasPresentsLargeDirtyRegionsWhole(t *testing.T) {
+	tests := []struct {
+		name  string
+		rects []image.Rectangle
+	}{
+		{"coverage", []image.Rectangle{image.Rect(0, 0, 6, 10)}},
+		{"count", func() []image.Rectangle {
+			var rects []image.Rectangle
+			for i := 0; i <= maxDirtyRects; i++ {
+				rects = append(rects, image.Rect(i%10, i/10*2, i%10+1, i/10*2+1))
+			}
+			return rects
+		}()},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewHeadlessCanvas(10, 10)
+			c.Start(60, func(gc *draw2dimg.GraphicContext, dt float64) bool {
+				for _, r := range test.rects {
+					c.Invalidate(r)
+				}
+				return true
+			})
+
+			c.Frame(0)
+			c.Frame(100)
+
+			if expected := 2 * len(c.Image().Pix); c.BytesCopied() != expected {
+				t.Errorf("copied %d bytes, expected the whole frame twice", c.BytesCopied())
+			}
+		})
+	}
+}
+
+func TestHeadlessCanvasLifecycle(t *testing.T) {
+	c := NewHeadlessCanvas(4, 4)
+	rendered := 0
+	render := func(gc *draw2dimg.GraphicContext, dt float64) bool {
+		rendered++
+		return true
+	}
+
+	// nothing to pause, resume or stop before starting
+	c.Pause()
+	c.Resume()
+	c.Stop()
+	if c.State() != StateIdle || c.Frame(0) {
+		t.Fatalf("state %s, expected no frame before Start", c.State())
+	}
+
+	c.Start(60, render)
+	c.Start(60, render) // already running
+	if c.Run(100, 60) != 6 {
+		t.Errorf("running canvas should present frames")
+	}
+
+	c.Pause()
+	c.Pause()
+	if c.State() != StatePaused || c.Run(100, 60) != 0 {
+		t.Errorf("paused canvas should not present frames, state %s", c.State())
+	}
+
+	// no catching up on the time spent paused
+	var elapsed float64
+	c.Start(60, func(gc *draw2dimg.GraphicContext, dt float64) bool {
+		elapsed = dt
+		return render(gc, dt)
+	})
+	c.Pause()
+	c.Resume()
+	c.Resume()
+	if !c.Frame(c.Now()+500) || elapsed != 0 || c.State() != StateRunning {
+		t.Errorf("resumed canvas rendered with dt=%f, state %s", elapsed, c.State())
+	}
+
+	// hiding the page pauses, showing it resumes
+	c.SetHidden(true)
+	if c.State() != StatePaused || c.Frame(c.Now()+20) {
+		t.Errorf("hidden page should pause, state %s", c.State())
+	}
+	c.SetHidden(false)
+	if c.State() != StateRunning {
+		t.Errorf("visible page should resume, state %s", c.State())
+	}
+
+	// but a canvas paused on purpose stays paused
+	c.Pause()
+	c.SetHidden(true)
+	c.SetHidden(false)
+	if c.State() != StatePaused {
+		t.Errorf("page visibility resumed a paused canvas")
+	}
+
+	c.Stop()
+	c.Resume()
+	if c.State() != StateStopped || c.Frame(c.Now()+20) {
+		t.Errorf("stopped canvas should not be resumed, state %s", c.State())
+	}
+
+	c.Start(60, render)
+	if !c.Frame(c.Now() + 20) {
+		t.Errorf("stopped canvas should start again")
+	}
+
+	before := rendered
+	c.Destroy()
+	c.Destroy()
+	c.Start(60, render)
+	c.Resume()
+	if c.State() != StateDestroyed || c.Run(100, 60) != 0 || rendered != before {
+		t.Errorf("destroyed canvas should not render, state %s", c.State())
+	}
+}
+
+func TestHeadlessCanvasResizeKeepsPictureAndState(t *testing.T) {
+	c := NewHeadlessCanvas(8, 6)
+	red := color.RGBA{0xff, 0x00, 0x00, 0xff}
+
+	// a gradient, to tell pixels apart
+	for y := 0; y < 6; y++ {
+		for x := 0; x < 8; x++ {
+			c.Image().SetRGBA(x, y, color.RGBA{uint8(x * 10), uint8(y * 10), 0xff, 0xff})
+		}
+	}
+
+	c.Gc().SetFillColor(red)
+	c.Gc().SetFontSize(30)
+
+	pix := &c.Image().Pix[0]
+
+	for _, size := range []image.Point{{5, 4}, {3, 5}, {8, 6}, {12, 3}} {
+		c.SetSize(size.X, size.Y)
+		img := c.Image()
+
+		if img.Bounds() != image.Rect(0, 0, size.X, size.Y) || c.Width() != size.X || c.Height() != size.Y {
+			t.Fatalf("SetSize(%d, %d) gave a %v image", size.X, size.Y, img.Bounds())
+		}
+
+		// the part of the first picture still inside every size so far
+		for y := 0; y < 3; y++ {
+			for x := 0; x < 3; x++ {
+				if expected := (color.RGBA{uint8(x * 10), uint8(y * 10), 0xff, 0xff}); img.RGBAAt(x, y) != expected {
+					t.Fatalf("after SetSize(%d, %d) pixel (%d, %d) = %v, expected %v", size.X, size.Y, x, y, img.RGBAAt(x, y), expected)
+				}
+			}
+		}
+
+		if size.X*size.Y <= 8*6 && &img.Pix[0] != pix {
+			t.Errorf("SetSize(%d, %d) reallocated the frame", size.X, size.Y)
+		}
+	}
+
+	// the last resize grew the width in place, the pixels uncovered are cleared
+	for _, p := range []image.Point{{3, 0}, {11, 2}} {
+		if got := c.Image().RGBAAt(p.X, p.Y); got != (color.RGBA{}) {
+			t.Errorf("uncovered pixel %v = %v, expected transparent", p, got)
+		}
+	}
+
+	if c.Gc().Current.FillColor != red || c.Gc().GetFontSize() != 30 {
+		t.Errorf("drawing state lost on resize")
+	}
+
+	// fonts are still installed
+	c.SetSize(64, 32)
+	c.Gc().SetFontData(defaultFontData)
+	c.Gc().FillStringAt("A", 4, 28)
+
+	drawn := false
+	for y := 0; y < 32 && !drawn; y++ {
+		for x := 0; x < 64 && !drawn; x++ {
+			drawn = c.Image().RGBAAt(x, y) == red
+		}
+	}
+
+	if !drawn {
+		t.Errorf("text not drawn after resize")
+	}
+}
+
+// Pixels written directly show through draw2d drawings made afterwards, and the other way round.
+func TestHeadlessCanvasFramebufferWithGraphicContext(t *testing.T) {
+	red := color.RGBA{0xff, 0x00, 0x00, 0xff}
+	green := color.RGBA{0x00, 0xff, 0x00, 0xff}
+	blue := color.RGBA{0x00, 0x00, 0xff, 0x80}
+
+	c := NewHeadlessCanvas(10, 10)
+
+	c.Framebuffer().FillRect(c.Image().Bounds(), red)
+
+	gc := c.Gc()
+	gc.SetFillColor(green)
+	gc.BeginPath()
+	draw2dkit.Rectangle(gc, 0, 0, 5, 10)
+	gc.Fill()
+
+	c.Framebuffer().VSpan(9, 0, 10, blue)
+
+	for y := 0; y < 10; y++ {
+		for x := 0; x < 10; x++ {
+			expected := red
+			switch {
+			case x < 5:
+				expected = green
+			case x == 9:
+				expected = blue
+			}
+
+			if got := c.Image().RGBAAt(x, y); got != expected {
+				t.Fatalf("pixel (%d, %d) is %v, expected %v", x, y, got, expected)
+			}
+		}
+	}
+}
+
+// Wall columns of a 320x200 frame, draw2d slows down a lot with the number of rectangles in a path.
+func BenchmarkFramebufferColumns(b *testing.B) {
+	red := color.RGBA{0xff, 0x00, 0x00, 0xff}
+	c := NewHeadlessCanvas(320, 200)
+
+	b.Run("framebuffer", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			fb := c.Framebuffer()
+			for x := 0; x < 320; x++ {
+				fb.VSpan(x, 40, 160, red)
+			}
+		}
+	})
+
+	b.Run("draw2d", func(b *testing.B) {
+		gc := c.Gc()
+		for i := 0; i < b.N; i++ {
+			gc.SetFillColor(red)
+			gc.BeginPath()
+			for x := 0; x < 320; x++ {
+				draw2dkit.Rectangle(gc, float64(x), 40, float64(x+1), 160)
+			}
+			gc.Fill()
+		}
+	})
+}
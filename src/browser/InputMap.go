@@ -0,0 +1,236 @@
+package browser
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// Action is a named thing the player can do, such as "forward" or "run".
+type Action string
+
+// Storage persists strings by key. LocalStorage is the browser implementation.
+type Storage interface {
+	Get(key string) (string, bool)
+	Set(key string, value string)
+}
+
+// InputMap maps physical keys, as found in KeyboardEvent.code, to actions.
+// Codes name key positions rather than printed letters, so "KeyW" is the key left of "KeyE" on any layout. An action
+// may have several keys, but a key drives a single action.
+type InputMap struct {
+	defaults map[Action][]string
+	bindings map[Action][]string
+	pressed  map[string]bool // Codes currently held down
+
+	capture  Action // When set, the next key pressed is bound to this action
+	onChange []func()
+}
+
+// NewInputMap creates a map using the given default bindings.
+func NewInputMap(defaults map[Action][]string) *InputMap {
+	m := &InputMap{
+		defaults: copyBindings(defaults),
+		pressed:  map[string]bool{},
+	}
+
+	m.bindings = copyBindings(defaults)
+
+	return m
+}
+
+// Bind adds code to the keys of action, removing it from any other action.
+func (m *InputMap) Bind(action Action, code string) {
+	for other := range m.bindings {
+		if other != action {
+			m.bindings[other] = without(m.bindings[other], code)
+		}
+	}
+
+	if !contains(m.bindings[action], code) {
+		m.bindings[action] = append(m.bindings[action], code)
+	}
+
+	m.changed()
+}
+
+// Unbind removes code from the keys of action.
+func (m *InputMap) Unbind(action Action, code string) {
+	m.bindings[action] = without(m.bindings[action], code)
+	m.changed()
+}
+
+// Clear removes every key of action.
+func (m *InputMap) Clear(action Action) {
+	m.bindings[action] = nil
+	m.changed()
+}
+
+// Reset restores the default bindings.
+func (m *InputMap) Reset() {
+	m.bindings = copyBindings(m.defaults)
+	m.changed()
+}
+
+// Bindings returns the keys of action.
+func (m *InputMap) Bindings(action Action) []string {
+	return append([]string(nil), m.bindings[action]...)
+}
+
+// Actions returns every action known to the map, sorted by name.
+func (m *InputMap) Actions() []Action {
+	actions := make([]Action, 0, len(m.bindings))
+	for action := range m.bindings {
+		actions = append(actions, action)
+	}
+
+	sort.Slice(actions, func(i, j int) bool { return actions[i] < actions[j] })
+
+	return actions
+}
+
+// Capture binds the next key pressed to action, as a settings screen would. Pressing Escape cancels.
+func (m *InputMap) Capture(action Action) {
+	m.capture = action
+}
+
+// Capturing returns the action waiting for a key, if any.
+func (m *InputMap) Capturing() (Action, bool) {
+	return m.capture, m.capture != ""
+}
+
+// KeyDown records a key press. It returns true when the key is used by the game, so the browser default can be
+// prevented.
+func (m *InputMap) KeyDown(code string) bool {
+	if action, ok := m.Capturing(); ok {
+		m.capture = ""
+
+		if code != "Escape" {
+			m.Bind(action, code)
+		}
+
+		return true
+	}
+
+	m.pressed[code] = true
+
+	return m.actionOf(code) != ""
+}
+
+// KeyUp records a key release. It returns true when the key is used by the game.
+func (m *InputMap) KeyUp(code string) bool {
+	delete(m.pressed, code)
+
+	return m.actionOf(code) != ""
+}
+
+// ReleaseAll forgets every key held down, e.g. when the page loses focus and key up events are lost.
+func (m *InputMap) ReleaseAll() {
+	m.pressed = map[string]bool{}
+}
+
+// Pressed tells whether any key of action is held down.
+func (m *InputMap) Pressed(action Action) bool {
+	for _, code := range m.bindings[action] {
+		if m.pressed[code] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// OnChange registers a function called after every change of the bindings, after the ones registered before.
+func (m *InputMap) OnChange(fn func()) {
+	m.onChange = append(m.onChange, fn)
+}
+
+// Persist loads the bindings saved under key, if any, then saves them back on every change.
+func (m *InputMap) Persist(storage Storage, key string) error {
+	if data, ok := storage.Get(key); ok {
+		if err := json.Unmarshal([]byte(data), m); err != nil {
+			return err
+		}
+	}
+
+	m.OnChange(func() {
+		data, _ := json.Marshal(m)
+		storage.Set(key, string(data))
+	})
+
+	return nil
+}
+
+// MarshalJSON encodes the bindings as an object of action name to key codes.
+func (m *InputMap) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.bindings)
+}
+
+// UnmarshalJSON replaces the bindings of the actions found in data, other actions keep their current keys.
+// Actions without defaults are ignored, saved bindings of a removed action must not steal keys from the others.
+func (m *InputMap) UnmarshalJSON(data []byte) error {
+	var bindings map[Action][]string
+
+	if err := json.Unmarshal(data, &bindings); err != nil {
+		return err
+	}
+
+	for action, codes := range bindings {
+		if _, ok := m.defaults[action]; !ok {
+			continue
+		}
+
+		m.bindings[action] = nil
+
+		for _, code := range codes {
+			m.Bind(action, code)
+		}
+	}
+
+	return nil
+}
+
+func (m *InputMap) actionOf(code string) Action {
+	for action, codes := range m.bindings {
+		if contains(codes, code) {
+			return action
+		}
+	}
+
+	return ""
+}
+
+func (m *InputMap) changed() {
+	for _, fn := range m.onChange {
+		fn()
+	}
+}
+
+func copyBindings(bindings map[Action][]string) map[Action][]string {
+	copied := make(map[Action][]string, len(bindings))
+	for action, codes := range bindings {
+		copied[action] = append([]string(nil), codes...)
+	}
+
+	return copied
+}
+
+func contains(codes []string, code string) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+func without(codes []string, code string) []string {
+	kept := codes[:0:0]
+	for _, c := range codes {
+		if c != code {
+			kept = append(kept, c)
+		}
+	}
+
+	return kept
+}
@@ -0,0 +1,195 @@
+package browser
+
+import (
+	"reflect"
+	"testing"
+)
+
+// memoryStorage keeps values in memory, as LocalStorage does in the browser.
+type memoryStorage map[string]string
+
+func (s memoryStorage) Get(key string) (string, bool) {
+	value, ok := s[key]
+	return value, ok
+}
+
+func (s memoryStorage) Set(key string, value string) {
+	s[key] = value
+}
+
+func newTestInputMap() *InputMap {
+	return NewInputMap(map[Action][]string{
+		"forward": {"ArrowUp", "KeyW"},
+		"left":    {"ArrowLeft"},
+		"run":     {"ShiftLeft"},
+	})
+}
+
+func assertBindings(t *testing.T, m *InputMap, action Action, expected ...string) {
+	t.Helper()
+
+	if got := m.Bindings(action); !reflect.DeepEqual(got, expected) && !(len(got) == 0 && len(expected) == 0) {
+		t.Errorf("%s is bound to %v, expected %v", action, got, expected)
+	}
+}
+
+func TestInputMapSeveralKeysPerAction(t *testing.T) {
+	m := newTestInputMap()
+
+	for _, code := range []string{"ArrowUp", "KeyW"} {
+		m.KeyDown(code)
+		if !m.Pressed("forward") {
+			t.Errorf("%s should press forward", code)
+		}
+		m.KeyUp(code)
+	}
+
+	// releasing one of two held keys keeps the action pressed
+	m.KeyDown("ArrowUp")
+	m.KeyDown("KeyW")
+	m.KeyUp("ArrowUp")
+	if !m.Pressed("forward") {
+		t.Error("forward should stay pressed while KeyW is held")
+	}
+
+	m.ReleaseAll()
+	if m.Pressed("forward") {
+		t.Error("ReleaseAll should release forward")
+	}
+
+	if m.KeyDown("KeyP") {
+		t.Error("an unbound key should let the browser handle it")
+	}
+}
+
+func TestInputMapRebind(t *testing.T) {
+	m := newTestInputMap()
+
+	changes := 0
+	m.OnChange(func() { changes++ })
+
+	// a key drives a single action, binding it elsewhere moves it
+	m.Bind("left", "KeyW")
+	assertBindings(t, m, "forward", "ArrowUp")
+	assertBindings(t, m, "left", "ArrowLeft", "KeyW")
+
+	m.KeyDown("KeyW")
+	if m.Pressed("forward") || !m.Pressed("left") {
+		t.Error("KeyW should press left only")
+	}
+	m.ReleaseAll()
+
+	m.Unbind("left", "ArrowLeft")
+	assertBindings(t, m, "left", "KeyW")
+
+	m.Clear("run")
+	assertBindings(t, m, "run")
+
+	m.Reset()
+	assertBindings(t, m, "forward", "ArrowUp", "KeyW")
+	assertBindings(t, m, "left", "ArrowLeft")
+	assertBindings(t, m, "run", "ShiftLeft")
+
+	if changes != 4 {
+		t.Errorf("got %d change notifications, expected 4", changes)
+	}
+}
+
+func TestInputMapCapture(t *testing.T) {
+	m := newTestInputMap()
+
+	m.Capture("run")
+	if action, ok := m.Capturing(); !ok || action != "run" {
+		t.Fatalf("Capturing() = %q, %v, expected run", action, ok)
+	}
+
+	// the captured key is bound, not pressed
+	if !m.KeyDown("KeyR") {
+		t.Error("the captured key should be handled by the game")
+	}
+	assertBindings(t, m, "run", "ShiftLeft", "KeyR")
+	if m.Pressed("run") {
+		t.Error("the captured key should not press run")
+	}
+
+	m.Capture("run")
+	m.KeyDown("Escape")
+	if _, ok := m.Capturing(); ok {
+		t.Error("Escape should end the capture")
+	}
+	assertBindings(t, m, "run", "ShiftLeft", "KeyR")
+}
+
+func TestInputMapUnknownAction(t *testing.T) {
+	m := newTestInputMap()
+
+	assertBindings(t, m, "jump")
+	if m.Pressed("jump") {
+		t.Error("an unknown action can't be pressed")
+	}
+
+	if err := m.UnmarshalJSON([]byte(`{"jump": ["ArrowUp"], "left": ["KeyA"]}`)); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+
+	// saved bindings of an action that doesn't exist anymore are dropped
+	assertBindings(t, m, "jump")
+	assertBindings(t, m, "forward", "ArrowUp", "KeyW")
+	assertBindings(t, m, "left", "KeyA")
+
+	if !reflect.DeepEqual(m.Actions(), []Action{"forward", "left", "run"}) {
+		t.Errorf("unexpected actions %v", m.Actions())
+	}
+}
+
+func TestInputMapUnmarshalMergesDefaults(t *testing.T) {
+	m := newTestInputMap()
+
+	// left steals KeyW from the defaults of forward, run isn't saved and keeps its default
+	if err := m.UnmarshalJSON([]byte(`{"forward": ["ArrowUp"], "left": ["KeyW", "KeyA"]}`)); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+
+	assertBindings(t, m, "forward", "ArrowUp")
+	assertBindings(t, m, "left", "KeyW", "KeyA")
+	assertBindings(t, m, "run", "ShiftLeft")
+
+	if err := m.UnmarshalJSON([]byte(`["KeyW"]`)); err == nil {
+		t.Error("UnmarshalJSON should reject anything but an object")
+	}
+}
+
+func TestInputMapPersist(t *testing.T) {
+	storage := memoryStorage{}
+
+	m := newTestInputMap()
+	if err := m.Persist(storage, "bindings"); err != nil {
+		t.Fatalf("Persist: %v", err)
+	}
+
+	if _, ok := storage["bindings"]; ok {
+		t.Error("nothing should be saved before a change")
+	}
+
+	m.Bind("run", "KeyR")
+
+	// a new session loads the saved bindings
+	loaded := newTestInputMap()
+	if err := loaded.Persist(storage, "bindings"); err != nil {
+		t.Fatalf("Persist: %v", err)
+	}
+	assertBindings(t, loaded, "run", "ShiftLeft", "KeyR")
+	assertBindings(t, loaded, "forward", "ArrowUp", "KeyW")
+
+	loaded.Reset()
+	again := newTestInputMap()
+	if err := again.Persist(storage, "bindings"); err != nil {
+		t.Fatalf("Persist: %v", err)
+	}
+	assertBindings(t, again, "run", "ShiftLeft")
+
+	storage["bindings"] = "{broken"
+	if err := newTestInputMap().Persist(storage, "bindings"); err == nil {
+		t.Error("Persist should report corrupted bindings")
+	}
+}
@@ -0,0 +1,125 @@
+package browser
+
+// State is where a Surface is in its lifecycle:
+//
+//	Idle --Start--> Running <--Pause/Resume--> Paused
+//	Running, Paused --Stop--> Stopped --Start--> Running
+//	any --Destroy--> Destroyed
+//
+// Calls that make no sense in the current state, like resuming a running surface, are ignored, so every method is
+// safe to call in any order.
+type State int
+
+const (
+	StateIdle      State = iota // Created, never started
+	StateRunning                // Rendering on every animation frame
+	StatePaused                 // Keeps its render function, until resumed
+	StateStopped                // Forgot its render function, may be started again
+	StateDestroyed              // Released, for good
+)
+
+func (s State) String() string {
+	switch s {
+	case StateIdle:
+		return "idle"
+	case StateRunning:
+		return "running"
+	case StatePaused:
+		return "paused"
+	case StateStopped:
+		return "stopped"
+	case StateDestroyed:
+		return "destroyed"
+	}
+
+	return "unknown"
+}
+
+// lifecycle holds the state of a surface, its methods tell whether the transition happened, i.e. whether animation
+// frames must be requested or cancelled.
+type lifecycle struct {
+	state  State
+	hidden bool // Paused because the page was hidden, resumed once it is visible again
+}
+
+// start returns true when the surface wasn't running and frames must be requested.
+func (l *lifecycle) start() bool {
+	l.hidden = false
+
+	switch l.state {
+	case StateRunning, StateDestroyed:
+		return false
+	}
+
+	l.state = StateRunning
+	return true
+}
+
+// pause returns true when the surface was running and frames must be cancelled.
+func (l *lifecycle) pause() bool {
+	l.hidden = false
+
+	if l.state != StateRunning {
+		return false
+	}
+
+	l.state = StatePaused
+	return true
+}
+
+// resume returns true when the surface was paused and frames must be requested.
+func (l *lifecycle) resume() bool {
+	l.hidden = false
+
+	if l.state != StatePaused {
+		return false
+	}
+
+	l.state = StateRunning
+	return true
+}
+
+// stop returns true when the surface was running and frames must be cancelled.
+func (l *lifecycle) stop() bool {
+	l.hidden = false
+
+	switch l.state {
+	case StateRunning:
+		l.state = StateStopped
+		return true
+	case StatePaused:
+		l.state = StateStopped
+	}
+
+	return false
+}
+
+// destroy returns true the first time, when resources must be released. Frames must be cancelled beforehand if stop
+// says so.
+func (l *lifecycle) destroy() bool {
+	if l.state == StateDestroyed {
+		return false
+	}
+
+	l.state = StateDestroyed
+	return true
+}
+
+// hide pauses a running surface when the page gets hidden. It returns true when frames must be cancelled.
+func (l *lifecycle) hide() bool {
+	if !l.pause() {
+		return false
+	}
+
+	l.hidden = true
+	return true
+}
+
+// show resumes a surface paused by hide. It returns true when frames must be requested.
+func (l *lifecycle) show() bool {
+	if !l.hidden {
+		return false
+	}
+
+	return l.resume()
+}
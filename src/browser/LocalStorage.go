@@ -0,0 +1,83 @@
+//go:build js && wasm
+// +build js,wasm
+
+package browser
+
+import (
+	"syscall/js"
+)
+
+// LocalStorage persists strings in the browser window.localStorage. In a worker, it reads the copy the page sent on
+// startup and the page saves the writes, see Page.LocalStorage.
+// When storage is unavailable (private browsing, blocked cookies, quota exceeded...) reads find nothing and writes are
+// dropped. Browsers throw rather than return null in most of these cases, a throw turns the storage off for good.
+type LocalStorage struct {
+	storage js.Value
+}
+
+func NewLocalStorage() *LocalStorage {
+	s := &LocalStorage{storage: js.Undefined()}
+
+	// Reading window.localStorage throws a SecurityError when storage is blocked, see Page.LocalStorage
+	s.try("open", func() {
+		s.storage = CurrentPage().LocalStorage()
+	})
+
+	return s
+}
+
+// Get returns the value stored under key.
+func (s *LocalStorage) Get(key string) (value string, ok bool) {
+	if !s.Available() {
+		return "", false
+	}
+
+	s.try("read", func() {
+		if item := s.storage.Call("getItem", key); !item.IsNull() {
+			value, ok = item.String(), true
+		}
+	})
+
+	return value, ok
+}
+
+// Set stores value under key.
+func (s *LocalStorage) Set(key string, value string) {
+	if s.Available() {
+		s.try("write", func() {
+			s.storage.Call("setItem", key, value)
+		})
+	}
+}
+
+// Remove deletes the value stored under key.
+func (s *LocalStorage) Remove(key string) {
+	if s.Available() {
+		s.try("write", func() {
+			s.storage.Call("removeItem", key)
+		})
+	}
+}
+
+// Available tells whether the browser provides storage.
+func (s *LocalStorage) Available() bool {
+	return !s.storage.IsUndefined() && !s.storage.IsNull()
+}
+
+// try runs f, which calls into localStorage. A JS exception thrown meanwhile is logged and makes the storage
+// unavailable, instead of crashing the program.
+func (s *LocalStorage) try(what string, f func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, ok := r.(js.Error)
+			if !ok {
+				panic(r)
+			}
+
+			js.Global().Get("console").Call("warn", "localStorage: "+what+" failed, storage is off: "+err.Error())
+			s.storage = js.Undefined()
+		}
+	}()
+
+	f()
+}
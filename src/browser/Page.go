@@ -0,0 +1,163 @@
+//go:build js && wasm
+// +build js,wasm
+
+package browser
+
+import (
+	"syscall/js"
+)
+
+// Page is the web page the program draws on and takes its input from.
+//
+// On the main thread it is the window and its document. In a Web Worker, see public/worker.js, the page transfers
+// its canvas as an OffscreenCanvas and forwards its events and state with postMessage: window events are dispatched
+// on the worker global scope, document and canvas events on stand-in event targets, so listening to them is the same
+// in both cases.
+type Page struct {
+	global js.Value
+	state  js.Value // self.page, set up by worker.js, undefined on the main thread
+}
+
+var currentPage = &Page{
+	global: js.Global(),
+	state:  js.Global().Get("page"),
+}
+
+// CurrentPage returns the page the program runs for.
+func CurrentPage() *Page {
+	return currentPage
+}
+
+// Worker tells whether the program runs in a Web Worker rather than on the page main thread.
+func (p *Page) Worker() bool {
+	return !p.state.IsUndefined()
+}
+
+// Window returns where window events (resize, blur, pointerdown...) are listened.
+func (p *Page) Window() js.Value {
+	return p.global
+}
+
+// Document returns where document events (keydown, keyup, visibilitychange, mousemove...) are listened.
+func (p *Page) Document() js.Value {
+	if p.Worker() {
+		return p.state.Get("document")
+	}
+
+	return p.global.Get("document")
+}
+
+// Canvas returns the canvas the page gave to the worker, undefined on the main thread where canvases are created.
+func (p *Page) Canvas() js.Value {
+	if p.Worker() {
+		return p.state.Get("canvas")
+	}
+
+	return js.Undefined()
+}
+
+// CanvasEvents returns where the pointer events of canvas are listened.
+func (p *Page) CanvasEvents(canvas js.Value) js.Value {
+	if p.Worker() {
+		return p.state.Get("canvasEvents")
+	}
+
+	return canvas
+}
+
+// Size returns the size of the window, in CSS pixels.
+func (p *Page) Size() (width int, height int) {
+	source := p.global
+	if p.Worker() {
+		source = p.state
+	}
+
+	return source.Get("innerWidth").Int(), source.Get("innerHeight").Int()
+}
+
+// PixelRatio returns the number of device pixels per CSS pixel.
+func (p *Page) PixelRatio() float64 {
+	source := p.global
+	if p.Worker() {
+		source = p.state
+	}
+
+	if ratio := source.Get("devicePixelRatio"); ratio.Truthy() {
+		return ratio.Float()
+	}
+
+	return 1
+}
+
+// Hidden tells whether the page is in a background tab or a minimized window.
+func (p *Page) Hidden() bool {
+	if p.Worker() {
+		return p.state.Get("hidden").Bool()
+	}
+
+	return p.Document().Get("hidden").Bool()
+}
+
+// Search returns the query string of the page URL, e.g. "?level=e1m1".
+func (p *Page) Search() string {
+	if p.Worker() {
+		return p.state.Get("search").String()
+	}
+
+	return p.global.Get("location").Get("search").String()
+}
+
+// PointerLocked tells whether the pointer is locked on element.
+func (p *Page) PointerLocked(element js.Value) bool {
+	if p.Worker() {
+		return p.state.Get("pointerLocked").Bool()
+	}
+
+	return p.Document().Get("pointerLockElement").Equal(element)
+}
+
+// Gamepads returns the connected gamepads, as navigator.getGamepads() does. Workers get the snapshot the page sent
+// on its last animation frame.
+func (p *Page) Gamepads() js.Value {
+	if p.Worker() {
+		return p.state.Get("gamepads")
+	}
+
+	navigator := p.global.Get("navigator")
+	if navigator.Get("getGamepads").IsUndefined() {
+		return js.Undefined()
+	}
+
+	return navigator.Call("getGamepads")
+}
+
+// LocalStorage returns window.localStorage. Workers get a copy the page sent on startup, writes are sent back.
+// It panics with a js.Error when the browser blocks storage: the property throws, which is only caught when read
+// through a call.
+func (p *Page) LocalStorage() js.Value {
+	if p.Worker() {
+		return p.state.Get("localStorage")
+	}
+
+	return p.global.Get("Reflect").Call("get", p.global, "localStorage")
+}
+
+// PreventKeys tells the page which key codes the program uses, so their browser default (scrolling, focusing the
+// menu...) is prevented. Forwarded events come too late to be prevented from the worker. On the main thread, key
+// events are prevented directly.
+func (p *Page) PreventKeys(codes []string) {
+	list := make([]interface{}, len(codes))
+	for i, code := range codes {
+		list[i] = code
+	}
+
+	p.Post(map[string]interface{}{"type": "preventKeys", "codes": list})
+}
+
+// Post asks the page to do what a worker can't: style the canvas, lock the pointer... It does nothing on the main
+// thread, where it is done directly.
+func (p *Page) Post(message map[string]interface{}) {
+	if p.Worker() {
+		p.global.Call("postMessage", message)
+	}
+}
@@ -0,0 +1,90 @@
+//go:build js && wasm
+// +build js,wasm
+
+package browser
+
+import (
+	"syscall/js"
+)
+
+// PointerLock captures the mouse on an element and accumulates its raw movements, for mouse-look.
+type PointerLock struct {
+	element js.Value
+	page    *Page
+	doc     js.Value
+
+	dx float64 // Movement accumulated since the last call to Delta
+	dy float64
+
+	onMove js.Func
+}
+
+// NewPointerLock starts listening to mouse movements made while element holds the pointer lock.
+func NewPointerLock(element js.Value) *PointerLock {
+	p := &PointerLock{
+		element: element,
+		page:    CurrentPage(),
+		doc:     CurrentPage().Document(),
+	}
+
+	p.onMove = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		if p.Locked() {
+			p.dx += args[0].Get("movementX").Float()
+			p.dy += args[0].Get("movementY").Float()
+		}
+		return nil
+	})
+
+	p.doc.Call("addEventListener", "mousemove", p.onMove)
+
+	return p
+}
+
+// Request asks the browser to lock the pointer, it must be called from a user gesture such as a click.
+// In a worker the page locks it on the canvas, the gesture is still recent when the message gets there.
+func (p *PointerLock) Request() {
+	if p.Locked() {
+		return
+	}
+
+	if p.page.Worker() {
+		p.page.Post(map[string]interface{}{"type": "requestPointerLock"})
+		return
+	}
+
+	p.element.Call("requestPointerLock")
+}
+
+// Exit releases the pointer if it is locked.
+func (p *PointerLock) Exit() {
+	if !p.Locked() {
+		return
+	}
+
+	if p.page.Worker() {
+		p.page.Post(map[string]interface{}{"type": "exitPointerLock"})
+		return
+	}
+
+	p.doc.Call("exitPointerLock")
+}
+
+// Locked tells whether the pointer is currently locked on the element.
+func (p *PointerLock) Locked() bool {
+	return p.page.PointerLocked(p.element)
+}
+
+// Delta returns the mouse movement in pixels since the previous call.
+func (p *PointerLock) Delta() (dx, dy float64) {
+	dx, dy = p.dx, p.dy
+	p.dx, p.dy = 0, 0
+
+	return dx, dy
+}
+
+// Release stops listening to mouse movements.
+func (p *PointerLock) Release() {
+	p.Exit()
+	p.doc.Call("removeEventListener", "mousemove", p.onMove)
+	p.onMove.Release()
+}
@@ -0,0 +1,164 @@
+package browser
+
+import (
+	"math"
+)
+
+// ScaleMode is how a frame rendered at a lower resolution is upscaled to the display.
+type ScaleMode int
+
+const (
+	// ScaleNearest stretches the frame over the display, with nearest neighbour filtering so pixels stay sharp.
+	// Pixels may get slightly different sizes when the factor isn't an integer.
+	ScaleNearest ScaleMode = iota
+	// ScaleInteger upscales the frame by the largest integer factor that fits, all pixels have the same size and
+	// borders are left around the frame.
+	ScaleInteger
+)
+
+// Resolution separates the size frames are rendered at from the size they are displayed at.
+//
+// By default frames are rendered at the display resolution in device pixels, Scale multiplies it: 0.5 renders a
+// quarter of the pixels. When Width and Height are set, frames are rendered at that logical resolution times Scale
+// whatever the display size, keeping their aspect ratio.
+type Resolution struct {
+	Mode       ScaleMode
+	Scale      float64 // Render pixels per display pixel, 1 when unset
+	Width      int     // Logical render resolution, the display resolution when unset
+	Height     int
+	PixelRatio float64 // Device pixels per CSS pixel, window.devicePixelRatio, 1 when unset
+}
+
+// Viewport is where and at which size a frame is rendered and displayed.
+type Viewport struct {
+	RenderWidth  int // Size of the frame buffer, in pixels
+	RenderHeight int
+
+	X      float64 // Position and size of the frame on the page, in CSS pixels
+	Y      float64
+	Width  float64
+	Height float64
+}
+
+// Viewport lays out frames on a display of cssWidth x cssHeight CSS pixels.
+func (r Resolution) Viewport(cssWidth int, cssHeight int) Viewport {
+	ratio := r.PixelRatio
+	if ratio <= 0 {
+		ratio = 1
+	}
+
+	scale := r.Scale
+	if scale <= 0 {
+		scale = 1
+	}
+
+	// display size, in device pixels
+	deviceWidth := math.Max(math.Floor(float64(cssWidth)*ratio), 1)
+	deviceHeight := math.Max(math.Floor(float64(cssHeight)*ratio), 1)
+
+	var renderWidth, renderHeight, factor float64
+
+	switch {
+	case r.Width > 0 && r.Height > 0:
+		renderWidth = math.Max(math.Round(float64(r.Width)*scale), 1)
+		renderHeight = math.Max(math.Round(float64(r.Height)*scale), 1)
+
+		factor = math.Min(deviceWidth/renderWidth, deviceHeight/renderHeight)
+		if r.Mode == ScaleInteger {
+			factor = math.Max(math.Floor(factor), 1)
+		}
+
+	case r.Mode == ScaleInteger:
+		// the closest integer factor, the frame fills the display but for less than a pixel of each factor
+		factor = math.Max(math.Round(1/scale), 1)
+		renderWidth = math.Max(math.Floor(deviceWidth/factor), 1)
+		renderHeight = math.Max(math.Floor(deviceHeight/factor), 1)
+
+	default:
+		renderWidth = math.Max(math.Round(deviceWidth*scale), 1)
+		renderHeight = math.Max(math.Round(deviceHeight*scale), 1)
+
+		return Viewport{
+			RenderWidth:  int(renderWidth),
+			RenderHeight: int(renderHeight),
+			Width:        deviceWidth / ratio,
+			Height:       deviceHeight / ratio,
+		}
+	}
+
+	width := renderWidth * factor / ratio
+	height := renderHeight * factor / ratio
+
+	// centered, on whole device pixels
+	return Viewport{
+		RenderWidth:  int(renderWidth),
+		RenderHeight: int(renderHeight),
+		X:            math.Floor((deviceWidth-renderWidth*factor)/2) / ratio,
+		Y:            math.Floor((deviceHeight-renderHeight*factor)/2) / ratio,
+		Width:        width,
+		Height:       height,
+	}
+}
+
+// Frames averaged before DynamicResolution changes the scale
+const dynamicResolutionWindow = 30
+
+// DynamicResolution lowers the render scale while frames take longer than a time budget, and raises it back once
+// there is time to spare.
+type DynamicResolution struct {
+	Budget   float64 // Time a frame may take to render and present, in milliseconds
+	MinScale float64
+	MaxScale float64
+	Step     float64 // Scale change on each adjustment
+
+	scale float64
+	total float64 // Time spent on the frames of the current window
+	count int
+}
+
+// NewDynamicResolution keeps frames under budget milliseconds, starting at maxScale.
+func NewDynamicResolution(budget float64, minScale float64, maxScale float64) *DynamicResolution {
+	return &DynamicResolution{
+		Budget:   budget,
+		MinScale: minScale,
+		MaxScale: maxScale,
+		Step:     0.1,
+		scale:    maxScale,
+	}
+}
+
+// Scale returns the current render scale.
+func (d *DynamicResolution) Scale() float64 {
+	return d.scale
+}
+
+// Observe records the time spent on a frame in milliseconds. It returns the scale to render at, and true when it
+// just changed.
+func (d *DynamicResolution) Observe(frameTime float64) (float64, bool) {
+	d.total += frameTime
+	d.count++
+
+	if d.count < dynamicResolutionWindow {
+		return d.scale, false
+	}
+
+	average := d.total / float64(d.count)
+	d.total, d.count = 0, 0
+
+	scale := d.scale
+
+	switch {
+	case average > d.Budget:
+		scale = math.Max(scale-d.Step, d.MinScale)
+	case average < d.Budget*0.6:
+		// rendering cost grows with the square of the scale, only go up with a good margin to avoid oscillating
+		scale = math.Min(scale+d.Step, d.MaxScale)
+	}
+
+	if scale == d.scale {
+		return d.scale, false
+	}
+
+	d.scale = scale
+	return d.scale, true
+}
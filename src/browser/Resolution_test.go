@@ -0,0 +1,60 @@
+package browser
+
+import (
+	"math"
+	"testing"
+)
+
+func TestResolutionViewport(t *testing.T) {
+	tests := []struct {
+		name     string
+		res      Resolution
+		width    int
+		height   int
+		expected Viewport
+	}{
+		{"native", Resolution{}, 800, 600, Viewport{800, 600, 0, 0, 800, 600}},
+		{"device pixels", Resolution{PixelRatio: 2}, 800, 600, Viewport{1600, 1200, 0, 0, 800, 600}},
+		{"half scale", Resolution{Scale: 0.5, PixelRatio: 2}, 801, 600, Viewport{801, 600, 0, 0, 801, 600}},
+		{"integer", Resolution{Mode: ScaleInteger, Scale: 0.3}, 1001, 600, Viewport{333, 200, 1, 0, 999, 600}},
+		{"logical nearest", Resolution{Width: 320, Height: 200}, 1000, 1000, Viewport{320, 200, 0, 187, 1000, 625}},
+		{"logical integer", Resolution{Mode: ScaleInteger, Width: 320, Height: 200, PixelRatio: 2}, 1000, 1000, Viewport{320, 200, 20, 200, 960, 600}},
+		{"logical too big", Resolution{Mode: ScaleInteger, Width: 320, Height: 200}, 100, 100, Viewport{320, 200, -110, -50, 320, 200}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.res.Viewport(test.width, test.height); got != test.expected {
+				t.Errorf("Viewport(%d, %d) = %+v, expected %+v", test.width, test.height, got, test.expected)
+			}
+		})
+	}
+}
+
+func TestDynamicResolution(t *testing.T) {
+	d := NewDynamicResolution(10, 0.25, 1)
+
+	observe := func(frameTime float64, frames int) (changes int) {
+		for i := 0; i < frames; i++ {
+			if _, changed := d.Observe(frameTime); changed {
+				changes++
+			}
+		}
+		return changes
+	}
+
+	// slow frames lower the scale once per window, down to the minimum
+	if changes := observe(20, 10*dynamicResolutionWindow); changes != 8 || d.Scale() != 0.25 {
+		t.Errorf("scale = %f after %d changes, expected 0.25 after 8", d.Scale(), changes)
+	}
+
+	// frames a bit under budget keep the scale
+	if observe(8, 5*dynamicResolutionWindow) != 0 {
+		t.Errorf("scale changed to %f with frames under budget", d.Scale())
+	}
+
+	// fast frames raise it back, up to the maximum
+	if observe(2, 10*dynamicResolutionWindow); math.Abs(d.Scale()-1) > 1e-9 {
+		t.Errorf("scale = %f with fast frames, expected 1", d.Scale())
+	}
+}
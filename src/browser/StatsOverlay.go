@@ -0,0 +1,112 @@
+package browser
+
+import (
+	"fmt"
+	"github.com/llgcode/draw2d/draw2dimg"
+	"github.com/llgcode/draw2d/draw2dkit"
+	"image/color"
+	"math"
+)
+
+// Size of the overlay panel, in pixels
+const (
+	statsOverlayWidth  = 2 * frameStatsWindow
+	statsOverlayHeight = 110
+	statsGraphHeight   = 50
+)
+
+// StatsOverlay draws frame statistics over the frame: FPS, frame times and a graph of the time spent on the last
+// frames, with the budget as a line.
+type StatsOverlay struct {
+	stats *FrameStats
+
+	Budget float64 // Frame time not to exceed, in milliseconds. Frames over it are drawn in OverColor
+
+	BackgroundColor color.RGBA
+	TextColor       color.RGBA
+	GraphColor      color.RGBA
+	OverColor       color.RGBA
+}
+
+func NewStatsOverlay(stats *FrameStats, budget float64) *StatsOverlay {
+	return &StatsOverlay{
+		stats:           stats,
+		Budget:          budget,
+		BackgroundColor: color.RGBA{0x00, 0x00, 0x00, 0xb0},
+		TextColor:       color.RGBA{0xff, 0xff, 0xff, 0xff},
+		GraphColor:      color.RGBA{0x40, 0xd0, 0x40, 0xff},
+		OverColor:       color.RGBA{0xe0, 0x40, 0x40, 0xff},
+	}
+}
+
+// Size returns the width and height of the overlay, in pixels.
+func (o *StatsOverlay) Size() (float64, float64) {
+	return statsOverlayWidth, statsOverlayHeight
+}
+
+// Draw renders the overlay with its top left corner at (x, y).
+func (o *StatsOverlay) Draw(gc *draw2dimg.GraphicContext, x, y float64) {
+	s := o.stats
+
+	gc.Save()
+	defer gc.Restore()
+
+	gc.SetFillColor(o.BackgroundColor)
+	gc.BeginPath()
+	draw2dkit.Rectangle(gc, x, y, x+statsOverlayWidth, y+statsOverlayHeight)
+	gc.Fill()
+
+	lines := []string{
+		fmt.Sprintf("%.1f FPS   %d skipped", s.FPS(), s.Skipped()),
+		fmt.Sprintf("frame  %.2f ms   p95 %.2f   p99 %.2f", s.Average(MetricTotal), s.Percentile(MetricTotal, 95), s.Percentile(MetricTotal, 99)),
+		fmt.Sprintf("render %.2f  copy %.2f  present %.2f", s.Average(MetricRender), s.Average(MetricCopy), s.Average(MetricPresent)),
+	}
+
+	gc.SetFontData(defaultFontData)
+	gc.SetFontSize(8)
+	gc.SetFillColor(o.TextColor)
+
+	for i, line := range lines {
+		gc.FillStringAt(line, x+4, y+14+float64(i)*14)
+	}
+
+	o.drawGraph(gc, x, y+statsOverlayHeight-statsGraphHeight)
+}
+
+// drawGraph draws a bar per kept frame, the oldest on the left. The scale fits twice the budget.
+func (o *StatsOverlay) drawGraph(gc *draw2dimg.GraphicContext, x, y float64) {
+	s := o.stats
+	scale := statsGraphHeight / (2 * math.Max(o.Budget, 1))
+	bottom := y + statsGraphHeight
+
+	// batch bars by color, so each is filled once
+	for _, over := range []bool{false, true} {
+		if over {
+			gc.SetFillColor(o.OverColor)
+		} else {
+			gc.SetFillColor(o.GraphColor)
+		}
+		gc.BeginPath()
+
+		for i := 0; i < s.Len(); i++ {
+			total := s.Timing(i).Total()
+			if (total > o.Budget) != over {
+				continue
+			}
+
+			left := x + float64(i*statsOverlayWidth/frameStatsWindow)
+			height := math.Min(total*scale, statsGraphHeight)
+			draw2dkit.Rectangle(gc, left, bottom-height, left+statsOverlayWidth/frameStatsWindow, bottom)
+		}
+
+		gc.Fill()
+	}
+
+	// budget line
+	gc.SetStrokeColor(o.TextColor)
+	gc.SetLineWidth(1)
+	gc.BeginPath()
+	gc.MoveTo(x, bottom-o.Budget*scale)
+	gc.LineTo(x+statsOverlayWidth, bottom-o.Budget*scale)
+	gc.Stroke()
+}
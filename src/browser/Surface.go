@@ -0,0 +1,242 @@
+package browser
+
+import (
+	"github.com/DrSmithFr/go-webassembly/src/gfx"
+	"github.com/golang/freetype/truetype"
+	"github.com/llgcode/draw2d"
+	"github.com/llgcode/draw2d/draw2dimg"
+	"image"
+	"image/draw"
+)
+
+// RenderFunc draws a frame, dt being the time elapsed since the previous frame in seconds.
+// It returns true when the frame changed and must be copied to the canvas. Only the regions passed to the Surface
+// Invalidate method are copied, or the whole frame when none were.
+type RenderFunc func(gc *draw2dimg.GraphicContext, dt float64) bool
+
+// Surface is a frame buffer drawn on by a RenderFunc and presented at most maxFPS times per second.
+// Canvas2d presents to a browser canvas, HeadlessCanvas keeps frames in memory for tests and server side rendering.
+type Surface interface {
+	Start(maxFPS float64, rf RenderFunc)
+	Pause()
+	Resume()
+	Stop()
+	Destroy()
+	State() State
+	SetFPS(maxFPS float64)
+	SetSize(width int, height int)
+	Invalidate(r image.Rectangle)
+	Stats() *FrameStats
+	Gc() *draw2dimg.GraphicContext
+	Image() *image.RGBA
+	Framebuffer() gfx.Framebuffer
+	Width() int
+	Height() int
+}
+
+var _ Surface = (*HeadlessCanvas)(nil)
+
+// presenter copies frames to the canvas, in two steps timed separately.
+type presenter interface {
+	name() string
+	resize(width int, height int)
+	copy(img *image.RGBA, rects []image.Rectangle) // Copies the rects regions of img, the only ones changed, to JS memory
+	present(rects []image.Rectangle)               // Hands the copied regions to the canvas
+	release()
+}
+
+// Font installed on every graphic context
+var defaultFontData = draw2d.FontData{
+	Name:   "roboto",
+	Family: draw2d.FontFamilySans,
+	Style:  draw2d.FontStyleNormal,
+}
+
+// newGraphicContext creates a graphic context drawing on img, with the embedded font installed.
+func newGraphicContext(img *image.RGBA) (*draw2dimg.GraphicContext, *truetype.Font) {
+	gctx := draw2dimg.NewGraphicContext(img)
+
+	font, _ := truetype.Parse(FontData["font.ttf"])
+
+	fontCache := &FontCache{}
+	fontCache.Store(defaultFontData, font)
+
+	gctx.FontCache = fontCache
+
+	return gctx, font
+}
+
+// resizeGraphicContext returns a graphic context drawing on img, carrying over the fonts and the drawing state of gctx:
+// colors, line width, font, transformation and saved states.
+func resizeGraphicContext(gctx *draw2dimg.GraphicContext, img *image.RGBA) *draw2dimg.GraphicContext {
+	resized := draw2dimg.NewGraphicContext(img)
+
+	resized.FontCache = gctx.FontCache
+	resized.DPI = gctx.DPI
+	resized.Current = gctx.Current
+
+	return resized
+}
+
+// resizeImage returns a width x height image keeping the picture of img in its top left corner, the rest is
+// transparent. The pixels of img are reused when they are enough, so shrinking doesn't allocate and growing back
+// after shrinking doesn't either.
+func resizeImage(img *image.RGBA, width int, height int) *image.RGBA {
+	size := 4 * width * height
+	if cap(img.Pix) < size {
+		resized := image.NewRGBA(image.Rect(0, 0, width, height))
+		draw.Draw(resized, img.Bounds(), img, img.Bounds().Min, draw.Src)
+		return resized
+	}
+
+	resized := &image.RGBA{
+		Pix:    img.Pix[:size],
+		Stride: 4 * width,
+		Rect:   image.Rect(0, 0, width, height),
+	}
+
+	rows := img.Rect.Dy()
+	if height < rows {
+		rows = height
+	}
+
+	columns := 4 * img.Rect.Dx()
+	if resized.Stride < columns {
+		columns = resized.Stride
+	}
+
+	// Rows move in place: towards the start when the stride shrinks, so they are moved from the top, and towards
+	// the end when it grows, so they are moved from the bottom. Either way a row never overwrites one not moved yet.
+	move := func(y int) {
+		from := img.PixOffset(img.Rect.Min.X, img.Rect.Min.Y+y)
+		to := y * resized.Stride
+
+		copy(resized.Pix[to:to+columns], img.Pix[from:from+columns])
+		clearBytes(resized.Pix[to+columns : to+resized.Stride])
+	}
+
+	if resized.Stride <= img.Stride {
+		for y := 0; y < rows; y++ {
+			move(y)
+		}
+	} else {
+		for y := rows - 1; y >= 0; y-- {
+			move(y)
+		}
+	}
+
+	clearBytes(resized.Pix[rows*resized.Stride:])
+
+	return resized
+}
+
+func clearBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
+
+// Animation frame timestamps jitter, a frame arriving this early, in milliseconds, is still rendered. Otherwise a
+// 60Hz display capped at 30 FPS would regularly skip two frames in a row.
+const frameTolerance = 1
+
+// frameLimiter decides which animation frames are rendered to honour the max FPS.
+type frameLimiter struct {
+	timeStep      float64 // Min Time delay between frames. - Calculated as   1000/maxFPS
+	lastTimestamp float64 // Timestamp of the last rendered frame, in milliseconds
+	started       bool
+}
+
+func (f *frameLimiter) setFPS(maxFPS float64) {
+	f.timeStep = 1000 / maxFPS
+}
+
+// reset makes the next frame the first one.
+func (f *frameLimiter) reset() {
+	f.started = false
+}
+
+// due tells whether the animation frame at timestamp, in milliseconds, must be rendered, and the time elapsed since
+// the previous rendered frame in seconds.
+func (f *frameLimiter) due(timestamp float64) (elapsed float64, ok bool) {
+	if !f.started { // Nothing elapsed before the first frame
+		f.started = true
+		f.lastTimestamp = timestamp
+		return 0, true
+	}
+
+	delta := timestamp - f.lastTimestamp
+	if delta < f.timeStep-frameTolerance { // Constrain FPS
+		return 0, false
+	}
+
+	f.lastTimestamp = timestamp
+	return delta / 1000, true
+}
+
+// Above this many separate dirty rectangles, or this share of the frame, presenting the whole frame at once is cheaper.
+const (
+	maxDirtyRects    = 16
+	maxDirtyCoverage = 0.5
+)
+
+// dirtyRegion collects the parts of the frame changed since it was last presented.
+type dirtyRegion struct {
+	bounds image.Rectangle
+	rects  []image.Rectangle // Disjoint rectangles inside bounds
+	full   bool              // The whole frame must be presented, whatever was invalidated
+	taken  []image.Rectangle // Returned by take, reused between frames
+}
+
+// reset makes the whole frame of the given bounds dirty, after a resize or before the first frame.
+func (d *dirtyRegion) reset(bounds image.Rectangle) {
+	d.bounds = bounds
+	d.rects = d.rects[:0]
+	d.full = true
+}
+
+// add marks r dirty, merging it with the rectangles it overlaps.
+func (d *dirtyRegion) add(r image.Rectangle) {
+	r = r.Intersect(d.bounds)
+	if d.full || r.Empty() {
+		return
+	}
+
+	// A merged rectangle may overlap rectangles it didn't before, start over until nothing overlaps
+	for merged := true; merged; {
+		merged = false
+
+		for i := 0; i < len(d.rects); i++ {
+			if d.rects[i].Overlaps(r) {
+				r = r.Union(d.rects[i])
+				d.rects = append(d.rects[:i], d.rects[i+1:]...)
+				merged = true
+				break
+			}
+		}
+	}
+
+	d.rects = append(d.rects, r)
+
+	area := 0
+	for _, rect := range d.rects {
+		area += rect.Dx() * rect.Dy()
+	}
+
+	if len(d.rects) > maxDirtyRects || float64(area) > maxDirtyCoverage*float64(d.bounds.Dx()*d.bounds.Dy()) {
+		d.full = true
+	}
+}
+
+// take returns the rectangles to present and starts collecting again. Nothing invalidated means everything changed.
+func (d *dirtyRegion) take() []image.Rectangle {
+	if d.full || len(d.rects) == 0 {
+		d.rects = append(d.rects[:0], d.bounds)
+	}
+
+	d.taken = append(d.taken[:0], d.rects...)
+	d.rects = d.rects[:0]
+	d.full = false
+
+	return d.taken
+}
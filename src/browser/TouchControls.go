@@ -0,0 +1,235 @@
+package browser
+
+import (
+	"github.com/llgcode/draw2d/draw2dimg"
+	"github.com/llgcode/draw2d/draw2dkit"
+	"image/color"
+	"math"
+)
+
+// TouchButton is an on-screen button bound to an action.
+type TouchButton struct {
+	Action Action
+	Label  string
+}
+
+// touchJoystick is the virtual stick following the finger that started it.
+type touchJoystick struct {
+	pointer  int
+	originX  float64
+	originY  float64
+	currentX float64
+	currentY float64
+}
+
+// TouchControls turns multi-touch pointers into a virtual joystick on the left half of the screen, a look area on the
+// right half and action buttons in the bottom right corner, and draws them over the game.
+// Coordinates are canvas pixels, what the controls return doesn't depend on the resolution.
+type TouchControls struct {
+	width  float64
+	height float64
+
+	buttons []TouchButton
+
+	joystick *touchJoystick
+	looking  map[int][2]float64 // Last position of each pointer dragging the look area
+	lookX    float64            // Horizontal look drag accumulated since the last call to LookDelta, in widths
+	pressed  map[int]int        // Button index held by each pointer
+
+	active bool // Set once a touch has been seen, controls are only drawn on touch devices
+}
+
+func NewTouchControls(buttons ...TouchButton) *TouchControls {
+	return &TouchControls{
+		buttons: append([]TouchButton(nil), buttons...),
+		looking: map[int][2]float64{},
+		pressed: map[int]int{},
+	}
+}
+
+// SetSize gives the size of the surface the controls are laid out on. Fingers being tracked are moved along when the
+// render resolution changes, for the next pointer events to be in the same pixels.
+func (t *TouchControls) SetSize(width int, height int) {
+	if t.width > 0 && t.height > 0 && (float64(width) != t.width || float64(height) != t.height) {
+		scaleX, scaleY := float64(width)/t.width, float64(height)/t.height
+
+		if j := t.joystick; j != nil {
+			j.originX, j.originY = j.originX*scaleX, j.originY*scaleY
+			j.currentX, j.currentY = j.currentX*scaleX, j.currentY*scaleY
+		}
+
+		for id, last := range t.looking {
+			t.looking[id] = [2]float64{last[0] * scaleX, last[1] * scaleY}
+		}
+	}
+
+	t.width = float64(width)
+	t.height = float64(height)
+}
+
+// Active tells whether the player used touch controls at least once.
+func (t *TouchControls) Active() bool {
+	return t.active
+}
+
+// PointerDown starts tracking a finger.
+func (t *TouchControls) PointerDown(id int, x, y float64) {
+	t.active = true
+
+	if button, ok := t.buttonAt(x, y); ok {
+		t.pressed[id] = button
+		return
+	}
+
+	if x < t.width/2 {
+		if t.joystick == nil {
+			t.joystick = &touchJoystick{pointer: id, originX: x, originY: y, currentX: x, currentY: y}
+		}
+		return
+	}
+
+	t.looking[id] = [2]float64{x, y}
+}
+
+// PointerMove follows a finger.
+func (t *TouchControls) PointerMove(id int, x, y float64) {
+	if t.joystick != nil && t.joystick.pointer == id {
+		t.joystick.currentX = x
+		t.joystick.currentY = y
+		return
+	}
+
+	if last, ok := t.looking[id]; ok {
+		t.lookX += (x - last[0]) / t.width
+		t.looking[id] = [2]float64{x, y}
+	}
+}
+
+// PointerUp stops tracking a finger, lifted or cancelled.
+func (t *TouchControls) PointerUp(id int) {
+	if t.joystick != nil && t.joystick.pointer == id {
+		t.joystick = nil
+	}
+
+	delete(t.looking, id)
+	delete(t.pressed, id)
+}
+
+// Move returns the joystick position, each axis from -1 to 1, Y being negative when pushed up.
+func (t *TouchControls) Move() Stick {
+	if t.joystick == nil {
+		return Stick{}
+	}
+
+	radius := t.joystickRadius()
+	dx := t.joystick.currentX - t.joystick.originX
+	dy := t.joystick.currentY - t.joystick.originY
+
+	if d := math.Hypot(dx, dy); d > radius {
+		dx *= radius / d
+		dy *= radius / d
+	}
+
+	x, y := applyDeadZone(dx/radius, dy/radius, DefaultDeadZone)
+
+	return Stick{X: x, Y: y}
+}
+
+// LookDelta returns the horizontal drag on the look area since the previous call, as a fraction of the surface width
+// so it is the same whatever the render resolution.
+func (t *TouchControls) LookDelta() float64 {
+	dx := t.lookX
+	t.lookX = 0
+
+	return dx
+}
+
+// Pressed tells whether a button bound to action is held down.
+func (t *TouchControls) Pressed(action Action) bool {
+	for _, button := range t.pressed {
+		if t.buttons[button].Action == action {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Draw renders the joystick and buttons over the frame.
+func (t *TouchControls) Draw(gc *draw2dimg.GraphicContext) {
+	if !t.active {
+		return
+	}
+
+	base := color.RGBA{0xff, 0xff, 0xff, 0x30}
+	knob := color.RGBA{0xff, 0xff, 0xff, 0x80}
+
+	if t.joystick != nil {
+		radius := t.joystickRadius()
+		move := t.Move()
+
+		t.fillCircle(gc, t.joystick.originX, t.joystick.originY, radius, base)
+		t.fillCircle(gc, t.joystick.originX+move.X*radius, t.joystick.originY+move.Y*radius, radius/2, knob)
+	}
+
+	gc.SetFontData(defaultFontData)
+
+	for i, button := range t.buttons {
+		x, y, r := t.buttonCircle(i)
+
+		fill := base
+		if t.isPressed(i) {
+			fill = knob
+		}
+		t.fillCircle(gc, x, y, r, fill)
+
+		gc.SetFillColor(color.RGBA{0xff, 0xff, 0xff, 0xc0})
+		gc.SetFontSize(r / 2)
+		left, top, right, bottom := gc.GetStringBounds(button.Label)
+		gc.FillStringAt(button.Label, x-(left+right)/2, y-(top+bottom)/2)
+	}
+}
+
+func (t *TouchControls) fillCircle(gc *draw2dimg.GraphicContext, x, y, r float64, c color.RGBA) {
+	gc.SetFillColor(c)
+	gc.BeginPath()
+	draw2dkit.Circle(gc, x, y, r)
+	gc.Fill()
+}
+
+func (t *TouchControls) isPressed(button int) bool {
+	for _, b := range t.pressed {
+		if b == button {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t *TouchControls) buttonAt(x, y float64) (int, bool) {
+	for i := range t.buttons {
+		bx, by, r := t.buttonCircle(i)
+
+		if math.Hypot(x-bx, y-by) <= r {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// buttonCircle lays buttons out from the bottom right corner, going up.
+func (t *TouchControls) buttonCircle(button int) (x, y, r float64) {
+	r = math.Min(t.width, t.height) * 0.07
+	margin := r / 2
+
+	x = t.width - margin - r
+	y = t.height - margin - r - float64(button)*(2*r+margin)
+
+	return x, y, r
+}
+
+func (t *TouchControls) joystickRadius() float64 {
+	return math.Min(t.width, t.height) * 0.12
+}
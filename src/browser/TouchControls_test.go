@@ -0,0 +1,155 @@
+package browser
+
+import (
+	"math"
+	"testing"
+)
+
+// newTestTouchControls lays out a run button on a 1000x500 surface: the joystick radius is 60 pixels and the button
+// is a circle of radius 35 centered at (947.5, 447.5).
+func newTestTouchControls() *TouchControls {
+	t := NewTouchControls(TouchButton{Action: "run", Label: "Run"})
+	t.SetSize(1000, 500)
+
+	return t
+}
+
+func TestTouchJoystick(t *testing.T) {
+	c := newTestTouchControls()
+
+	if c.Active() {
+		t.Error("controls should be inactive before any touch")
+	}
+
+	c.PointerDown(1, 200, 300)
+	if !c.Active() {
+		t.Error("a touch should activate the controls")
+	}
+
+	// pushed up by half the radius
+	c.PointerMove(1, 200, 270)
+	move := c.Move()
+	if move.X != 0 || math.Abs(move.Y+0.5) > 1e-9 {
+		t.Errorf("move %+v, expected half way up", move)
+	}
+
+	// pushed past the radius, clamped to full tilt
+	c.PointerMove(1, 500, 300)
+	if move := c.Move(); math.Abs(move.X-1) > 1e-9 || move.Y != 0 {
+		t.Errorf("move %+v, expected full right", move)
+	}
+
+	// the joystick belongs to the first finger, another one on the left half doesn't take it over
+	c.PointerDown(2, 100, 100)
+	c.PointerMove(2, 100, 400)
+	if move := c.Move(); math.Abs(move.X-1) > 1e-9 || move.Y != 0 {
+		t.Errorf("move %+v, a second finger should not move the joystick", move)
+	}
+
+	c.PointerUp(2)
+	if c.Move() == (Stick{}) {
+		t.Error("lifting the second finger should keep the joystick")
+	}
+
+	c.PointerUp(1)
+	if move := c.Move(); move != (Stick{}) {
+		t.Errorf("move %+v after lifting the finger, expected centered", move)
+	}
+
+	// the next finger gets a joystick of its own, centered where it lands
+	c.PointerDown(3, 300, 200)
+	if move := c.Move(); move != (Stick{}) {
+		t.Errorf("move %+v for a new joystick, expected centered", move)
+	}
+}
+
+func TestTouchLookWhileMoving(t *testing.T) {
+	c := newTestTouchControls()
+
+	c.PointerDown(1, 200, 300) // joystick
+	c.PointerDown(2, 700, 200) // look area
+
+	c.PointerMove(1, 200, 240)
+	c.PointerMove(2, 730, 210)
+	c.PointerMove(2, 720, 100)
+
+	// 20 pixels of 1000
+	if dx := c.LookDelta(); math.Abs(dx-0.02) > 1e-9 {
+		t.Errorf("look delta %f, expected 0.02", dx)
+	}
+
+	if dx := c.LookDelta(); dx != 0 {
+		t.Errorf("look delta %f after reading it, expected 0", dx)
+	}
+
+	if move := c.Move(); move.Y >= 0 {
+		t.Errorf("move %+v, the joystick should still be pushed up", move)
+	}
+
+	// a lifted finger stops looking
+	c.PointerUp(2)
+	c.PointerMove(2, 800, 100)
+	if dx := c.LookDelta(); dx != 0 {
+		t.Errorf("look delta %f from a lifted finger, expected 0", dx)
+	}
+}
+
+func TestTouchResolutionChange(t *testing.T) {
+	c := newTestTouchControls()
+
+	c.PointerDown(1, 200, 300)
+	c.PointerMove(1, 230, 300) // half way to the joystick radius
+	c.PointerDown(2, 700, 200)
+	c.PointerMove(2, 750, 200)
+	before := c.Move()
+
+	// the render resolution halves, pointers now report half the pixels
+	c.SetSize(500, 250)
+
+	if move := c.Move(); math.Abs(move.X-before.X) > 1e-9 || move.Y != 0 {
+		t.Errorf("move %+v after resizing, expected %+v as before", move, before)
+	}
+
+	c.PointerMove(2, 400, 100)
+	if dx := c.LookDelta(); math.Abs(dx-0.1) > 1e-9 {
+		t.Errorf("look delta %f, expected 0.1 of the width, before and after resizing", dx)
+	}
+
+	c.PointerMove(1, 100, 150)
+	if move := c.Move(); move != (Stick{}) {
+		t.Errorf("move %+v, expected the joystick back at its origin in the new pixels", move)
+	}
+}
+
+func TestTouchButtons(t *testing.T) {
+	c := newTestTouchControls()
+
+	// just outside the button circle, on the right half: looking
+	c.PointerDown(1, 947.5-36, 447.5)
+	if c.Pressed("run") {
+		t.Error("a touch outside the button should not press it")
+	}
+
+	c.PointerDown(2, 947.5+20, 447.5-20)
+	if !c.Pressed("run") || c.Pressed("jump") {
+		t.Error("a touch inside the button should press run only")
+	}
+
+	// buttons don't look around
+	c.PointerMove(2, 900, 400)
+	if dx := c.LookDelta(); dx != 0 {
+		t.Errorf("look delta %f from a button, expected 0", dx)
+	}
+
+	// held by two fingers, released once both are lifted
+	c.PointerDown(3, 947.5, 447.5)
+	c.PointerUp(2)
+	if !c.Pressed("run") {
+		t.Error("run should stay pressed by the other finger")
+	}
+
+	c.PointerUp(3)
+	if c.Pressed("run") {
+		t.Error("run should be released")
+	}
+}
@@ -0,0 +1,68 @@
+//go:build js && wasm
+// +build js,wasm
+
+package browser
+
+import (
+	"syscall/js"
+)
+
+// Bind feeds the touch pointer events of element to the controls. Mouse and pen pointers are ignored.
+// In a worker element is an OffscreenCanvas, the page forwards the events of the canvas it displays.
+func (t *TouchControls) Bind(element js.Value) {
+	page := CurrentPage()
+	target := page.CanvasEvents(element)
+
+	// Prevent the browser from scrolling or zooming while playing. In a worker, the page does it and captures pointers
+	if !page.Worker() {
+		element.Get("style").Set("touchAction", "none")
+	}
+
+	// Converts client coordinates to canvas pixels, the canvas may be displayed at another size
+	position := func(event js.Value) (float64, float64) {
+		rect := event.Get("canvasRect") // Sent along by the page, workers can't measure it
+		if !page.Worker() {
+			rect = element.Call("getBoundingClientRect")
+		}
+		scaleX := element.Get("width").Float() / rect.Get("width").Float()
+		scaleY := element.Get("height").Float() / rect.Get("height").Float()
+
+		x := (event.Get("clientX").Float() - rect.Get("left").Float()) * scaleX
+		y := (event.Get("clientY").Float() - rect.Get("top").Float()) * scaleY
+
+		return x, y
+	}
+
+	listen := func(name string, handle func(event js.Value)) {
+		target.Call("addEventListener", name, js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			event := args[0]
+			if event.Get("pointerType").String() != "touch" {
+				return nil
+			}
+
+			event.Call("preventDefault")
+			handle(event)
+			return nil
+		}))
+	}
+
+	listen("pointerdown", func(event js.Value) {
+		if !page.Worker() {
+			element.Call("setPointerCapture", event.Get("pointerId"))
+		}
+
+		x, y := position(event)
+		t.PointerDown(event.Get("pointerId").Int(), x, y)
+	})
+
+	listen("pointermove", func(event js.Value) {
+		x, y := position(event)
+		t.PointerMove(event.Get("pointerId").Int(), x, y)
+	})
+
+	for _, name := range []string{"pointerup", "pointercancel"} {
+		listen(name, func(event js.Value) {
+			t.PointerUp(event.Get("pointerId").Int())
+		})
+	}
+}
@@ -0,0 +1,241 @@
+//go:build js && wasm
+// +build js,wasm
+
+package browser
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"syscall/js"
+)
+
+// errWebGLUnsupported is returned when the browser has no WebGL at all, the canvas is then left untouched.
+var errWebGLUnsupported = errors.New("webgl: not supported")
+
+// Draws the frame texture over the whole canvas, as a strip of two triangles.
+const webglVertexShader = `
+attribute vec2 position;
+varying vec2 texCoord;
+
+void main() {
+	// Go images start at the top left, clip space at the bottom left
+	texCoord = vec2(position.x + 1.0, 1.0 - position.y) * 0.5;
+	gl_Position = vec4(position, 0.0, 1.0);
+}
+`
+
+const webglFragmentShader = `
+precision mediump float;
+uniform sampler2D frame;
+varying vec2 texCoord;
+
+void main() {
+	gl_FragColor = texture2D(frame, texCoord);
+}
+`
+
+// webglPresenter uploads frames as a texture and lets the GPU draw them on the canvas, which is much cheaper than
+// putImageData on large canvases.
+type webglPresenter struct {
+	canvas js.Value
+	gl     js.Value
+
+	program js.Value
+	quad    js.Value
+	texture js.Value
+
+	width  int
+	height int
+
+	pixels js.Value // Static JS buffer the frame is copied to before the upload
+	buffer js.Value // ArrayBuffer behind pixels, kept when shrinking
+	lost   bool     // The GPU dropped the context, frames are skipped until it is restored
+
+	onLost     js.Func
+	onRestored js.Func
+}
+
+// newWebGLPresenter sets WebGL up on canvas, failing when the browser or the GPU doesn't support it.
+// The canvas can't be given a 2D context once this succeeded.
+func newWebGLPresenter(canvas js.Value, width int, height int) (*webglPresenter, error) {
+	options := map[string]interface{}{
+		"alpha":                 false,
+		"antialias":             false,
+		"depth":                 false,
+		"stencil":               false,
+		"premultipliedAlpha":    false,
+		"preserveDrawingBuffer": false,
+	}
+
+	gl := canvas.Call("getContext", "webgl", options)
+	if gl.IsNull() || gl.IsUndefined() {
+		gl = canvas.Call("getContext", "experimental-webgl", options)
+	}
+	if gl.IsNull() || gl.IsUndefined() {
+		return nil, errWebGLUnsupported
+	}
+
+	p := &webglPresenter{canvas: canvas, gl: gl}
+
+	if err := p.init(); err != nil {
+		return nil, err
+	}
+
+	p.resize(width, height)
+
+	// The GPU may drop the context at any time (driver reset, too many contexts...). Preventing the default
+	// behaviour lets the browser restore it, everything must then be created again.
+	p.onLost = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		args[0].Call("preventDefault")
+		p.lost = true
+		return nil
+	})
+	p.onRestored = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		if err := p.init(); err != nil {
+			js.Global().Get("console").Call("error", err.Error())
+			return nil
+		}
+		p.resize(p.width, p.height)
+		p.lost = false
+		return nil
+	})
+
+	canvas.Call("addEventListener", "webglcontextlost", p.onLost)
+	canvas.Call("addEventListener", "webglcontextrestored", p.onRestored)
+
+	return p, nil
+}
+
+// init creates the program, the quad and the texture.
+func (p *webglPresenter) init() error {
+	gl := p.gl
+
+	vertex, err := p.compile(gl.Get("VERTEX_SHADER"), webglVertexShader)
+	if err != nil {
+		return err
+	}
+
+	fragment, err := p.compile(gl.Get("FRAGMENT_SHADER"), webglFragmentShader)
+	if err != nil {
+		return err
+	}
+
+	p.program = gl.Call("createProgram")
+	gl.Call("attachShader", p.program, vertex)
+	gl.Call("attachShader", p.program, fragment)
+	gl.Call("linkProgram", p.program)
+
+	if !gl.Call("getProgramParameter", p.program, gl.Get("LINK_STATUS")).Bool() {
+		return fmt.Errorf("webgl: link failed: %s", gl.Call("getProgramInfoLog", p.program).String())
+	}
+
+	gl.Call("useProgram", p.program)
+
+	// Fullscreen quad, in clip space
+	vertices := js.Global().Get("Float32Array").New(js.ValueOf([]interface{}{-1, -1, 1, -1, -1, 1, 1, 1}))
+
+	p.quad = gl.Call("createBuffer")
+	gl.Call("bindBuffer", gl.Get("ARRAY_BUFFER"), p.quad)
+	gl.Call("bufferData", gl.Get("ARRAY_BUFFER"), vertices, gl.Get("STATIC_DRAW"))
+
+	position := gl.Call("getAttribLocation", p.program, "position")
+	gl.Call("enableVertexAttribArray", position)
+	gl.Call("vertexAttribPointer", position, 2, gl.Get("FLOAT"), false, 0, 0)
+
+	// Frames aren't powers of two, WebGL 1 only samples those textures without mipmaps and clamped to the edges.
+	// Nearest filtering keeps pixels sharp when the canvas is scaled.
+	p.texture = gl.Call("createTexture")
+	gl.Call("activeTexture", gl.Get("TEXTURE0"))
+	gl.Call("bindTexture", gl.Get("TEXTURE_2D"), p.texture)
+	gl.Call("texParameteri", gl.Get("TEXTURE_2D"), gl.Get("TEXTURE_MIN_FILTER"), gl.Get("NEAREST"))
+	gl.Call("texParameteri", gl.Get("TEXTURE_2D"), gl.Get("TEXTURE_MAG_FILTER"), gl.Get("NEAREST"))
+	gl.Call("texParameteri", gl.Get("TEXTURE_2D"), gl.Get("TEXTURE_WRAP_S"), gl.Get("CLAMP_TO_EDGE"))
+	gl.Call("texParameteri", gl.Get("TEXTURE_2D"), gl.Get("TEXTURE_WRAP_T"), gl.Get("CLAMP_TO_EDGE"))
+	gl.Call("uniform1i", gl.Call("getUniformLocation", p.program, "frame"), 0)
+
+	return nil
+}
+
+func (p *webglPresenter) compile(kind js.Value, source string) (js.Value, error) {
+	gl := p.gl
+
+	shader := gl.Call("createShader", kind)
+	gl.Call("shaderSource", shader, source)
+	gl.Call("compileShader", shader)
+
+	if !gl.Call("getShaderParameter", shader, gl.Get("COMPILE_STATUS")).Bool() {
+		return js.Null(), fmt.Errorf("webgl: shader compilation failed: %s", gl.Call("getShaderInfoLog", shader).String())
+	}
+
+	return shader, nil
+}
+
+func (p *webglPresenter) name() string {
+	return "webgl"
+}
+
+// resize allocates the texture for frames of the given size, and the copy buffer when they are larger than ever.
+func (p *webglPresenter) resize(width int, height int) {
+	gl := p.gl
+
+	p.width = width
+	p.height = height
+
+	size := width * height * 4
+	if p.buffer.IsUndefined() || p.buffer.Get("byteLength").Int() < size {
+		p.buffer = js.Global().Get("ArrayBuffer").New(size)
+	}
+	p.pixels = js.Global().Get("Uint8Array").New(p.buffer, 0, size)
+
+	gl.Call("viewport", 0, 0, width, height)
+	gl.Call("texImage2D", gl.Get("TEXTURE_2D"), 0, gl.Get("RGBA"), width, height, 0, gl.Get("RGBA"), gl.Get("UNSIGNED_BYTE"), p.pixels)
+}
+
+// copy copies the dirty regions of img to JS memory.
+func (p *webglPresenter) copy(img *image.RGBA, rects []image.Rectangle) {
+	for _, r := range rects {
+		if r.Empty() {
+			continue
+		}
+
+		start, end := img.PixOffset(0, r.Min.Y), img.PixOffset(0, r.Max.Y)
+		js.CopyBytesToJS(p.pixels.Call("subarray", start, end), img.Pix[start:end])
+	}
+}
+
+// present uploads the dirty regions to the texture, and draws it.
+func (p *webglPresenter) present(rects []image.Rectangle) {
+	if p.lost {
+		return
+	}
+
+	gl := p.gl
+	stride := 4 * p.width
+
+	for _, r := range rects {
+		if r.Empty() {
+			continue
+		}
+
+		// WebGL 1 can't upload part of a row from a larger buffer, send the rows the rectangle spans
+		rows := p.pixels.Call("subarray", r.Min.Y*stride, r.Max.Y*stride)
+		gl.Call("texSubImage2D", gl.Get("TEXTURE_2D"), 0, 0, r.Min.Y, p.width, r.Dy(), gl.Get("RGBA"), gl.Get("UNSIGNED_BYTE"), rows)
+	}
+
+	gl.Call("drawArrays", gl.Get("TRIANGLE_STRIP"), 0, 4)
+}
+
+// release stops listening to the canvas and frees the GPU resources.
+func (p *webglPresenter) release() {
+	p.canvas.Call("removeEventListener", "webglcontextlost", p.onLost)
+	p.canvas.Call("removeEventListener", "webglcontextrestored", p.onRestored)
+	p.onLost.Release()
+	p.onRestored.Release()
+
+	if !p.lost {
+		p.gl.Call("deleteTexture", p.texture)
+		p.gl.Call("deleteBuffer", p.quad)
+		p.gl.Call("deleteProgram", p.program)
+	}
+}
@@ -0,0 +1,86 @@
+package gfx
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+var (
+	fbRed   = color.RGBA{0xff, 0x00, 0x00, 0xff}
+	fbGreen = color.RGBA{0x00, 0xff, 0x00, 0xff}
+	fbBlue  = color.RGBA{0x00, 0x00, 0xff, 0x80}
+)
+
+// assertPixels checks every pixel of img, want returning the expected color of each.
+func assertPixels(t *testing.T, img *image.RGBA, want func(x, y int) color.RGBA) {
+	t.Helper()
+
+	for y := img.Rect.Min.Y; y < img.Rect.Max.Y; y++ {
+		for x := img.Rect.Min.X; x < img.Rect.Max.X; x++ {
+			if got, expected := img.RGBAAt(x, y), want(x, y); got != expected {
+				t.Fatalf("pixel (%d, %d) is %v, expected %v", x, y, got, expected)
+			}
+		}
+	}
+}
+
+func TestFramebufferSpans(t *testing.T) {
+	fb := NewFramebuffer(image.NewRGBA(image.Rect(0, 0, 8, 6)))
+
+	fb.PutPixel(0, 0, fbBlue)
+	fb.PutPixel(8, 0, fbBlue) // clipped
+	fb.PutPixel(-1, 2, fbBlue)
+	fb.HSpan(-2, 3, 5, fbRed)
+	fb.VSpan(6, 2, 10, fbGreen)
+
+	assertPixels(t, fb.Image(), func(x, y int) color.RGBA {
+		switch {
+		case x == 0 && y == 0:
+			return fbBlue
+		case y == 5 && x < 3:
+			return fbRed
+		case x == 6 && y >= 2:
+			return fbGreen
+		}
+		return color.RGBA{}
+	})
+}
+
+func TestFramebufferFillRect(t *testing.T) {
+	// odd sizes and an offset origin, so rows don't start at the beginning of the buffer
+	img := image.NewRGBA(image.Rect(2, 3, 15, 12))
+	fb := NewFramebuffer(img)
+
+	fb.FillRect(image.Rect(0, 5, 7, 20), fbRed)
+	fb.FillRect(image.Rect(20, 0, 30, 4), fbGreen) // outside
+
+	assertPixels(t, img, func(x, y int) color.RGBA {
+		if x < 7 && y >= 5 {
+			return fbRed
+		}
+		return color.RGBA{}
+	})
+}
+
+func TestFramebufferBlit(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			src.SetRGBA(x, y, color.RGBA{uint8(x), uint8(y), 0, 0xff})
+		}
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, 5, 5))
+	fb := NewFramebuffer(img)
+
+	// the 3x3 bottom right of src, its last column and row falling off the frame
+	fb.Blit(image.Pt(3, -1), src, image.Rect(1, 1, 5, 4))
+
+	assertPixels(t, img, func(x, y int) color.RGBA {
+		if x >= 3 && y < 2 {
+			return color.RGBA{uint8(x - 2), uint8(y + 2), 0, 0xff}
+		}
+		return color.RGBA{}
+	})
+}
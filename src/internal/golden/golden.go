@@ -0,0 +1,168 @@
+// Package golden compares rendered images with reference PNGs checked in
+// under testdata/golden.
+//
+// Run the tests with -update to write the current renders as the new
+// references, after checking the change is intended:
+//
+//	go test ./src/wolfenstein ./src/browser -update
+//
+// On a mismatch, the render and an image highlighting the differences are
+// written under testdata/failed.
+package golden
+
+import (
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "write renders as the new golden images")
+
+// Directories, relative to the package under test.
+const (
+	goldenDir = "testdata/golden"
+	failedDir = "testdata/failed"
+)
+
+// Tolerance is how far a render may drift from its golden image, to absorb
+// floating point differences between platforms.
+type Tolerance struct {
+	Channel uint8   // largest difference on a color channel for pixels to be equal
+	Pixels  float64 // fraction of the pixels allowed to differ, from 0 to 1
+}
+
+// Exact accepts no difference at all.
+var Exact = Tolerance{}
+
+// Default ignores slight color drifts and a few pixels moved along edges.
+var Default = Tolerance{Channel: 8, Pixels: 0.002}
+
+// Assert compares img with the golden image name, or writes it with -update.
+func Assert(t testing.TB, name string, img *image.RGBA, tolerance Tolerance) {
+	t.Helper()
+
+	path := filepath.Join(goldenDir, name+".png")
+
+	if *update {
+		if err := write(path, img); err != nil {
+			t.Fatalf("golden %s: %v", name, err)
+		}
+		return
+	}
+
+	want, err := read(path)
+	if err != nil {
+		t.Fatalf("golden %s: %v (run the test with -update to create it)", name, err)
+	}
+
+	if want.Bounds().Size() != img.Bounds().Size() {
+		t.Fatalf("golden %s: render is %v, golden image is %v", name, img.Bounds().Size(), want.Bounds().Size())
+	}
+
+	diff, count := compare(want, img, tolerance.Channel)
+	total := img.Bounds().Dx() * img.Bounds().Dy()
+
+	if float64(count) <= tolerance.Pixels*float64(total) {
+		return
+	}
+
+	actualPath := filepath.Join(failedDir, name+".actual.png")
+	diffPath := filepath.Join(failedDir, name+".diff.png")
+
+	for path, img := range map[string]image.Image{actualPath: img, diffPath: diff} {
+		if err := write(path, img); err != nil {
+			t.Errorf("golden %s: %v", name, err)
+		}
+	}
+
+	t.Errorf(
+		"golden %s: %d of %d pixels differ by more than %d, see %s and %s",
+		name, count, total, tolerance.Channel, actualPath, diffPath,
+	)
+}
+
+// compare returns an image of the differences, differing pixels in red over
+// a faded copy of the golden image, and the number of differing pixels.
+func compare(want, got *image.RGBA, channel uint8) (*image.RGBA, int) {
+	size := got.Bounds().Size()
+	diff := image.NewRGBA(image.Rect(0, 0, size.X, size.Y))
+	count := 0
+
+	for y := 0; y < size.Y; y++ {
+		for x := 0; x < size.X; x++ {
+			w := want.RGBAAt(want.Bounds().Min.X+x, want.Bounds().Min.Y+y)
+			g := got.RGBAAt(got.Bounds().Min.X+x, got.Bounds().Min.Y+y)
+
+			if distance(w, g) > channel {
+				diff.SetRGBA(x, y, color.RGBA{0xff, 0x00, 0x00, 0xff})
+				count++
+				continue
+			}
+
+			gray := uint8((uint16(w.R) + uint16(w.G) + uint16(w.B)) / 3 / 4)
+			diff.SetRGBA(x, y, color.RGBA{gray, gray, gray, 0xff})
+		}
+	}
+
+	return diff, count
+}
+
+// distance is the largest difference between the channels of a and b.
+func distance(a, b color.RGBA) uint8 {
+	var d uint8
+
+	for _, c := range [][2]uint8{{a.R, b.R}, {a.G, b.G}, {a.B, b.B}, {a.A, b.A}} {
+		v := c[0] - c[1]
+		if c[1] > c[0] {
+			v = c[1] - c[0]
+		}
+
+		if v > d {
+			d = v
+		}
+	}
+
+	return d
+}
+
+func read(path string) (*image.RGBA, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	img, err := png.Decode(file)
+	if err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", path, err)
+	}
+
+	rgba := image.NewRGBA(img.Bounds())
+	draw.Draw(rgba, rgba.Bounds(), img, img.Bounds().Min, draw.Src)
+
+	return rgba, nil
+}
+
+func write(path string, img image.Image) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := png.Encode(file, img); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
@@ -1,3 +1,6 @@
+//go:build js && wasm
+// +build js,wasm
+
 package main
 
 import (
@@ -5,59 +8,188 @@ import (
 	"github.com/DrSmithFr/go-webassembly/src/browser"
 	"github.com/DrSmithFr/go-webassembly/src/wolfenstein"
 	"github.com/llgcode/draw2d/draw2dimg"
-	"github.com/llgcode/draw2d/draw2dkit"
 	"image/color"
 	"math"
 	"runtime"
+	"strconv"
 	"syscall/js"
 )
 
 var DOM *browser.DOM
 var cvs *browser.Canvas2d
 var gs *wolfenstein.GameState
+var renderer *wolfenstein.Renderer
+var minimap *wolfenstein.Minimap
+var loop *browser.GameLoop
+var pointer *browser.PointerLock
+var overlay *browser.StatsOverlay
+
+// the canvas size follows the page layout through a ResizeObserver, rather than window resize events
+var observed bool
+var touch = browser.NewTouchControls(
+	browser.TouchButton{Action: actionRun, Label: "RUN"},
+)
+
+// simulation ticks per second, independent of the rendering frame rate
+const tickRate = 60
+
+// radians turned per pixel of mouse movement
+const mouseSensitivity = 0.003
+
+// radians turned by dragging across the whole width of the touch look area
+const touchSensitivity = 4
+
+// minimap is drawn over the first person view at this scale, on a frame of minimapReferenceWidth pixels
+const minimapScale = 0.25
+const minimapReferenceWidth = 1280
+
+// frames are rendered at this fraction of the display resolution, then lowered while they take longer than
+// frameBudget milliseconds, down to minRenderScale
+const renderScale = 0.5
+const minRenderScale = 0.2
+const frameBudget = 12
+
+// performance overlay, shown from the start with ?stats=on
+var showStats bool
+var statsHeld bool
+
+// actions the player can bind keys to
+const (
+	actionForward     browser.Action = "forward"
+	actionBackward    browser.Action = "backward"
+	actionTurnLeft    browser.Action = "turnLeft"
+	actionTurnRight   browser.Action = "turnRight"
+	actionStrafeLeft  browser.Action = "strafeLeft"
+	actionStrafeRight browser.Action = "strafeRight"
+	actionStrafe      browser.Action = "strafe" // turns turnLeft / turnRight into strafing
+	actionRun         browser.Action = "run"
+	actionStats       browser.Action = "stats" // toggles the performance overlay
+)
 
-type move struct {
-	up    bool
-	down  bool
-	left  bool
-	right bool
+var defaultBindings = map[browser.Action][]string{
+	actionForward:     {"ArrowUp", "KeyW"},
+	actionBackward:    {"ArrowDown", "KeyS"},
+	actionTurnLeft:    {"ArrowLeft", "KeyA"},
+	actionTurnRight:   {"ArrowRight", "KeyD"},
+	actionStrafeLeft:  {"KeyQ"},
+	actionStrafeRight: {"KeyE"},
+	actionStrafe:      {"AltLeft", "AltRight"},
+	actionRun:         {"ShiftLeft", "ShiftRight"},
+	actionStats:       {"F3"},
 }
 
-var keyboard = move{false, false, false, false}
+// localStorage key of the player key bindings
+const bindingsStorageKey = "wolfenstein.bindings"
 
-var width float64
-var height float64
+var keyboard = browser.NewInputMap(defaultBindings)
+
+// standard layout gamepad buttons, sticks are read separately
+var gamepad = browser.NewGamepad(map[int]browser.Action{
+	browser.ButtonDPadUp:      actionForward,
+	browser.ButtonDPadDown:    actionBackward,
+	browser.ButtonDPadLeft:    actionTurnLeft,
+	browser.ButtonDPadRight:   actionTurnRight,
+	browser.ButtonLeftBumper:  actionStrafeLeft,
+	browser.ButtonRightBumper: actionStrafeRight,
+	browser.ButtonLeftStick:   actionRun,
+	browser.ButtonA:           actionRun,
+})
 
 func main() {
 	// loading DOM to memory
 	DOM = browser.LoadDOM()
 
+	// restoring the player key bindings
+	if err := keyboard.Persist(browser.NewLocalStorage(), bindingsStorageKey); err != nil {
+		DOM.Log(fmt.Sprintf("ignoring saved key bindings: %s", err))
+	}
+
+	// in a worker, the page must know the bound keys to prevent their browser default
+	keyboard.OnChange(preventBoundKeys)
+	preventBoundKeys()
+
 	// setting up everything
 	bindEvents(*DOM)
+	exposeControls()
 
-	// create canvas
+	// create canvas, rendered at a lower resolution than the window and upscaled with sharp pixels
 	cvs, _ = browser.NewCanvas2d(false)
-	cvs.Create(
-		js.Global().Get("innerWidth").Int(),
-		js.Global().Get("innerHeight").Int(),
-	)
+	res := resolution()
+	cvs.SetResolution(res)
+
+	if DOM.QueryParam("dynamic", "on") != "off" {
+		cvs.SetDynamicResolution(browser.NewDynamicResolution(frameBudget, math.Min(minRenderScale, res.Scale), res.Scale))
+	}
+
+	cvs.Create(browser.CurrentPage().Size())
+
+	observed = cvs.ObserveResize()
+
+	// mouse-look once the pointer is locked on the canvas
+	pointer = browser.NewPointerLock(cvs.Element())
 
-	DOM.Log(fmt.Sprintf("number of thread: %d", runtime.NumCPU()))
+	// virtual joystick and buttons for tablets
+	touch.Bind(cvs.Element())
+
+	DOM.Log(fmt.Sprintf("number of thread: %d, rendering on %d", runtime.NumCPU(), runtime.GOMAXPROCS(0)))
 
 	// create gameState
-	gs, _ = wolfenstein.NewGameState(cvs.Width(), cvs.Height())
+	level, err := loadLevel(DOM.QueryParam("level", "e1m1"))
+
+	if err != nil {
+		DOM.Log(fmt.Sprintf("cannot load level, falling back to default: %s", err))
+		level = wolfenstein.DefaultLevel()
+	}
+
+	gs, err = wolfenstein.NewGameStateFromLevel(level)
+
+	if err != nil {
+		panic(err)
+	}
 
-	height = float64(cvs.Height())
-	width = float64(cvs.Width())
+	renderer = wolfenstein.NewRenderer(gs)
+	minimap = wolfenstein.NewMinimap(gs, minimapScale)
+	overlay = browser.NewStatsOverlay(cvs.Stats(), frameBudget)
+	showStats = DOM.QueryParam("stats", "off") == "on"
 
 	// starting rendering
-	cvs.Start(120, Render)
+	loop = browser.NewGameLoop(tickRate, Update, Render)
+	cvs.Start(120, loop.Frame)
 
 	// allow daemon style process
 	emptyChanToKeepAppRunning := make(chan bool)
 	<-emptyChanToKeepAppRunning
 }
 
+// resolution reads the render resolution from the page URL, e.g. ?scale=0.25&pixels=integer
+func resolution() browser.Resolution {
+	res := browser.Resolution{
+		Mode:  browser.ScaleNearest,
+		Scale: renderScale,
+	}
+
+	if scale, err := strconv.ParseFloat(DOM.QueryParam("scale", ""), 64); err == nil && scale > 0 && scale <= 1 {
+		res.Scale = scale
+	}
+
+	if DOM.QueryParam("pixels", "nearest") == "integer" {
+		res.Mode = browser.ScaleInteger
+	}
+
+	return res
+}
+
+// loadLevel downloads and validates public/levels/<name>.json
+func loadLevel(name string) (*wolfenstein.Level, error) {
+	data, err := DOM.Fetch(fmt.Sprintf("levels/%s.json", name))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wolfenstein.ParseLevel(data)
+}
+
 func bindEvents(DOM browser.DOM) {
 	// let's handle windows resize
 	var resizeEventHandler = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
@@ -81,6 +213,14 @@ func bindEvents(DOM browser.DOM) {
 	DOM.Document.Call("addEventListener", "keydown", keydownEventHandler)
 	DOM.Document.Call("addEventListener", "keyup", keyupEventHandler)
 
+	// keys released while the page has no focus never send keyup
+	var blurEventHandler = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		keyboard.ReleaseAll()
+		return nil
+	})
+
+	DOM.Window.Call("addEventListener", "blur", blurEventHandler)
+
 	// let's handle that mouse pointer down
 	var mouseEventHandler = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
 		clickEvent(DOM, args[0])
@@ -91,13 +231,12 @@ func bindEvents(DOM browser.DOM) {
 }
 
 func resizeEvent(DOM browser.DOM, event js.Value) {
-	windowsWidth := js.Global().Get("innerWidth").Int()
-	windowsHeight := js.Global().Get("innerHeight").Int()
-
-	cvs.SetSize(windowsWidth, windowsHeight)
+	windowsWidth, windowsHeight := browser.CurrentPage().Size()
 
-	width = float64(windowsWidth)
-	height = float64(windowsHeight)
+	// resizes are debounced by the canvas, and applied between frames
+	if !observed {
+		cvs.SetDisplaySize(windowsWidth, windowsHeight)
+	}
 
 	go DOM.Log(fmt.Sprintf("resizeEvent x:%d y:%d", windowsWidth, windowsHeight))
 }
@@ -105,312 +244,184 @@ func resizeEvent(DOM browser.DOM, event js.Value) {
 func keydownEvent(DOM browser.DOM, event js.Value) {
 	code := event.Get("code").String()
 
-	switch code {
-	case "ArrowUp", "KeyW":
-		keyboard.up = true
-	case "ArrowDown", "KeyS":
-		keyboard.down = true
-	case "ArrowRight", "KeyD":
-		keyboard.right = true
-	case "ArrowLeft", "KeyA":
-		keyboard.left = true
+	if keyboard.KeyDown(code) {
+		// Alt would focus the browser menu, arrows would scroll
+		event.Call("preventDefault")
 	}
 
 	//go DOM.Log(fmt.Sprintf("key down:%s", code))
 }
 
+// preventBoundKeys sends the codes of the bound keys to the page, when running in a worker.
+func preventBoundKeys() {
+	codes := []string{}
+	for _, action := range keyboard.Actions() {
+		codes = append(codes, keyboard.Bindings(action)...)
+	}
+
+	browser.CurrentPage().PreventKeys(codes)
+}
+
 func keyupEvent(DOM browser.DOM, event js.Value) {
 	code := event.Get("code").String()
 
-	switch code {
-	case "ArrowUp", "KeyW":
-		keyboard.up = false
-	case "ArrowDown", "KeyS":
-		keyboard.down = false
-	case "ArrowRight", "KeyD":
-		keyboard.right = false
-	case "ArrowLeft", "KeyA":
-		keyboard.left = false
-	}
+	keyboard.KeyUp(code)
 
 	//go DOM.Log(fmt.Sprintf("key up:%s", code))
 }
 
+// exposeControls lets the page rebind keys at runtime through window.controls:
+//
+//	controls.bind("forward", "KeyZ")
+//	controls.unbind("forward", "KeyW")
+//	controls.capture("forward") // the next key pressed is bound to forward
+//	controls.reset()
+//	controls.bindings() // {forward: ["ArrowUp", "KeyW", "KeyZ"], ...}
+func exposeControls() {
+	js.Global().Set("controls", js.ValueOf(map[string]interface{}{
+		"bind": js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			keyboard.Bind(browser.Action(args[0].String()), args[1].String())
+			return nil
+		}),
+		"unbind": js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			keyboard.Unbind(browser.Action(args[0].String()), args[1].String())
+			return nil
+		}),
+		"capture": js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			keyboard.Capture(browser.Action(args[0].String()))
+			return nil
+		}),
+		"reset": js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			keyboard.Reset()
+			return nil
+		}),
+		"bindings": js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			bindings := map[string]interface{}{}
+
+			for _, action := range keyboard.Actions() {
+				codes := []interface{}{}
+				for _, code := range keyboard.Bindings(action) {
+					codes = append(codes, code)
+				}
+
+				bindings[string(action)] = codes
+			}
+
+			return js.ValueOf(bindings)
+		}),
+	}))
+}
+
 func clickEvent(DOM browser.DOM, event js.Value) {
 	mouseX := event.Get("clientX").Int()
 	mouseY := event.Get("clientY").Int()
 
 	go DOM.Log(fmt.Sprintf("mouseEvent x:%d y:%d", mouseX, mouseY))
-}
-
-func Render(gc *draw2dimg.GraphicContext) bool {
-	// render default color
-	gc.SetFillColor(color.RGBA{0x18, 0x18, 0x18, 0xff})
-	gc.Clear()
-
-	renderLevel(gc)
-	renderPlayer(gc)
 
-	if false {
-		renderRayCasting(gc)
-	}else {
-		gs.RenderRay(gc)
+	// fingers drive the touch controls instead
+	if event.Get("pointerType").String() != "touch" {
+		pointer.Request()
 	}
+}
 
+// Update advances the game by one fixed tick
+func Update(dt float64) {
 	handleMove()
+	gs.Update(dt)
 
-	return true
+	// toggle once per key press, not on every tick it is held
+	stats := pressed(actionStats)
+	if stats && !statsHeld {
+		showStats = !showStats
+	}
+	statsHeld = stats
 }
 
-func renderRayCasting(gc *draw2dimg.GraphicContext) {
-	gc.BeginPath()
-
-	var rayX, rayY, rayAngle float64
-	var rayTargetX, rayTargetY float64
-	var mapX, mapY, mapIndex int
-	var dof int
-	var distT float64
-
-	level := gs.GetLevel()
-	blocSize := gs.GetBlockSize()
-	mapSize := gs.GetMapSize()
-	playerX, playerY, _, _ := gs.GetPlayerPosition()
-
-	oneRadian := 0.0174533
-	rayAngle = gs.GetPlayerAngle()
-
-	if rayAngle < 0 {
-		rayAngle += 2 * math.Pi
-	} else if rayAngle > 2*math.Pi {
-		rayAngle -= 2 * math.Pi
-	}
+// Render draws the game between the last two ticks
+func Render(gc *draw2dimg.GraphicContext, alpha float64) bool {
+	// render default color
+	gc.SetFillColor(color.RGBA{0x18, 0x18, 0x18, 0xff})
+	gc.Clear()
 
-	for rayN := 0; rayN < 1; rayN++ {
-		// check Horizontal
-		dof = 0
-		aTan := -1 / math.Tan(rayAngle)
-
-		distH := 1000000.0
-		hx := playerX
-		hy := playerY
-
-		if rayAngle > math.Pi {
-			// looking up
-			rayY = math.Trunc(playerY/float64(blocSize))*float64(blocSize) - 1
-			rayX = (playerY-rayY)*aTan + playerX
-
-			rayTargetY = - float64(blocSize)
-			rayTargetX = - rayTargetY * aTan
-		} else if rayAngle < math.Pi {
-			// looking down
-			rayY = math.Trunc(playerY/float64(blocSize))*float64(blocSize) + float64(blocSize)
-			rayX = (playerY-rayY)*aTan + playerX
-
-			rayTargetY = float64(blocSize)
-			rayTargetX = - rayTargetY * aTan
-		}
+	cam := gs.CameraAt(alpha)
 
-		if rayAngle == 0 || rayAngle == math.Pi {
-			rayX = playerX
-			rayY = playerY
-			dof = 8
-		}
+	// the render resolution changes with the window and the dynamic resolution
+	minimap.Scale = minimapScale * float64(cvs.Width()) / minimapReferenceWidth
+	touch.SetSize(cvs.Width(), cvs.Height())
 
-		for ; dof < 8; {
-			mapX = int(math.Trunc(rayX / float64(blocSize)))
-			mapY = int(math.Trunc((rayY) / float64(blocSize)))
-
-			mapIndex = mapY*mapSize + mapX
-
-			// hit wall
-			if mapIndex > 0 && mapIndex < mapSize*mapSize && level[mapIndex] == 1 {
-				dof = 8
-				hx = rayX
-				hy = rayY
-				distH = dist(playerX, playerY, hx, hy, rayAngle)
-			} else {
-				rayX += rayTargetX
-				rayY += rayTargetY
-				dof++
-			}
-		}
+	renderer.Render(cvs.Image(), cam)
+	minimap.Render(gc, cam)
 
-		// check Vertical
-		dof = 0
-		nTan := -math.Tan(rayAngle)
-		P2 := math.Pi / 2
-		P3 := 3 * P2
-
-		distV := 1000000.0
-		vx := playerX
-		vy := playerY
-
-		if rayAngle > P2 && rayAngle < P3 {
-			// looking left
-			rayX = math.Trunc(playerX/float64(blocSize))*float64(blocSize) - 1
-			rayY = (playerX-rayX)*nTan + playerY
-
-			rayTargetX = - float64(blocSize)
-			rayTargetY = - rayTargetX * nTan
-		} else if rayAngle < P2 || rayAngle > P3 {
-			// looking right
-			rayX = math.Trunc(playerX/float64(blocSize))*float64(blocSize) + float64(blocSize)
-			rayY = (playerX-rayX)*nTan + playerY
-
-			rayTargetX = float64(blocSize)
-			rayTargetY = - rayTargetX * nTan
-		}
+	touch.Draw(gc)
 
-		if rayAngle == 0 || rayAngle == math.Pi {
-			rayX = playerX
-			rayY = playerY
-			dof = 8
-		}
+	if showStats {
+		width, _ := overlay.Size()
+		overlay.Draw(gc, float64(cvs.Width())-width-8, 8)
+	}
 
-		for ; dof < 8; {
-			mapX = int(math.Trunc(rayX / float64(blocSize)))
-			mapY = int(math.Trunc((rayY) / float64(blocSize)))
-
-			mapIndex = mapY*mapSize + mapX
-
-			// hit wall
-			if mapIndex > 0 && mapIndex < mapSize*mapSize && level[mapIndex] == 1 {
-				vx = rayX
-				vy = rayY
-				distV = dist(playerX, playerY, vx, vy, rayAngle)
-				dof = 8
-			} else {
-				rayX += rayTargetX
-				rayY += rayTargetY
-				dof++
-			}
-		}
+	return true
+}
 
-		// vertical wall
-		if distV < distH {
-			rayX = vx
-			rayY = vy
-			distT = distV
+// pressed tells whether the player asks for action on any input device
+func pressed(action browser.Action) bool {
+	return keyboard.Pressed(action) || gamepad.Pressed(action) || touch.Pressed(action)
+}
 
-			gc.SetFillColor(color.RGBA{0xE5, 0x00, 0x00, 0xff})
-			gc.SetStrokeColor(color.RGBA{0xE5, 0x00, 0x00, 0xff})
-		}
+func handleMove() {
+	var input wolfenstein.Input
 
-		// horizontal wall
-		if distH < distV {
-			rayX = hx
-			rayY = hy
-			distT = distH
+	// browsers only refresh gamepads when asked
+	gamepad.Poll()
 
-			gc.SetFillColor(color.RGBA{0xb2, 0x00, 0x00, 0xff})
-			gc.SetStrokeColor(color.RGBA{0xb2, 0x00, 0x00, 0xff})
-		}
+	if pressed(actionForward) {
+		input.Forward++
+	}
+	if pressed(actionBackward) {
+		input.Forward--
+	}
 
-		// render raycast
-		gc.BeginPath()
-		gc.MoveTo(playerX, playerY)
-		gc.LineTo(rayX, rayY)
-		gc.Close()
-		gc.FillStroke()
-
-		// render 3D walls
-		ca := gs.GetPlayerAngle() - rayAngle
-		if ca < 0 {
-			ca += 2 * math.Pi
+	if pressed(actionStrafe) {
+		// Alt + arrows sidestep instead of turning
+		if pressed(actionTurnRight) {
+			input.Strafe++
 		}
-		if ca > 2*math.Pi {
-			ca -= 2 * math.Pi
+		if pressed(actionTurnLeft) {
+			input.Strafe--
 		}
-		distT = distT * math.Cos(ca) // fix fisheye
-
-		lineH := float64(mapSize*320) / distT
-		if lineH > 320 {
-			lineH = 320
+	} else {
+		if pressed(actionTurnRight) {
+			input.Turn++
 		}
-
-		lineOffset := (320 / 2) - lineH/2
-
-		draw2dkit.Rectangle(
-			gc,
-			float64(rayN*8+530),
-			lineOffset,
-			float64(rayN*8+530)+8,
-			lineH+lineOffset,
-		)
-		gc.FillStroke()
-
-		// updating angle for next ray
-		rayAngle += oneRadian
-
-		if rayAngle < 0 {
-			rayAngle += 2 * math.Pi
-		} else if rayAngle > 2*math.Pi {
-			rayAngle -= 2 * math.Pi
+		if pressed(actionTurnLeft) {
+			input.Turn--
 		}
 	}
-}
-
-func dist(aX, aY, bX, bY, angle float64) float64 {
-	return math.Sqrt((bX-aX)*(bX-aX) + (bY-aY)*(bY-aY))
-}
 
-func handleMove() {
-	if keyboard.up {
-		gs.MoveUp()
-	} else if keyboard.down {
-		gs.MoveDown()
+	if pressed(actionStrafeRight) {
+		input.Strafe++
 	}
-
-	if keyboard.right {
-		gs.MoveRight()
-	} else if keyboard.left {
-		gs.MoveLeft()
+	if pressed(actionStrafeLeft) {
+		input.Strafe--
 	}
-}
 
-func renderLevel(gc *draw2dimg.GraphicContext) {
-	gc.SetFillColor(color.RGBA{0xff, 0xff, 0xff, 0xff})
-	gc.SetStrokeColor(color.RGBA{0xff, 0xff, 0xff, 0xff})
-	gc.BeginPath()
+	input.Run = pressed(actionRun)
 
-	level := gs.GetLevel()
-	blockSize := gs.GetBlockSize()
-	mapSize := gs.GetMapSize()
+	// analog sticks, a stick pushed halfway walks at half speed
+	move := gamepad.LeftStick()
+	look := gamepad.RightStick()
 
-	for y := 0; y < mapSize; y++ {
-		for x := 0; x < mapSize; x++ {
-			if level[x+y*mapSize] == 0 {
-				// avoid useless rendering
-				continue
-			}
+	input.Forward -= move.Y
+	input.Strafe += move.X
+	input.Turn += look.X
 
-			draw2dkit.Rectangle(
-				gc,
-				float64(x*blockSize+1),
-				float64(y*blockSize+1),
-				float64(x*blockSize+blockSize-1),
-				float64(y*blockSize+blockSize-1),
-			)
-			gc.FillStroke()
-		}
-	}
-}
+	// virtual joystick, analog too
+	move = touch.Move()
+
+	input.Forward -= move.Y
+	input.Strafe += move.X
+
+	dx, _ := pointer.Delta()
+	input.Look = dx*mouseSensitivity + touch.LookDelta()*touchSensitivity
 
-func renderPlayer(gc *draw2dimg.GraphicContext) {
-	// draw player on screen
-	gc.SetFillColor(color.RGBA{0xff, 0x00, 0x00, 0xff})
-	gc.SetStrokeColor(color.RGBA{0xff, 0x00, 0x00, 0xff})
-	gc.BeginPath()
-
-	// draw player on screen
-	playerX, playerY, playerDeltaX, playerDeltaY := gs.GetPlayerPosition()
-	draw2dkit.Circle(gc, playerX, playerY, 5)
-	gc.FillStroke()
-
-	// draw player direction
-	gc.BeginPath()
-	gc.MoveTo(playerX, playerY)
-	gc.LineTo(playerX+playerDeltaX*5, playerY+playerDeltaY*5)
-	gc.Close()
-	gc.FillStroke()
+	gs.SetInput(input)
 }
@@ -0,0 +1,118 @@
+package wolfenstein
+
+import (
+	"math"
+)
+
+// wallGap keeps the player a hair away from the walls it slides along, so the
+// next collision test does not see it inside the wall.
+const wallGap = 1e-6
+
+func (gs *GameState) GetPlayerRadius() float64 {
+	return gs.playerRadius
+}
+
+// SetPlayerRadius changes the size of the square the player occupies when
+// colliding with walls, in world units. Negative values are treated as 0.
+func (gs *GameState) SetPlayerRadius(radius float64) {
+	gs.playerRadius = math.Max(radius, 0)
+}
+
+// moveBy moves the player by (dx, dy), one axis at a time, so that a blocked
+// axis does not prevent sliding along the other one.
+func (gs *GameState) moveBy(dx, dy float64) {
+	// split long moves so the player can never skip over a wall
+	maxStep := float64(gs.blockSize) / 2
+	steps := math.Ceil(math.Max(math.Abs(dx), math.Abs(dy)) / maxStep)
+
+	if steps < 1 {
+		steps = 1
+	}
+
+	for i := 0; i < int(steps); i++ {
+		gs.slideX(dx / steps)
+		gs.slideY(dy / steps)
+	}
+
+	gs.clampToMap()
+}
+
+func (gs *GameState) slideX(dx float64) {
+	p := &gs.player.position
+	r := gs.playerRadius
+	blockSize := float64(gs.blockSize)
+
+	if dx == 0 || !gs.collides(p.x+dx, p.y) {
+		p.x += dx
+		return
+	}
+
+	// stop flush against the wall we ran into
+	if dx > 0 {
+		p.x = snap(p.x, dx, math.Ceil((p.x+r)/blockSize)*blockSize-r-wallGap)
+	} else {
+		p.x = snap(p.x, dx, math.Floor((p.x-r)/blockSize)*blockSize+r+wallGap)
+	}
+}
+
+func (gs *GameState) slideY(dy float64) {
+	p := &gs.player.position
+	r := gs.playerRadius
+	blockSize := float64(gs.blockSize)
+
+	if dy == 0 || !gs.collides(p.x, p.y+dy) {
+		p.y += dy
+		return
+	}
+
+	if dy > 0 {
+		p.y = snap(p.y, dy, math.Ceil((p.y+r)/blockSize)*blockSize-r-wallGap)
+	} else {
+		p.y = snap(p.y, dy, math.Floor((p.y-r)/blockSize)*blockSize+r+wallGap)
+	}
+}
+
+// snap returns flush, the coordinate flush against the wall blocking a move
+// by d from v, when it lies on the way. A player already overlapping a wall
+// has no such coordinate and doesn't move along that axis, rather than being
+// pushed into the wall or jumping away.
+func snap(v, d, flush float64) float64 {
+	if math.Min(v, v+d) <= flush && flush <= math.Max(v, v+d) {
+		return flush
+	}
+
+	return v
+}
+
+// collides tells whether the player square centered on (x, y) overlaps a wall
+// or the outside of the map.
+func (gs *GameState) collides(x, y float64) bool {
+	r := gs.playerRadius
+	blockSize := float64(gs.blockSize)
+
+	x0 := int(math.Floor((x - r) / blockSize))
+	x1 := int(math.Floor((x + r) / blockSize))
+	y0 := int(math.Floor((y - r) / blockSize))
+	y1 := int(math.Floor((y + r) / blockSize))
+
+	for cy := y0; cy <= y1; cy++ {
+		for cx := x0; cx <= x1; cx++ {
+			if gs.level.IsSolid(cx, cy) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// clampToMap guarantees the player never leaves the map, whatever the level.
+func (gs *GameState) clampToMap() {
+	p := &gs.player.position
+	r := math.Min(gs.playerRadius, float64(gs.blockSize)/2)
+	width := float64(gs.level.Width() * gs.blockSize)
+	height := float64(gs.level.Height() * gs.blockSize)
+
+	p.x = math.Max(r, math.Min(p.x, width-r))
+	p.y = math.Max(r, math.Min(p.y, height-r))
+}
@@ -1,20 +1,52 @@
 package wolfenstein
 
 import (
-	"github.com/llgcode/draw2d/draw2dimg"
-	"github.com/llgcode/draw2d/draw2dkit"
-	"image/color"
 	"math"
 )
 
+// defaultPlayerRadius is the half width of the square the player occupies, in
+// cells. Levels must leave that much room around their spawn.
+const defaultPlayerRadius = 0.25
+
 type GameState struct {
-	level     []int
-	mapSize   int
+	level     *Grid
 	blockSize int
+	fov       float64
+
+	// wall textures, level value n uses the texture n-1
+	textures *TextureAtlas
+
+	player       Player
+	playerRadius float64
+
+	// player position before the last Update, to interpolate between updates
+	previous Point
+
+	// entities of the level, positions are in cells
+	entities []Entity
 
-	player Player
+	// entities drawn as billboards, from the frames of the sprite sheet
+	sprites     []Sprite
+	spriteSheet *TextureAtlas
+
+	input     Input
+	moveSpeed float64
+	turnSpeed float64
+	runFactor float64
+}
+
+// Input is what the player wants to do, each axis going from -1 to 1.
+type Input struct {
+	Forward float64 // 1 walks forward, -1 walks backward
+	Strafe  float64 // 1 steps right, -1 steps left
+	Turn    float64 // 1 turns right, -1 turns left
+	Look    float64 // radians to turn right at once, e.g. from mouse movements
+	Run     bool
 }
 
+// maxUpdateStep is the longest step, in seconds, a single Update simulates.
+const maxUpdateStep = 0.25
+
 type Player struct {
 	position Point
 	delta    Point
@@ -26,44 +58,72 @@ type Point struct {
 	angle float64
 }
 
+// NewGameState starts the default level.
 func NewGameState(width, height int) (*GameState, error) {
+	return NewGameStateFromLevel(DefaultLevel())
+}
+
+// NewGameStateFromLevel starts the given level, which is validated first.
+func NewGameStateFromLevel(level *Level) (*GameState, error) {
+	if err := level.Validate(); err != nil {
+		return nil, err
+	}
+
 	var gs GameState
 
-	// silly level
-	gs.level = []int{
-		1, 1, 1, 1, 1, 1, 1, 1,
-		1, 0, 1, 0, 0, 0, 0, 1,
-		1, 0, 1, 0, 0, 0, 0, 1,
-		1, 0, 1, 0, 0, 0, 0, 1,
-		1, 0, 0, 0, 0, 0, 0, 1,
-		1, 0, 0, 0, 0, 1, 0, 1,
-		1, 0, 0, 0, 0, 0, 0, 1,
-		1, 1, 1, 1, 1, 1, 1, 1,
+	gs.level = NewGrid(level.Width, level.Height)
+
+	for y := 0; y < level.Height; y++ {
+		for x := 0; x < level.Width; x++ {
+			gs.level.Set(x, y, level.Cell(x, y))
+		}
 	}
 
-	gs.mapSize = 8
 	gs.blockSize = 64
+	gs.fov = math.Pi / 3
+
+	textures, err := NewTextureAtlas(TextureData["textures.png"], textureSize)
+	if err != nil {
+		return nil, err
+	}
+
+	gs.textures = textures
+
+	sprites, err := NewTextureAtlas(SpriteData["sprites.png"], spriteSize)
+	if err != nil {
+		return nil, err
+	}
+
+	gs.spriteSheet = sprites
+	gs.playerRadius = defaultPlayerRadius * float64(gs.blockSize)
+	gs.moveSpeed = float64(4 * gs.blockSize)
+	gs.turnSpeed = 3
+	gs.runFactor = 2
 
 	gs.player = Player{
 		position: Point{
-			float64(gs.mapSize * gs.blockSize / 2),
-			float64(gs.mapSize * gs.blockSize / 2),
-			0.0,
+			level.Spawn.X * float64(gs.blockSize),
+			level.Spawn.Y * float64(gs.blockSize),
+			normalizeAngle(level.Spawn.Angle * math.Pi / 180),
 		},
 		delta: Point{0, 0, 0.0},
 	}
 
+	gs.previous = gs.player.position
+	gs.entities = level.Entities
+	gs.sprites = spritesOf(level.Entities, float64(gs.blockSize))
+
 	gs.updateDelta()
 
 	return &gs, nil
 }
 
-func (gs *GameState) GetMapSize() int {
-	return gs.mapSize
+func (gs *GameState) GetLevel() *Grid {
+	return gs.level
 }
 
-func (gs *GameState) GetLevel() []int {
-	return gs.level
+func (gs *GameState) GetEntities() []Entity {
+	return gs.entities
 }
 
 func (gs *GameState) GetPlayer() Player {
@@ -82,70 +142,154 @@ func (gs *GameState) GetPlayerAngle() float64 {
 	return gs.player.position.angle
 }
 
-func (gs *GameState) MoveUp() {
-	gs.player.position.x += gs.player.delta.x
-	gs.player.position.y += gs.player.delta.y
+func (gs *GameState) GetFOV() float64 {
+	return gs.fov
 }
 
-func (gs *GameState) MoveDown() {
-	gs.player.position.x -= gs.player.delta.x
-	gs.player.position.y -= gs.player.delta.y
+// SetFOV changes the horizontal field of view of the player, in radians.
+func (gs *GameState) SetFOV(fov float64) {
+	gs.fov = fov
 }
 
-func (gs *GameState) MoveLeft() {
-	gs.player.position.angle -= 0.1
+func (gs *GameState) GetTextures() *TextureAtlas {
+	return gs.textures
+}
 
-	if gs.player.position.angle < 0 {
-		gs.player.position.angle += 2 * math.Pi
-	}
+// SetTextures replaces the wall textures, nil renders flat colored walls.
+func (gs *GameState) SetTextures(textures *TextureAtlas) {
+	gs.textures = textures
+}
 
-	gs.updateDelta()
+func (gs *GameState) GetSprites() []Sprite {
+	return gs.sprites
 }
 
-func (gs *GameState) MoveRight() {
-	gs.player.position.angle += 0.1
+// SetSprites replaces the sprites drawn in the level.
+func (gs *GameState) SetSprites(sprites []Sprite) {
+	gs.sprites = sprites
+}
 
-	if gs.player.position.angle > 2*math.Pi {
-		gs.player.position.angle -= 2 * math.Pi
+func (gs *GameState) GetSpriteSheet() *TextureAtlas {
+	return gs.spriteSheet
+}
+
+// SetSpriteSheet replaces the frames sprites are drawn with, nil draws no
+// sprites.
+func (gs *GameState) SetSpriteSheet(sheet *TextureAtlas) {
+	gs.spriteSheet = sheet
+}
+
+// textureOf returns the atlas texture used by a level value.
+func (gs *GameState) textureOf(cell int) (tile int, ok bool) {
+	if gs.textures == nil || cell < 1 || cell > gs.textures.Count() {
+		return 0, false
 	}
 
-	gs.updateDelta()
+	return cell - 1, true
 }
 
-func (gs *GameState) updateDelta() {
-	gs.player.delta.x = math.Cos(gs.player.position.angle) * 5
-	gs.player.delta.y = math.Sin(gs.player.position.angle) * 5
+// SetInput records what the player wants to do during the next updates.
+// Input.Look is applied by the next Update only.
+func (gs *GameState) SetInput(input Input) {
+	gs.input = input
 }
 
-func (gs *GameState) RenderRay(gc *draw2dimg.GraphicContext) {
-	// player position as origin
-	posX := gs.player.position.x
-	posY := gs.player.position.y
-	posAngle := gs.player.position.angle
+func (gs *GameState) GetInput() Input {
+	return gs.input
+}
 
-	blockSize := gs.GetBlockSize()
+func (gs *GameState) GetMoveSpeed() float64 {
+	return gs.moveSpeed
+}
 
-	var rayX, rayY float64
+// SetMoveSpeed changes how fast the player walks, in world units per second.
+func (gs *GameState) SetMoveSpeed(speed float64) {
+	gs.moveSpeed = speed
+}
 
-	for x := 0; x < 1; x++ {
-		//which cell of the map we're in
-		mapX := int(math.Trunc(posX / float64(blockSize)))*blockSize
+func (gs *GameState) GetTurnSpeed() float64 {
+	return gs.turnSpeed
+}
 
-		// right
-		if posAngle < math.Pi/2 || posAngle > 3*math.Pi/2 {
-			rayX = posX + (float64(blockSize) - (posX - float64(mapX)))
-			rayY = posY + (float64(blockSize)-(posX-float64(mapX)))*math.Tan(posAngle)
-		}
+// SetTurnSpeed changes how fast the player turns, in radians per second.
+func (gs *GameState) SetTurnSpeed(speed float64) {
+	gs.turnSpeed = speed
+}
 
-		// left
-		if posAngle > math.Pi/2 && posAngle < 3*math.Pi/2 {
-			rayX = posX - (float64(blockSize) - (posX - float64(mapX)))
-			rayY = posY + (float64(blockSize)-(posX-float64(mapX)))*-math.Tan(posAngle)
-		}
+func (gs *GameState) GetRunFactor() float64 {
+	return gs.runFactor
+}
+
+// SetRunFactor changes how many times faster the player moves when running.
+func (gs *GameState) SetRunFactor(factor float64) {
+	gs.runFactor = factor
+}
 
-		gc.SetFillColor(color.RGBA{0x00, 0x00, 0xff, 0xff})
-		gc.SetStrokeColor(color.RGBA{0x00, 0x00, 0xff, 0xff})
-		draw2dkit.Circle(gc, rayX, rayY, 2)
-		gc.FillStroke()
+// Update advances the game by dt seconds, applying the current input.
+func (gs *GameState) Update(dt float64) {
+	gs.previous = gs.player.position
+
+	if dt <= 0 {
+		return
+	}
+
+	// a tab coming back from background must not teleport the player
+	if dt > maxUpdateStep {
+		dt = maxUpdateStep
 	}
+
+	speed := gs.moveSpeed
+	turnSpeed := gs.turnSpeed
+
+	if gs.input.Run {
+		speed *= gs.runFactor
+		turnSpeed *= gs.runFactor
+	}
+
+	gs.Turn(clampUnit(gs.input.Turn)*turnSpeed*dt + gs.input.Look)
+	gs.input.Look = 0
+
+	// walking diagonally must not be faster than walking straight
+	right := clampUnit(gs.input.Strafe)
+	forward := clampUnit(gs.input.Forward)
+
+	if length := math.Hypot(right, forward); length > 1 {
+		right /= length
+		forward /= length
+	}
+
+	gs.Move(right*speed*dt, forward*speed*dt)
+}
+
+// Move walks the player by a vector relative to where it faces, right being
+// the sidestep and forward the step ahead, in world units. Walls stop it.
+func (gs *GameState) Move(right, forward float64) {
+	if right == 0 && forward == 0 {
+		return
+	}
+
+	// right of the facing direction is a quarter turn clockwise
+	dirX, dirY := gs.player.delta.x, gs.player.delta.y
+
+	gs.moveBy(dirX*forward-dirY*right, dirY*forward+dirX*right)
+}
+
+// Turn rotates the player clockwise by angle radians.
+func (gs *GameState) Turn(angle float64) {
+	if angle == 0 {
+		return
+	}
+
+	gs.player.position.angle = normalizeAngle(gs.player.position.angle + angle)
+	gs.updateDelta()
+}
+
+// updateDelta keeps delta the unit vector the player is facing.
+func (gs *GameState) updateDelta() {
+	gs.player.delta.x = math.Cos(gs.player.position.angle)
+	gs.player.delta.y = math.Sin(gs.player.position.angle)
+}
+
+func clampUnit(v float64) float64 {
+	return math.Max(-1, math.Min(v, 1))
 }
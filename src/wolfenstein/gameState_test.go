@@ -0,0 +1,286 @@
+package wolfenstein
+
+import (
+	"math"
+	"testing"
+)
+
+// gridOf builds a grid from its cells, given row by row.
+func gridOf(width int, cells ...int) *Grid {
+	grid := NewGrid(width, len(cells)/width)
+	copy(grid.cells, cells)
+
+	return grid
+}
+
+// newTestGameState builds a GameState on the given level, with the player at
+// the center of cell (cellX, cellY) facing angle.
+func newTestGameState(t *testing.T, level *Grid, cellX, cellY int, angle float64) *GameState {
+	t.Helper()
+
+	gs, err := NewGameState(0, 0)
+	if err != nil {
+		t.Fatalf("NewGameState: %v", err)
+	}
+
+	gs.level = level
+
+	gs.player.position = Point{
+		x:     (float64(cellX) + 0.5) * float64(gs.blockSize),
+		y:     (float64(cellY) + 0.5) * float64(gs.blockSize),
+		angle: angle,
+	}
+	gs.updateDelta()
+
+	return gs
+}
+
+// walk simulates frames of 1/60s with the given input.
+func walk(gs *GameState, input Input, frames int) {
+	gs.SetInput(input)
+
+	for i := 0; i < frames; i++ {
+		gs.Update(1.0 / 60)
+	}
+}
+
+var corridor = gridOf(5,
+	1, 1, 1, 1, 1,
+	1, 0, 0, 0, 1,
+	1, 0, 0, 0, 1,
+	1, 0, 0, 0, 1,
+	1, 1, 1, 1, 1,
+)
+
+func TestWalkForwardStopsAgainstWall(t *testing.T) {
+	gs := newTestGameState(t, corridor, 2, 2, 0)
+
+	walk(gs, Input{Forward: 1}, 100)
+
+	x, _, _, _ := gs.GetPlayerPosition()
+	wall := float64(4 * gs.GetBlockSize())
+
+	if x+gs.GetPlayerRadius() > wall {
+		t.Errorf("player overlaps the east wall: x=%f radius=%f wall=%f", x, gs.GetPlayerRadius(), wall)
+	}
+
+	if wall-(x+gs.GetPlayerRadius()) > 0.01 {
+		t.Errorf("player stopped too early: x=%f, expected flush against %f", x, wall)
+	}
+}
+
+func TestWalkBackwardStopsAgainstWall(t *testing.T) {
+	gs := newTestGameState(t, corridor, 2, 2, 0)
+
+	walk(gs, Input{Forward: -1}, 100)
+
+	x, _, _, _ := gs.GetPlayerPosition()
+	wall := float64(gs.GetBlockSize())
+
+	if x-gs.GetPlayerRadius() < wall {
+		t.Errorf("player overlaps the west wall: x=%f radius=%f wall=%f", x, gs.GetPlayerRadius(), wall)
+	}
+}
+
+func TestWalkSlidesAlongWall(t *testing.T) {
+	// walking mostly east but slightly south
+	gs := newTestGameState(t, corridor, 2, 2, 0.3)
+
+	walk(gs, Input{Forward: 1}, 100)
+
+	x, y, _, _ := gs.GetPlayerPosition()
+	r := gs.GetPlayerRadius()
+	blockSize := float64(gs.GetBlockSize())
+
+	// ends in the south east corner, flush against both walls
+	if math.Abs(x+r-4*blockSize) > 0.01 || math.Abs(y+r-4*blockSize) > 0.01 {
+		t.Errorf("player did not slide into the corner: x=%f y=%f", x, y)
+	}
+}
+
+func TestMoveDoesNotTunnelThroughThinWalls(t *testing.T) {
+	gs := newTestGameState(t, gridOf(4,
+		0, 0, 0, 0,
+		0, 0, 1, 0,
+		0, 0, 0, 0,
+		0, 0, 0, 0,
+	), 0, 1, 0)
+
+	// a single huge step must still be stopped by the wall
+	gs.moveBy(float64(3*gs.GetBlockSize()), 0)
+
+	x, _, _, _ := gs.GetPlayerPosition()
+	if x+gs.GetPlayerRadius() > float64(2*gs.GetBlockSize()) {
+		t.Errorf("player went through the wall: x=%f", x)
+	}
+}
+
+func TestMoveWhileOverlappingWall(t *testing.T) {
+	// the player square overlaps the west wall by 9.6 units
+	gs := newTestGameState(t, corridor, 1, 2, math.Pi)
+	gs.player.position.x = 1.1 * float64(gs.GetBlockSize())
+	startX, startY, _, _ := gs.GetPlayerPosition()
+
+	for _, move := range []struct{ right, forward float64 }{{0, 4}, {0, 4}, {-4, 0}, {4, 0}, {0, -4}} {
+		gs.Move(move.right, move.forward)
+
+		x, y, _, _ := gs.GetPlayerPosition()
+		if x < startX {
+			t.Fatalf("move %+v pushed the player into the wall: x=%f, started at %f", move, x, startX)
+		}
+
+		if math.Abs(y-startY) > 1e-9 {
+			t.Fatalf("move %+v made the player jump from y=%f to %f", move, startY, y)
+		}
+	}
+}
+
+func TestWalkStaysInsideMap(t *testing.T) {
+	// a 3x2 map with no walls at all, only the map bounds stop the player
+	gs := newTestGameState(t, NewGrid(3, 2), 1, 1, math.Pi/4)
+	width := float64(3 * gs.GetBlockSize())
+	height := float64(2 * gs.GetBlockSize())
+
+	walk(gs, Input{Forward: 1}, 200)
+
+	x, y, _, _ := gs.GetPlayerPosition()
+	if x < 0 || y < 0 || x > width || y > height {
+		t.Fatalf("player left the map: x=%f y=%f", x, y)
+	}
+
+	gs.SetPlayerRadius(0)
+
+	walk(gs, Input{Forward: -1}, 200)
+
+	x, y, _, _ = gs.GetPlayerPosition()
+	if x < 0 || y < 0 || x > width || y > height {
+		t.Fatalf("player with no radius left the map: x=%f y=%f", x, y)
+	}
+}
+
+func TestPlayerRadius(t *testing.T) {
+	gs := newTestGameState(t, corridor, 2, 2, 0)
+
+	gs.SetPlayerRadius(30)
+
+	walk(gs, Input{Forward: 1}, 100)
+
+	x, _, _, _ := gs.GetPlayerPosition()
+	if expected := float64(4*gs.GetBlockSize()) - 30; math.Abs(x-expected) > 0.01 {
+		t.Errorf("x = %f, expected %f with a 30 units radius", x, expected)
+	}
+
+	gs.SetPlayerRadius(-5)
+	if gs.GetPlayerRadius() != 0 {
+		t.Errorf("negative radius should be treated as 0, got %f", gs.GetPlayerRadius())
+	}
+}
+
+func TestUpdateIsFrameRateIndependent(t *testing.T) {
+	open := NewGrid(20, 20)
+	input := Input{Forward: 1, Turn: 0.5}
+
+	at60 := newTestGameState(t, open, 5, 10, 0)
+	at60.SetInput(input)
+	for i := 0; i < 60; i++ {
+		at60.Update(1.0 / 60)
+	}
+
+	at144 := newTestGameState(t, open, 5, 10, 0)
+	at144.SetInput(input)
+	for i := 0; i < 144; i++ {
+		at144.Update(1.0 / 144)
+	}
+
+	x60, y60, _, _ := at60.GetPlayerPosition()
+	x144, y144, _, _ := at144.GetPlayerPosition()
+
+	// both walked about a second, the paths only differ by integration error
+	if math.Hypot(x60-x144, y60-y144) > 2 {
+		t.Errorf("positions differ: (%f, %f) at 60Hz, (%f, %f) at 144Hz", x60, y60, x144, y144)
+	}
+
+	if math.Abs(at60.GetPlayerAngle()-at144.GetPlayerAngle()) > 1e-9 {
+		t.Errorf("angles differ: %f at 60Hz, %f at 144Hz", at60.GetPlayerAngle(), at144.GetPlayerAngle())
+	}
+
+	if expected := 0.5 * at60.GetTurnSpeed(); math.Abs(at60.GetPlayerAngle()-expected) > 1e-9 {
+		t.Errorf("angle = %f after a second, expected %f", at60.GetPlayerAngle(), expected)
+	}
+}
+
+func TestCameraAtInterpolatesBetweenUpdates(t *testing.T) {
+	// facing just below 2pi and turning right wraps the angle around 0
+	gs := newTestGameState(t, NewGrid(20, 20), 5, 10, 2*math.Pi-0.05)
+	gs.SetInput(Input{Forward: 1, Turn: 1})
+	gs.Update(0.1)
+
+	from := gs.CameraAt(0)
+	to := gs.CameraAt(1)
+	mid := gs.CameraAt(0.5)
+
+	if to != gs.Camera() {
+		t.Errorf("CameraAt(1) = %+v, expected the current camera %+v", to, gs.Camera())
+	}
+
+	if math.Abs(mid.X-(from.X+to.X)/2) > 1e-9 || math.Abs(mid.Y-(from.Y+to.Y)/2) > 1e-9 {
+		t.Errorf("CameraAt(0.5) = %+v is not halfway between %+v and %+v", mid, from, to)
+	}
+
+	// halfway through the turn, not halfway around the circle
+	expected := normalizeAngle(from.Angle + gs.GetTurnSpeed()*0.1/2)
+	if math.Abs(mid.Angle-expected) > 1e-9 {
+		t.Errorf("CameraAt(0.5) angle = %f, expected %f", mid.Angle, expected)
+	}
+}
+
+func TestStrafeAndRun(t *testing.T) {
+	open := NewGrid(20, 20)
+
+	// facing east, strafing right goes south
+	gs := newTestGameState(t, open, 10, 10, 0)
+	x0, y0, _, _ := gs.GetPlayerPosition()
+
+	walk(gs, Input{Strafe: 1}, 30)
+
+	x, y, _, _ := gs.GetPlayerPosition()
+	if math.Abs(x-x0) > 1e-9 || math.Abs(y-y0-gs.GetMoveSpeed()/2) > 1e-9 {
+		t.Errorf("strafed to (%f, %f) from (%f, %f), expected half a second south", x, y, x0, y0)
+	}
+
+	// running covers runFactor times the distance
+	gs = newTestGameState(t, open, 10, 10, 0)
+	walk(gs, Input{Forward: 1, Run: true}, 30)
+
+	x, _, _, _ = gs.GetPlayerPosition()
+	if expected := x0 + gs.GetMoveSpeed()*gs.GetRunFactor()/2; math.Abs(x-expected) > 1e-9 {
+		t.Errorf("ran to x=%f, expected %f", x, expected)
+	}
+}
+
+func TestDiagonalIsNotFaster(t *testing.T) {
+	gs := newTestGameState(t, NewGrid(20, 20), 10, 10, 0)
+	x0, y0, _, _ := gs.GetPlayerPosition()
+
+	walk(gs, Input{Forward: 1, Strafe: -1}, 30)
+
+	x, y, _, _ := gs.GetPlayerPosition()
+	if d := math.Hypot(x-x0, y-y0); math.Abs(d-gs.GetMoveSpeed()/2) > 1e-9 {
+		t.Errorf("walked %f diagonally in half a second, expected %f", d, gs.GetMoveSpeed()/2)
+	}
+
+	// forward and left while facing east is north east
+	if x <= x0 || y >= y0 {
+		t.Errorf("expected to walk north east, went from (%f, %f) to (%f, %f)", x0, y0, x, y)
+	}
+}
+
+func TestLookAppliesOnce(t *testing.T) {
+	gs := newTestGameState(t, NewGrid(20, 20), 10, 10, 0)
+
+	walk(gs, Input{Look: 0.25}, 3)
+
+	if math.Abs(gs.GetPlayerAngle()-0.25) > 1e-9 {
+		t.Errorf("angle = %f, expected the look delta to apply once", gs.GetPlayerAngle())
+	}
+}
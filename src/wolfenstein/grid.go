@@ -0,0 +1,65 @@
+package wolfenstein
+
+// Grid is a width x height map of level values, 0 being an empty cell.
+// Cells are stored row by row and every lookup is bounds checked, so a
+// coordinate outside the map never aliases a cell of another row.
+type Grid struct {
+	width  int
+	height int
+	cells  []int
+}
+
+func NewGrid(width, height int) *Grid {
+	if width < 0 {
+		width = 0
+	}
+
+	if height < 0 {
+		height = 0
+	}
+
+	return &Grid{
+		width:  width,
+		height: height,
+		cells:  make([]int, width*height),
+	}
+}
+
+func (g *Grid) Width() int {
+	return g.width
+}
+
+func (g *Grid) Height() int {
+	return g.height
+}
+
+// InBounds tells whether (x, y) is a cell of the grid.
+func (g *Grid) InBounds(x, y int) bool {
+	return x >= 0 && y >= 0 && x < g.width && y < g.height
+}
+
+// At returns the value of the cell at (x, y), ok is false outside the grid.
+func (g *Grid) At(x, y int) (cell int, ok bool) {
+	if !g.InBounds(x, y) {
+		return 0, false
+	}
+
+	return g.cells[y*g.width+x], true
+}
+
+// Set changes the value of the cell at (x, y), it returns false outside the grid.
+func (g *Grid) Set(x, y, cell int) bool {
+	if !g.InBounds(x, y) {
+		return false
+	}
+
+	g.cells[y*g.width+x] = cell
+	return true
+}
+
+// IsSolid tells whether the cell at (x, y) blocks movement, the outside of the
+// grid being solid.
+func (g *Grid) IsSolid(x, y int) bool {
+	cell, ok := g.At(x, y)
+	return !ok || cell != 0
+}
@@ -0,0 +1,69 @@
+package wolfenstein
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGridAt(t *testing.T) {
+	grid := gridOf(3,
+		7, 0, 0,
+		0, 0, 5,
+	)
+
+	if cell, ok := grid.At(0, 0); !ok || cell != 7 {
+		t.Errorf("At(0, 0) = %d, %v, expected the first cell", cell, ok)
+	}
+
+	// (-1, 2) would alias (2, 1) with a flat index
+	for _, c := range [][2]int{{-1, 2}, {3, 0}, {0, 2}, {0, -1}} {
+		if _, ok := grid.At(c[0], c[1]); ok {
+			t.Errorf("At(%d, %d) should be out of bounds", c[0], c[1])
+		}
+
+		if !grid.IsSolid(c[0], c[1]) {
+			t.Errorf("IsSolid(%d, %d) should be true outside the grid", c[0], c[1])
+		}
+	}
+}
+
+func TestCastRayOnLargeMap(t *testing.T) {
+	const width, height = 300, 256
+
+	// an empty room only surrounded by walls
+	level := NewGrid(width, height)
+	for x := 0; x < width; x++ {
+		level.Set(x, 0, 1)
+		level.Set(x, height-1, 2)
+	}
+	for y := 0; y < height; y++ {
+		level.Set(0, y, 3)
+		level.Set(width-1, y, 4)
+	}
+
+	gs := newTestGameState(t, level, 1, 1, 0)
+	x, y, _, _ := gs.GetPlayerPosition()
+	blockSize := float64(gs.GetBlockSize())
+
+	tests := []struct {
+		angle    float64
+		cell     int
+		distance float64
+	}{
+		{0, 4, float64(width-1)*blockSize - x},
+		{math.Pi / 2, 2, float64(height-1)*blockSize - y},
+		{math.Pi, 3, x - blockSize},
+	}
+
+	for _, test := range tests {
+		ray := gs.CastRay(x, y, test.angle)
+
+		if !ray.Hit || ray.Cell != test.cell {
+			t.Errorf("angle %f: hit=%v cell=%d, expected cell %d", test.angle, ray.Hit, ray.Cell, test.cell)
+		}
+
+		if math.Abs(ray.Distance-test.distance) > 1e-6 {
+			t.Errorf("angle %f: distance %f, expected %f", test.angle, ray.Distance, test.distance)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package wolfenstein
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// MaxLevelSize is the largest width or height accepted for a level, in cells.
+const MaxLevelSize = 1024
+
+// Level is the on-disk description of a map, stored as JSON:
+//
+//	{
+//	  "name": "E1M1",
+//	  "width": 5,
+//	  "height": 4,
+//	  "grid": [
+//	    "11111",
+//	    "1..21",
+//	    "1...1",
+//	    "11111"
+//	  ],
+//	  "spawn": {"x": 1.5, "y": 1.5, "angle": 90},
+//	  "entities": [
+//	    {"type": "barrel", "x": 3.5, "y": 2.5}
+//	  ]
+//	}
+//
+// Each grid row is a string of width characters: '.' or '0' is an empty cell,
+// '1' to '8' are wall types 1 to 8, drawn with the 8 textures of the embedded
+// atlas in order. '9' and 'A' to 'Z' stand for the wall types 9 to 35 a
+// larger atlas would hold, they are rejected for now.
+// The border of the grid must only hold walls.
+//
+// The spawn and entities stand in empty cells, at least the player radius, a
+// quarter of a cell, away from any wall.
+//
+// Entities of type "barrel", "lamp" and "guard" are drawn as sprites, the
+// guard showing the side it is seen from. Other types are kept for the game
+// but not drawn.
+//
+// Positions are in cells, (0, 0) being the top left corner of the map, so
+// the center of the cell at column 1, row 2 is (1.5, 2.5). Angles are in
+// degrees, 0 facing east and 90 facing south.
+type Level struct {
+	Name     string   `json:"name"`
+	Width    int      `json:"width"`
+	Height   int      `json:"height"`
+	Grid     []string `json:"grid"`
+	Spawn    Spawn    `json:"spawn"`
+	Entities []Entity `json:"entities"`
+}
+
+// Spawn is where and how the player enters the level.
+type Spawn struct {
+	X     float64 `json:"x"`
+	Y     float64 `json:"y"`
+	Angle float64 `json:"angle"`
+}
+
+// Entity is anything standing in the level besides walls.
+type Entity struct {
+	Type  string  `json:"type"`
+	X     float64 `json:"x"`
+	Y     float64 `json:"y"`
+	Angle float64 `json:"angle"`
+}
+
+// ParseLevel decodes and validates a JSON level.
+func ParseLevel(data []byte) (*Level, error) {
+	var level Level
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&level); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			line, column := position(data, syntaxErr.Offset)
+			return nil, fmt.Errorf("level: invalid JSON at line %d, column %d: %w", line, column, err)
+		}
+
+		return nil, fmt.Errorf("level: %w", err)
+	}
+
+	if err := level.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &level, nil
+}
+
+// Validate checks the level is playable, the returned error points at the
+// first problem found.
+func (l *Level) Validate() error {
+	if l.Width <= 0 || l.Height <= 0 {
+		return fmt.Errorf("level %q: size %dx%d must be positive", l.Name, l.Width, l.Height)
+	}
+
+	if l.Width > MaxLevelSize || l.Height > MaxLevelSize {
+		return fmt.Errorf("level %q: size %dx%d exceeds the maximum of %d", l.Name, l.Width, l.Height, MaxLevelSize)
+	}
+
+	if len(l.Grid) != l.Height {
+		return fmt.Errorf("level %q: grid has %d rows, expected height %d", l.Name, len(l.Grid), l.Height)
+	}
+
+	textures := embeddedTextureCount()
+
+	for y, row := range l.Grid {
+		if len(row) != l.Width {
+			return fmt.Errorf("level %q: grid row %d has %d cells, expected width %d", l.Name, y, len(row), l.Width)
+		}
+
+		for x := 0; x < len(row); x++ {
+			cell, ok := parseCell(row[x])
+			if !ok {
+				return fmt.Errorf("level %q: unknown cell %q at (%d, %d)", l.Name, row[x], x, y)
+			}
+
+			if cell > textures {
+				return fmt.Errorf("level %q: wall type %d at (%d, %d) has no texture, the atlas holds %d", l.Name, cell, x, y, textures)
+			}
+
+			border := x == 0 || y == 0 || x == l.Width-1 || y == l.Height-1
+			if border && cell == 0 {
+				return fmt.Errorf("level %q: border cell (%d, %d) is empty, the map must be enclosed by walls", l.Name, x, y)
+			}
+		}
+	}
+
+	if err := l.validatePosition("spawn", l.Spawn.X, l.Spawn.Y, l.Spawn.Angle); err != nil {
+		return err
+	}
+
+	for i, entity := range l.Entities {
+		if entity.Type == "" {
+			return fmt.Errorf("level %q: entity %d has no type", l.Name, i)
+		}
+
+		what := fmt.Sprintf("entity %d (%s)", i, entity.Type)
+		if err := l.validatePosition(what, entity.X, entity.Y, entity.Angle); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l *Level) validatePosition(what string, x, y, angle float64) error {
+	for _, v := range []float64{x, y, angle} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return fmt.Errorf("level %q: %s has a non finite position or angle", l.Name, what)
+		}
+	}
+
+	if x < 0 || y < 0 || x >= float64(l.Width) || y >= float64(l.Height) {
+		return fmt.Errorf("level %q: %s at (%g, %g) is outside the %dx%d map", l.Name, what, x, y, l.Width, l.Height)
+	}
+
+	if l.Cell(int(x), int(y)) != 0 {
+		return fmt.Errorf("level %q: %s at (%g, %g) is inside a wall", l.Name, what, x, y)
+	}
+
+	// the cells the player square would overlap there, as collisions see them
+	r := defaultPlayerRadius
+	for cy := int(math.Floor(y - r)); cy <= int(math.Floor(y+r)); cy++ {
+		for cx := int(math.Floor(x - r)); cx <= int(math.Floor(x+r)); cx++ {
+			if cx < 0 || cy < 0 || cx >= l.Width || cy >= l.Height || l.Cell(cx, cy) != 0 {
+				return fmt.Errorf("level %q: %s at (%g, %g) is closer than %g cells to a wall", l.Name, what, x, y, r)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Cell returns the wall type at (x, y), 0 being an empty cell. The level must
+// be valid.
+func (l *Level) Cell(x, y int) int {
+	cell, _ := parseCell(l.Grid[y][x])
+	return cell
+}
+
+func parseCell(c byte) (int, bool) {
+	switch {
+	case c == '.' || c == '0':
+		return 0, true
+	case c >= '1' && c <= '9':
+		return int(c - '0'), true
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10, true
+	}
+
+	return 0, false
+}
+
+// position converts a byte offset to a 1 based line and column.
+func position(data []byte, offset int64) (line, column int) {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+
+	before := data[:offset]
+	line = bytes.Count(before, []byte("\n")) + 1
+	column = len(before) - bytes.LastIndexByte(before, '\n')
+
+	return line, column
+}
+
+// DefaultLevel is the level used when none is provided.
+func DefaultLevel() *Level {
+	return &Level{
+		Name:   "default",
+		Width:  8,
+		Height: 8,
+		Grid: []string{
+			"11112222",
+			"1.3....2",
+			"1.3....2",
+			"1.3....6",
+			"4......6",
+			"4....5.6",
+			"4......6",
+			"44477888",
+		},
+		Spawn: Spawn{X: 4.5, Y: 4.5, Angle: 0},
+	}
+}
@@ -0,0 +1,101 @@
+package wolfenstein
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+const validLevel = `{
+  "name": "test",
+  "width": 5,
+  "height": 4,
+  "grid": [
+    "11111",
+    "1..21",
+    "1...1",
+    "17771"
+  ],
+  "spawn": {"x": 1.5, "y": 1.5, "angle": 90},
+  "entities": [
+    {"type": "barrel", "x": 3.5, "y": 2.5}
+  ]
+}`
+
+func TestParseLevel(t *testing.T) {
+	level, err := ParseLevel([]byte(validLevel))
+	if err != nil {
+		t.Fatalf("ParseLevel: %v", err)
+	}
+
+	if level.Cell(3, 1) != 2 || level.Cell(1, 3) != 7 || level.Cell(2, 2) != 0 {
+		t.Errorf("unexpected cells: %v", level.Grid)
+	}
+
+	if cell, ok := parseCell('A'); !ok || cell != 10 {
+		t.Errorf("parseCell('A') = %d, %v, expected wall type 10", cell, ok)
+	}
+
+	gs, err := NewGameStateFromLevel(level)
+	if err != nil {
+		t.Fatalf("NewGameStateFromLevel: %v", err)
+	}
+
+	x, y, _, _ := gs.GetPlayerPosition()
+	if x != 1.5*float64(gs.GetBlockSize()) || y != 1.5*float64(gs.GetBlockSize()) {
+		t.Errorf("player spawned at (%f, %f)", x, y)
+	}
+
+	if math.Abs(gs.GetPlayerAngle()-math.Pi/2) > 1e-9 {
+		t.Errorf("player angle = %f, expected pi/2", gs.GetPlayerAngle())
+	}
+
+	// a quarter of a cell from the walls is close enough
+	edge := strings.Replace(validLevel, `"x": 1.5, "y": 1.5`, `"x": 1.25, "y": 2.5`, 1)
+	if _, err := ParseLevel([]byte(edge)); err != nil {
+		t.Errorf("spawn a player radius away from the walls: %v", err)
+	}
+
+	if len(gs.GetEntities()) != 1 {
+		t.Errorf("expected 1 entity, got %d", len(gs.GetEntities()))
+	}
+}
+
+func TestParseLevelErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		replace [2]string
+		err     string
+	}{
+		{"syntax", [2]string{`"width": 5,`, `"width": 5`}, "invalid JSON at line 4"},
+		{"unknown field", [2]string{`"width"`, `"widht"`}, `unknown field "widht"`},
+		{"zero size", [2]string{`"width": 5`, `"width": 0`}, "must be positive"},
+		{"too big", [2]string{`"height": 4`, `"height": 4000`}, "exceeds the maximum"},
+		{"missing row", [2]string{",\n    \"17771\"", ""}, "grid has 3 rows, expected height 4"},
+		{"short row", [2]string{`"1...1"`, `"1..1"`}, "grid row 2 has 4 cells, expected width 5"},
+		{"unknown cell", [2]string{`"1...1"`, `"1.x.1"`}, `unknown cell 'x' at (2, 2)`},
+		{"no texture", [2]string{`"1..21"`, `"1..91"`}, "wall type 9 at (3, 1) has no texture, the atlas holds 8"},
+		{"open border", [2]string{`"1...1"`, `"....1"`}, "border cell (0, 2) is empty"},
+		{"spawn outside", [2]string{`"x": 1.5`, `"x": 7`}, "spawn at (7, 1.5) is outside the 5x4 map"},
+		{"spawn in wall", [2]string{`"x": 1.5`, `"x": 3.5`}, "spawn at (3.5, 1.5) is inside a wall"},
+		{"spawn against wall", [2]string{`"x": 1.5`, `"x": 1.1`}, "spawn at (1.1, 1.5) is closer than 0.25 cells to a wall"},
+		{"spawn near corner", [2]string{`"x": 1.5, "y": 1.5`, `"x": 2.8, "y": 2.8`}, "spawn at (2.8, 2.8) is closer than 0.25 cells to a wall"},
+		{"entity without type", [2]string{`"type": "barrel", `, ``}, "entity 0 has no type"},
+		{"entity in wall", [2]string{`"x": 3.5, "y": 2.5`, `"x": 0.5, "y": 2.5`}, "entity 0 (barrel) at (0.5, 2.5) is inside a wall"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := strings.Replace(validLevel, test.replace[0], test.replace[1], 1)
+
+			_, err := ParseLevel([]byte(data))
+			if err == nil {
+				t.Fatalf("expected an error containing %q", test.err)
+			}
+
+			if !strings.Contains(err.Error(), test.err) {
+				t.Errorf("error %q does not contain %q", err, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package wolfenstein
+
+import (
+	"github.com/llgcode/draw2d/draw2dimg"
+	"github.com/llgcode/draw2d/draw2dkit"
+	"image/color"
+	"math"
+)
+
+// Minimap draws the level from above, with the player and its field of view.
+type Minimap struct {
+	gs   *GameState
+	rays []Ray // rays drawn to debug the ray caster
+
+	Scale       float64 // screen pixels per world unit
+	WallColor   color.RGBA
+	PlayerColor color.RGBA
+	RayColor    color.RGBA
+}
+
+func NewMinimap(gs *GameState, scale float64) *Minimap {
+	return &Minimap{
+		gs:          gs,
+		rays:        make([]Ray, 60),
+		Scale:       scale,
+		WallColor:   color.RGBA{0xff, 0xff, 0xff, 0xff},
+		PlayerColor: color.RGBA{0xff, 0x00, 0x00, 0xff},
+		RayColor:    color.RGBA{0x00, 0x00, 0xff, 0xff},
+	}
+}
+
+// Render draws the minimap in the top left corner, the player seen from cam.
+func (m *Minimap) Render(gc *draw2dimg.GraphicContext, cam Camera) {
+	gc.Save()
+	gc.Scale(m.Scale, m.Scale)
+
+	m.renderLevel(gc)
+	m.renderRays(gc, cam)
+	m.renderPlayer(gc, cam)
+
+	gc.Restore()
+}
+
+func (m *Minimap) renderLevel(gc *draw2dimg.GraphicContext) {
+	gc.SetFillColor(m.WallColor)
+	gc.SetStrokeColor(m.WallColor)
+	gc.BeginPath()
+
+	level := m.gs.GetLevel()
+	blockSize := m.gs.GetBlockSize()
+
+	for y := 0; y < level.Height(); y++ {
+		for x := 0; x < level.Width(); x++ {
+			if cell, _ := level.At(x, y); cell == 0 {
+				// avoid useless rendering
+				continue
+			}
+
+			draw2dkit.Rectangle(
+				gc,
+				float64(x*blockSize+1),
+				float64(y*blockSize+1),
+				float64(x*blockSize+blockSize-1),
+				float64(y*blockSize+blockSize-1),
+			)
+			gc.FillStroke()
+		}
+	}
+}
+
+func (m *Minimap) renderRays(gc *draw2dimg.GraphicContext, cam Camera) {
+	gc.SetStrokeColor(m.RayColor)
+
+	m.gs.CastRays(cam, m.rays)
+
+	for _, ray := range m.rays {
+		gc.BeginPath()
+		gc.MoveTo(cam.X, cam.Y)
+		gc.LineTo(ray.HitX, ray.HitY)
+		gc.Stroke()
+	}
+}
+
+func (m *Minimap) renderPlayer(gc *draw2dimg.GraphicContext, cam Camera) {
+	gc.SetFillColor(m.PlayerColor)
+	gc.SetStrokeColor(m.PlayerColor)
+
+	// draw player on screen
+	gc.BeginPath()
+	draw2dkit.Circle(gc, cam.X, cam.Y, 5)
+	gc.FillStroke()
+
+	// draw player direction
+	gc.BeginPath()
+	gc.MoveTo(cam.X, cam.Y)
+	gc.LineTo(cam.X+math.Cos(cam.Angle)*25, cam.Y+math.Sin(cam.Angle)*25)
+	gc.Close()
+	gc.FillStroke()
+}
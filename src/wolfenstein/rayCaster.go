@@ -0,0 +1,201 @@
+package wolfenstein
+
+import (
+	"math"
+)
+
+// Side tells which kind of grid line a ray crossed when it hit a wall.
+type Side int
+
+const (
+	// SideVertical is a wall face lying on a vertical grid line (constant x).
+	SideVertical Side = iota
+	// SideHorizontal is a wall face lying on a horizontal grid line (constant y).
+	SideHorizontal
+)
+
+// Camera is the point of view used to cast rays, expressed in world units.
+type Camera struct {
+	X     float64
+	Y     float64
+	Angle float64
+	FOV   float64
+}
+
+// Ray is the result of casting a single screen column.
+type Ray struct {
+	Angle    float64 // absolute angle of the ray
+	Distance float64 // euclidean distance from the camera to the hit, in world units
+	HitX     float64
+	HitY     float64
+	Side     Side
+	MapX     int
+	MapY     int
+	Cell     int     // level value of the cell that was hit
+	Offset   float64 // horizontal texture offset on the wall face, in [0, 1)
+	Hit      bool    // false when the ray left the map without touching a wall
+}
+
+// Camera returns the point of view of the player.
+func (gs *GameState) Camera() Camera {
+	return Camera{
+		X:     gs.player.position.x,
+		Y:     gs.player.position.y,
+		Angle: gs.player.position.angle,
+		FOV:   gs.fov,
+	}
+}
+
+// CameraAt returns the point of view of the player between the last two
+// updates, alpha going from 0 (previous update) to 1 (last update).
+func (gs *GameState) CameraAt(alpha float64) Camera {
+	from := gs.previous
+	to := gs.player.position
+
+	// turn the shortest way
+	turn := normalizeAngle(to.angle - from.angle)
+	if turn > math.Pi {
+		turn -= 2 * math.Pi
+	}
+
+	return Camera{
+		X:     from.x + (to.x-from.x)*alpha,
+		Y:     from.y + (to.y-from.y)*alpha,
+		Angle: normalizeAngle(from.angle + turn*alpha),
+		FOV:   gs.fov,
+	}
+}
+
+// CastRays casts one ray per element of rays, spreading them from the left
+// to the right edge of the camera field of view.
+func (gs *GameState) CastRays(cam Camera, rays []Ray) {
+	gs.castColumns(cam, rays, 0, len(rays))
+}
+
+// castColumns casts the rays of the screen columns first to
+// first+len(rays)-1, out of columns. It only reads the game state, so
+// several goroutines may cast distinct columns at once.
+func (gs *GameState) castColumns(cam Camera, rays []Ray, first, columns int) {
+	plane := math.Tan(cam.FOV / 2)
+
+	for i := range rays {
+		// project the column on the camera plane, so rays are evenly spaced on
+		// screen rather than evenly spaced in angle
+		screenX := 2*(float64(first+i)+0.5)/float64(columns) - 1
+		rays[i] = gs.CastRay(cam.X, cam.Y, cam.Angle+math.Atan(screenX*plane))
+	}
+}
+
+// CastRay walks the level grid from (x, y) in the given direction using DDA
+// and returns the first wall it meets.
+func (gs *GameState) CastRay(x, y, angle float64) Ray {
+	angle = normalizeAngle(angle)
+	blockSize := float64(gs.blockSize)
+
+	dirX := math.Cos(angle)
+	dirY := math.Sin(angle)
+
+	// position in cell units
+	posX := x / blockSize
+	posY := y / blockSize
+
+	mapX := int(math.Floor(posX))
+	mapY := int(math.Floor(posY))
+
+	// distance along the ray between two vertical (resp. horizontal) grid lines
+	deltaX := math.Abs(1 / dirX)
+	deltaY := math.Abs(1 / dirY)
+
+	var stepX, stepY int
+	var sideX, sideY float64
+
+	if dirX < 0 {
+		stepX = -1
+		sideX = (posX - float64(mapX)) * deltaX
+	} else {
+		stepX = 1
+		sideX = (float64(mapX) + 1 - posX) * deltaX
+	}
+
+	if dirY < 0 {
+		stepY = -1
+		sideY = (posY - float64(mapY)) * deltaY
+	} else {
+		stepY = 1
+		sideY = (float64(mapY) + 1 - posY) * deltaY
+	}
+
+	ray := Ray{Angle: angle}
+	dist := 0.0
+
+	for depth := 0; depth < gs.maxDepth(); depth++ {
+		if sideX < sideY {
+			dist = sideX
+			sideX += deltaX
+			mapX += stepX
+			ray.Side = SideVertical
+		} else {
+			dist = sideY
+			sideY += deltaY
+			mapY += stepY
+			ray.Side = SideHorizontal
+		}
+
+		cell, ok := gs.level.At(mapX, mapY)
+		if !ok {
+			// left the map
+			break
+		}
+
+		if cell != 0 {
+			ray.Hit = true
+			ray.Cell = cell
+			break
+		}
+	}
+
+	ray.MapX = mapX
+	ray.MapY = mapY
+	ray.Distance = dist * blockSize
+	ray.HitX = x + dirX*ray.Distance
+	ray.HitY = y + dirY*ray.Distance
+
+	// keep textures reading left to right whatever the side we look from
+	if ray.Side == SideVertical {
+		ray.Offset = fraction(ray.HitY / blockSize)
+		if dirX < 0 {
+			ray.Offset = 1 - ray.Offset
+		}
+	} else {
+		ray.Offset = fraction(ray.HitX / blockSize)
+		if dirY > 0 {
+			ray.Offset = 1 - ray.Offset
+		}
+	}
+
+	if ray.Offset >= 1 {
+		ray.Offset = 0
+	}
+
+	return ray
+}
+
+// maxDepth is the maximum number of grid lines a ray may cross, a ray
+// crossing more lines than the map has is necessarily outside of it.
+func (gs *GameState) maxDepth() int {
+	return gs.level.Width() + gs.level.Height() + 2
+}
+
+func normalizeAngle(angle float64) float64 {
+	angle = math.Mod(angle, 2*math.Pi)
+
+	if angle < 0 {
+		angle += 2 * math.Pi
+	}
+
+	return angle
+}
+
+func fraction(v float64) float64 {
+	return v - math.Floor(v)
+}
@@ -0,0 +1,132 @@
+package wolfenstein
+
+import (
+	"math"
+	"testing"
+)
+
+// roomGrid is a 5x5 room whose north, south, west and east walls are the wall
+// types 1, 2, 3 and 4, the corners being 9.
+func roomGrid() *Grid {
+	return gridOf(5,
+		9, 1, 1, 1, 9,
+		3, 0, 0, 0, 4,
+		3, 0, 0, 0, 4,
+		3, 0, 0, 0, 4,
+		9, 2, 2, 2, 9,
+	)
+}
+
+func TestCastRayHitsEachWall(t *testing.T) {
+	gs := newTestGameState(t, roomGrid(), 2, 2, 0)
+
+	// 10 units off the center of the cell, so offsets tell the faces apart
+	tests := []struct {
+		name       string
+		x, y       float64
+		angle      float64
+		cell       int
+		side       Side
+		mapX, mapY int
+		hitX, hitY float64
+		offset     float64
+	}{
+		// offset 10 units past the middle of the cell, read left to right
+		{"east", 160, 170, 0, 4, SideVertical, 4, 2, 256, 170, 42.0 / 64},
+		{"west", 160, 170, math.Pi, 3, SideVertical, 0, 2, 64, 170, 22.0 / 64},
+		{"south", 170, 160, math.Pi / 2, 2, SideHorizontal, 2, 4, 170, 256, 22.0 / 64},
+		{"north", 170, 160, 3 * math.Pi / 2, 1, SideHorizontal, 2, 0, 170, 64, 42.0 / 64},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ray := gs.CastRay(test.x, test.y, test.angle)
+
+			if !ray.Hit || ray.Cell != test.cell || ray.Side != test.side {
+				t.Fatalf("hit=%v cell=%d side=%d, expected cell %d on side %d", ray.Hit, ray.Cell, ray.Side, test.cell, test.side)
+			}
+
+			if ray.MapX != test.mapX || ray.MapY != test.mapY {
+				t.Errorf("hit cell (%d, %d), expected (%d, %d)", ray.MapX, ray.MapY, test.mapX, test.mapY)
+			}
+
+			if math.Abs(ray.Distance-96) > 1e-9 {
+				t.Errorf("distance %f, expected 96", ray.Distance)
+			}
+
+			if math.Abs(ray.HitX-test.hitX) > 1e-9 || math.Abs(ray.HitY-test.hitY) > 1e-9 {
+				t.Errorf("hit at (%f, %f), expected (%f, %f)", ray.HitX, ray.HitY, test.hitX, test.hitY)
+			}
+
+			if math.Abs(ray.Offset-test.offset) > 1e-9 {
+				t.Errorf("offset %f, expected %f", ray.Offset, test.offset)
+			}
+		})
+	}
+}
+
+func TestCastRayDiagonal(t *testing.T) {
+	gs := newTestGameState(t, roomGrid(), 2, 2, 0)
+
+	// from the center of the room towards south east, the first line crossed
+	// is the vertical one of the east wall, 6 units before the south wall
+	ray := gs.CastRay(150, 160, math.Atan2(90, 106))
+
+	if !ray.Hit || ray.Side != SideVertical || ray.Cell != 4 {
+		t.Fatalf("hit=%v cell=%d side=%d, expected the east wall", ray.Hit, ray.Cell, ray.Side)
+	}
+
+	if expected := math.Hypot(106, 90); math.Abs(ray.Distance-expected) > 1e-9 {
+		t.Errorf("distance %f, expected %f", ray.Distance, expected)
+	}
+
+	if ray.MapX != 4 || ray.MapY != 3 || math.Abs(ray.HitY-250) > 1e-9 {
+		t.Errorf("hit cell (%d, %d) at y=%f, expected cell (4, 3) at y=250", ray.MapX, ray.MapY, ray.HitY)
+	}
+}
+
+func TestCastRayLeavingTheMap(t *testing.T) {
+	// no wall to stop the ray
+	gs := newTestGameState(t, gridOf(3, 0, 0, 0, 0, 0, 0, 0, 0, 0), 1, 1, 0)
+
+	for _, angle := range []float64{0, 1, math.Pi, 4} {
+		ray := gs.CastRay(96, 96, angle)
+
+		if ray.Hit || ray.Cell != 0 {
+			t.Errorf("angle %f: hit=%v cell=%d, expected a miss", angle, ray.Hit, ray.Cell)
+		}
+	}
+}
+
+func TestCastRaysSpacing(t *testing.T) {
+	gs := newTestGameState(t, roomGrid(), 2, 2, 0)
+	cam := Camera{X: 160, Y: 160, Angle: 1, FOV: math.Pi / 2}
+
+	rays := make([]Ray, 9)
+	gs.CastRays(cam, rays)
+
+	// rays are evenly spaced on the camera plane, not in angle
+	plane := math.Tan(cam.FOV / 2)
+	for i, ray := range rays {
+		screenX := 2*(float64(i)+0.5)/float64(len(rays)) - 1
+		offset := normalizeAngle(ray.Angle-cam.Angle+math.Pi) - math.Pi
+
+		if math.Abs(math.Tan(offset)-screenX*plane) > 1e-9 {
+			t.Errorf("ray %d is %f radians off the camera, expected atan(%f)", i, offset, screenX*plane)
+		}
+	}
+
+	if math.Abs(rays[4].Angle-cam.Angle) > 1e-9 {
+		t.Errorf("middle ray at %f, expected the camera angle %f", rays[4].Angle, cam.Angle)
+	}
+
+	// bands cast the same rays as the whole screen
+	band := make([]Ray, 3)
+	gs.castColumns(cam, band, 5, len(rays))
+
+	for i := range band {
+		if band[i] != rays[5+i] {
+			t.Errorf("column %d cast in a band differs: %+v, expected %+v", 5+i, band[i], rays[5+i])
+		}
+	}
+}
@@ -0,0 +1,362 @@
+package wolfenstein
+
+import (
+	"github.com/DrSmithFr/go-webassembly/src/gfx"
+	"image"
+	"image/color"
+	"math"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// Renderer draws the first person view of a GameState.
+//
+// Columns are independent, so the screen is split in bands of columns rendered
+// by as many goroutines, each writing its own pixels of the image. Go wasm
+// runs a single thread for now, there the bands are rendered one after the
+// other on the calling goroutine.
+type Renderer struct {
+	gs   *GameState
+	rays []Ray // one ray per screen column, reused between frames
+
+	// z-buffer, distance from the camera plane to the wall of each column,
+	// sprites are only drawn on columns where they stand in front of it
+	depth []float64
+
+	// sprites in front of the camera this frame, the farthest first
+	visible []projectedSprite
+
+	// Workers is the number of bands rendered concurrently, GOMAXPROCS when
+	// zero.
+	Workers int
+
+	CeilingColor color.RGBA
+	FloorColor   color.RGBA
+	WallColor    color.RGBA
+}
+
+func NewRenderer(gs *GameState) *Renderer {
+	return &Renderer{
+		gs:           gs,
+		CeilingColor: color.RGBA{0x38, 0x38, 0x38, 0xff},
+		FloorColor:   color.RGBA{0x70, 0x70, 0x70, 0xff},
+		WallColor:    color.RGBA{0xE5, 0x00, 0x00, 0xff},
+	}
+}
+
+// Render draws ceiling, floor, walls and sprites seen from cam on img. The size is read
+// from img on every call so the view follows canvas resizes.
+func (r *Renderer) Render(img *image.RGBA, cam Camera) {
+	width := img.Bounds().Dx()
+	height := img.Bounds().Dy()
+
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	if cap(r.rays) < width {
+		r.rays = make([]Ray, width)
+		r.depth = make([]float64, width)
+	}
+	r.rays = r.rays[:width]
+	r.depth = r.depth[:width]
+
+	r.projectSprites(cam, float64(width), float64(height))
+
+	bands := r.bands(width)
+	if bands == 1 {
+		r.renderBand(img, cam, 0, width)
+		return
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(bands)
+
+	for i := 0; i < bands; i++ {
+		go func(x0, x1 int) {
+			defer wg.Done()
+			r.renderBand(img, cam, x0, x1)
+		}(i*width/bands, (i+1)*width/bands)
+	}
+
+	wg.Wait()
+}
+
+// bands returns how many bands the columns are split in. Bands narrower than
+// minBandWidth cost more to schedule than they save.
+func (r *Renderer) bands(width int) int {
+	workers := r.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if most := width / minBandWidth; workers > most {
+		workers = most
+	}
+
+	if workers < 1 {
+		workers = 1
+	}
+
+	return workers
+}
+
+// minBandWidth is the narrowest band of columns given to a goroutine.
+const minBandWidth = 16
+
+// renderBand draws the columns from x0 included to x1 excluded. It only reads
+// shared state and writes its own columns of img and rays, so bands may be
+// rendered concurrently.
+func (r *Renderer) renderBand(img *image.RGBA, cam Camera, x0, x1 int) {
+	h := float64(img.Bounds().Dy())
+	fb := gfx.NewFramebuffer(img)
+
+	// ceiling and floor
+	fillRows(fb, x0, x1, 0, h/2, r.CeilingColor)
+	fillRows(fb, x0, x1, h/2, h, r.FloorColor)
+
+	// walls
+	rays := r.rays[x0:x1]
+	r.gs.castColumns(cam, rays, x0, len(r.rays))
+
+	// distance from the eye to the projection plane, in pixels
+	projection := (float64(len(r.rays)) / 2) / math.Tan(cam.FOV/2)
+	blockSize := float64(r.gs.GetBlockSize())
+
+	for i, ray := range rays {
+		r.depth[x0+i] = math.Inf(1)
+
+		if !ray.Hit {
+			continue
+		}
+
+		// fix fisheye by using the distance to the camera plane
+		distance := ray.Distance * math.Cos(ray.Angle-cam.Angle)
+		if distance <= 0 {
+			continue
+		}
+
+		r.depth[x0+i] = distance
+
+		lineH := blockSize * projection / distance
+		top := h/2 - lineH/2
+
+		if tile, ok := r.gs.textureOf(ray.Cell); ok {
+			r.drawTexturedColumn(img, x0+i, top, lineH, tile, ray)
+			continue
+		}
+
+		fillRows(fb, x0+i, x0+i+1, top, top+lineH, shade(r.WallColor, ray.Side))
+	}
+
+	for _, sprite := range r.visible {
+		r.drawSprite(img, sprite, x0, x1)
+	}
+}
+
+// projectedSprite is where a sprite lands on screen, in pixels.
+type projectedSprite struct {
+	left  float64
+	top   float64
+	size  float64 // width and height
+	depth float64 // distance to the camera plane, in world units
+	frame int
+}
+
+// Sprites closer to the camera plane than this, in world units, aren't drawn.
+const spriteNearPlane = 1
+
+// projectSprites finds the sprites in the field of view of cam, on a screen of
+// width x height pixels, and sorts them from the farthest to the nearest so
+// near sprites are drawn over far ones.
+func (r *Renderer) projectSprites(cam Camera, width, height float64) {
+	r.visible = r.visible[:0]
+
+	if r.gs.spriteSheet == nil {
+		return
+	}
+
+	// same projection as the walls
+	projection := (width / 2) / math.Tan(cam.FOV/2)
+	blockSize := float64(r.gs.GetBlockSize())
+	dirX, dirY := math.Cos(cam.Angle), math.Sin(cam.Angle)
+
+	for _, sprite := range r.gs.sprites {
+		// camera space: depth ahead of the camera plane, side to the right
+		dx, dy := sprite.X-cam.X, sprite.Y-cam.Y
+		depth := dx*dirX + dy*dirY
+		side := dy*dirX - dx*dirY
+
+		if depth < spriteNearPlane {
+			continue
+		}
+
+		size := blockSize * projection / depth
+		center := width/2 + side/depth*projection
+
+		if center+size/2 <= 0 || center-size/2 >= width {
+			continue
+		}
+
+		r.visible = append(r.visible, projectedSprite{
+			left:  center - size/2,
+			top:   height/2 - size/2,
+			size:  size,
+			depth: depth,
+			frame: sprite.FrameFrom(cam.X, cam.Y),
+		})
+	}
+
+	sort.Slice(r.visible, func(i, j int) bool {
+		return r.visible[i].depth > r.visible[j].depth
+	})
+}
+
+// drawSprite draws the columns of sprite between x0 included and x1 excluded
+// that stand in front of the walls, blending the transparent texels.
+func (r *Renderer) drawSprite(img *image.RGBA, sprite projectedSprite, x0, x1 int) {
+	sheet := r.gs.spriteSheet
+	size := sheet.TileSize()
+
+	height := img.Bounds().Dy()
+	left := int(math.Max(math.Ceil(sprite.left), float64(x0)))
+	right := int(math.Min(math.Ceil(sprite.left+sprite.size), float64(x1)))
+	y0 := int(math.Max(math.Ceil(sprite.top), 0))
+	y1 := int(math.Min(math.Ceil(sprite.top+sprite.size), float64(height)))
+
+	// texels per screen pixel
+	step := float64(size) / sprite.size
+	min := img.Bounds().Min
+
+	for x := left; x < right; x++ {
+		if sprite.depth >= r.depth[x] {
+			continue
+		}
+
+		texX := int((float64(x) - sprite.left) * step)
+		texPos := (float64(y0) - sprite.top) * step
+		offset := img.PixOffset(min.X+x, min.Y+y0)
+
+		for y := y0; y < y1; y++ {
+			texel := sheet.Texel(sprite.frame, texX, int(texPos))
+			texPos += step
+
+			if texel.A != 0 {
+				pix := img.Pix[offset : offset+4 : offset+4]
+				a := uint32(texel.A)
+				pix[0] = over(pix[0], texel.R, a)
+				pix[1] = over(pix[1], texel.G, a)
+				pix[2] = over(pix[2], texel.B, a)
+				pix[3] = over(pix[3], texel.A, a)
+			}
+
+			offset += img.Stride
+		}
+	}
+}
+
+// over draws the premultiplied src of opacity a, from 0 to 0xff, over dst.
+func over(dst, src uint8, a uint32) uint8 {
+	return uint8(uint32(src) + uint32(dst)*(0xff-a)/0xff)
+}
+
+// fillRows fills columns x0 to x1 of the frame between rows top and bottom,
+// which may fall between pixels or off screen. The fully covered rows are
+// filled at once, the partly covered ones at both ends are blended with the
+// coverage, so strips keep smooth edges.
+func fillRows(fb gfx.Framebuffer, x0, x1 int, top, bottom float64, c color.RGBA) {
+	img := fb.Image()
+	height := float64(img.Bounds().Dy())
+	min := img.Bounds().Min
+
+	y0 := int(math.Max(math.Floor(top), 0))
+	y1 := int(math.Min(math.Ceil(bottom), height))
+	full0 := int(math.Max(math.Ceil(top), 0))
+	full1 := int(math.Min(math.Floor(bottom), height))
+
+	if full0 < full1 {
+		fb.FillRect(image.Rect(min.X+x0, min.Y+full0, min.X+x1, min.Y+full1), c)
+	}
+
+	if y0 < full0 {
+		blendRow(img, x0, x1, y0, math.Min(bottom, float64(y0+1))-top, c)
+	}
+
+	// unless it is the top row too
+	if full1 < y1 && y1-1 >= full0 {
+		blendRow(img, x0, x1, y1-1, bottom-math.Max(top, float64(y1-1)), c)
+	}
+}
+
+// blendRow draws c over columns x0 to x1 of row y of img, with the opacity of
+// the coverage, from 0 to 1.
+func blendRow(img *image.RGBA, x0, x1, y int, coverage float64, c color.RGBA) {
+	if coverage <= 0 {
+		return
+	}
+
+	min := img.Bounds().Min
+	row := img.Pix[img.PixOffset(min.X+x0, min.Y+y):img.PixOffset(min.X+x1, min.Y+y)]
+	a := uint32(coverage * 0xff)
+
+	for i := 0; i < len(row); i += 4 {
+		row[i] = blend(row[i], c.R, a)
+		row[i+1] = blend(row[i+1], c.G, a)
+		row[i+2] = blend(row[i+2], c.B, a)
+		row[i+3] = blend(row[i+3], c.A, a)
+	}
+}
+
+// blend draws src over dst with opacity a, from 0 to 0xff.
+func blend(dst, src uint8, a uint32) uint8 {
+	return uint8((uint32(dst)*(0xff-a) + uint32(src)*a) / 0xff)
+}
+
+// drawTexturedColumn writes a wall strip of height lineH starting at top
+// (possibly off screen) straight into img, sampling the texture column under
+// the ray hit offset. Every pixel gets its own texel, scaled and shaded, which
+// neither a Framebuffer span nor a blit can express.
+func (r *Renderer) drawTexturedColumn(img *image.RGBA, x int, top, lineH float64, tile int, ray Ray) {
+	textures := r.gs.GetTextures()
+	size := textures.TileSize()
+	texX := int(ray.Offset * float64(size))
+
+	height := img.Bounds().Dy()
+	y0 := int(math.Max(math.Ceil(top), 0))
+	y1 := int(math.Min(math.Ceil(top+lineH), float64(height)))
+
+	// texture rows per screen row
+	step := float64(size) / lineH
+	texPos := (float64(y0) - top) * step
+
+	min := img.Bounds().Min
+	offset := img.PixOffset(min.X+x, min.Y+y0)
+
+	for y := y0; y < y1; y++ {
+		texel := shade(textures.Texel(tile, texX, int(texPos)), ray.Side)
+		texPos += step
+
+		pix := img.Pix[offset : offset+4 : offset+4]
+		pix[0] = texel.R
+		pix[1] = texel.G
+		pix[2] = texel.B
+		pix[3] = texel.A
+
+		offset += img.Stride
+	}
+}
+
+// shade darkens horizontal faces so corners stay readable.
+func shade(c color.RGBA, side Side) color.RGBA {
+	if side == SideVertical {
+		return c
+	}
+
+	return color.RGBA{
+		R: uint8(uint16(c.R) * 0xb2 / 0xE5),
+		G: uint8(uint16(c.G) * 0xb2 / 0xE5),
+		B: uint8(uint16(c.B) * 0xb2 / 0xE5),
+		A: c.A,
+	}
+}
@@ -0,0 +1,180 @@
+package wolfenstein
+
+import (
+	"bytes"
+	"github.com/DrSmithFr/go-webassembly/src/browser"
+	"github.com/DrSmithFr/go-webassembly/src/internal/golden"
+	"image"
+	"image/color"
+	"math"
+	"testing"
+)
+
+// renderScene draws what cam sees of gs on a headless canvas, like the game
+// does in the browser.
+func renderScene(gs *GameState, cam Camera, width, height int, minimap bool) *image.RGBA {
+	canvas := browser.NewHeadlessCanvas(width, height)
+	gc := canvas.Gc()
+
+	gc.SetFillColor(color.RGBA{0x18, 0x18, 0x18, 0xff})
+	gc.Clear()
+
+	NewRenderer(gs).Render(canvas.Image(), cam)
+
+	if minimap {
+		NewMinimap(gs, 0.1).Render(gc, cam)
+	}
+
+	return canvas.Image()
+}
+
+func TestRendererGolden(t *testing.T) {
+	gs, err := NewGameStateFromLevel(DefaultLevel())
+	if err != nil {
+		t.Fatalf("NewGameStateFromLevel: %v", err)
+	}
+
+	spawn := gs.Camera()
+
+	corner := spawn
+	corner.X, corner.Y, corner.Angle = 1.5*64, 6.5*64, 3*math.Pi/4
+
+	wide := corner
+	wide.FOV = math.Pi / 2
+
+	tests := []struct {
+		name    string
+		cam     Camera
+		flat    bool
+		minimap bool
+		width   int
+		height  int
+	}{
+		{name: "spawn", cam: spawn, width: 160, height: 100},
+		{name: "corner", cam: corner, width: 160, height: 100},
+		{name: "corner_wide_fov", cam: wide, width: 160, height: 100},
+		{name: "corner_flat", cam: corner, flat: true, width: 160, height: 100},
+		{name: "corner_tall", cam: corner, width: 100, height: 160},
+		{name: "spawn_minimap", cam: spawn, minimap: true, width: 160, height: 100},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			textures := gs.GetTextures()
+			if test.flat {
+				gs.SetTextures(nil)
+				defer gs.SetTextures(textures)
+			}
+
+			img := renderScene(gs, test.cam, test.width, test.height, test.minimap)
+			golden.Assert(t, "renderer_"+test.name, img, golden.Default)
+		})
+	}
+}
+
+// spriteLevel has a pillar in the middle of a room, in front of the camera
+// when it looks east from (1.5, 3.5).
+func spriteLevel(entities ...Entity) *Level {
+	return &Level{
+		Name:   "sprites",
+		Width:  10,
+		Height: 7,
+		Grid: []string{
+			"1111111111",
+			"1........1",
+			"1........1",
+			"1....2...1",
+			"1........1",
+			"1........1",
+			"1111111111",
+		},
+		Spawn:    Spawn{X: 1.5, Y: 3.5, Angle: 0},
+		Entities: entities,
+	}
+}
+
+func TestRendererSpritesGolden(t *testing.T) {
+	gs, err := NewGameStateFromLevel(spriteLevel(
+		Entity{Type: "guard", X: 7.5, Y: 2.6, Angle: 180}, // facing the camera, partly hidden by the pillar
+		Entity{Type: "guard", X: 8.5, Y: 2.2, Angle: 0},   // seen from behind, behind the other guard
+		Entity{Type: "barrel", X: 3.5, Y: 4.8},            // cut by the right edge
+		Entity{Type: "lamp", X: 3.5, Y: 2.5},              // translucent glow, cut by the left edge
+	))
+	if err != nil {
+		t.Fatalf("NewGameStateFromLevel: %v", err)
+	}
+
+	img := renderScene(gs, gs.Camera(), 160, 100, false)
+	golden.Assert(t, "renderer_sprites", img, golden.Default)
+}
+
+// Sprites hidden by a wall on every column they cover leave no trace.
+func TestRendererSpritesOccluded(t *testing.T) {
+	hidden, err := NewGameStateFromLevel(spriteLevel(Entity{Type: "guard", X: 7.5, Y: 3.5}))
+	if err != nil {
+		t.Fatalf("NewGameStateFromLevel: %v", err)
+	}
+
+	if len(hidden.GetSprites()) != 1 {
+		t.Fatalf("got %d sprites, expected 1", len(hidden.GetSprites()))
+	}
+
+	empty, err := NewGameStateFromLevel(spriteLevel())
+	if err != nil {
+		t.Fatalf("NewGameStateFromLevel: %v", err)
+	}
+
+	got := renderScene(hidden, hidden.Camera(), 160, 100, false)
+	expected := renderScene(empty, empty.Camera(), 160, 100, false)
+
+	if !bytes.Equal(got.Pix, expected.Pix) {
+		t.Errorf("a sprite behind the pillar was drawn over it")
+	}
+}
+
+// Bands rendered concurrently must give the same picture as a single band.
+func TestRendererWorkers(t *testing.T) {
+	gs, err := NewGameStateFromLevel(DefaultLevel())
+	if err != nil {
+		t.Fatalf("NewGameStateFromLevel: %v", err)
+	}
+
+	cam := gs.Camera()
+	cam.X, cam.Y, cam.Angle = 1.5*64, 6.5*64, 3*math.Pi/4
+
+	render := func(workers int) *image.RGBA {
+		img := image.NewRGBA(image.Rect(0, 0, 321, 200))
+		renderer := NewRenderer(gs)
+		renderer.Workers = workers
+		renderer.Render(img, cam)
+		return img
+	}
+
+	single := render(1)
+
+	for _, workers := range []int{2, 3, 8, 64} {
+		if !bytes.Equal(render(workers).Pix, single.Pix) {
+			t.Errorf("%d workers: picture differs from a single worker", workers)
+		}
+	}
+}
+
+// go test ./src/wolfenstein -bench Renderer -cpu 1,2,4,8 shows how rendering
+// scales with the cores.
+func BenchmarkRenderer(b *testing.B) {
+	gs, err := NewGameStateFromLevel(DefaultLevel())
+	if err != nil {
+		b.Fatalf("NewGameStateFromLevel: %v", err)
+	}
+
+	cam := gs.Camera()
+	img := image.NewRGBA(image.Rect(0, 0, 1280, 720))
+	renderer := NewRenderer(gs)
+
+	b.SetBytes(int64(len(img.Pix)))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		renderer.Render(img, cam)
+	}
+}
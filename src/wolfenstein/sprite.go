@@ -0,0 +1,69 @@
+package wolfenstein
+
+import (
+	"math"
+)
+
+// spriteSize is the width and height of the embedded sprite frames.
+const spriteSize = 64
+
+// SpriteKind is how a type of entity looks: a run of frames of the sprite
+// sheet, one per direction it may be seen from.
+type SpriteKind struct {
+	Frame  int // first frame in the sheet
+	Frames int // number of frames, 1 for sprites looking the same from everywhere
+}
+
+// spriteKinds maps entity types to the frames of the embedded sprite sheet,
+// which holds a kind per row of 8 frames. Entities of other types aren't drawn.
+var spriteKinds = map[string]SpriteKind{
+	"barrel": {Frame: 0, Frames: 1},
+	"lamp":   {Frame: 8, Frames: 1},
+	"guard":  {Frame: 16, Frames: 8},
+}
+
+// Sprite is an entity drawn as a billboard, a picture always facing the
+// camera, as tall as a wall and standing on the floor. Transparent pixels of
+// the sheet show what is behind.
+type Sprite struct {
+	X     float64 // position, in world units
+	Y     float64
+	Angle float64 // where the entity faces, in radians
+	Kind  SpriteKind
+}
+
+// FrameFrom returns the sheet frame showing the sprite seen from (x, y).
+// Frame 0 of the kind shows its front, the next ones show it seen from
+// further around it, in the direction of increasing angles.
+func (s Sprite) FrameFrom(x, y float64) int {
+	if s.Kind.Frames <= 1 {
+		return s.Kind.Frame
+	}
+
+	// direction of the viewer, relative to where the sprite faces
+	seen := normalizeAngle(math.Atan2(y-s.Y, x-s.X) - s.Angle)
+	step := 2 * math.Pi / float64(s.Kind.Frames)
+
+	return s.Kind.Frame + int(math.Round(seen/step))%s.Kind.Frames
+}
+
+// spritesOf returns the sprites of the entities having a known type.
+func spritesOf(entities []Entity, blockSize float64) []Sprite {
+	var sprites []Sprite
+
+	for _, entity := range entities {
+		kind, ok := spriteKinds[entity.Type]
+		if !ok {
+			continue
+		}
+
+		sprites = append(sprites, Sprite{
+			X:     entity.X * blockSize,
+			Y:     entity.Y * blockSize,
+			Angle: normalizeAngle(entity.Angle * math.Pi / 180),
+			Kind:  kind,
+		})
+	}
+
+	return sprites
+}
@@ -0,0 +1,283 @@
+package wolfenstein
+
+var (
+	// SpriteData contains the binarized sprite folder
+	SpriteData = fs{
+		"sprites.png": []byte{
+			0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A, 0x00, 0x00, 0x00, 0x0D, 0x49, 0x48, 0x44, 0x52, 0x00, 0x00, 0x02, 0x00,
+			0x00, 0x00, 0x00, 0xC0, 0x08, 0x06, 0x00, 0x00, 0x00, 0x72, 0x0F, 0xDB, 0x67, 0x00, 0x00, 0x15, 0x22, 0x49, 0x44, 0x41,
+			0x54, 0x78, 0x9C, 0xEC, 0xDA, 0x3B, 0x0D, 0x80, 0x00, 0x0C, 0x86, 0x41, 0x4A, 0x70, 0xC2, 0x82, 0x1C, 0xA4, 0x22, 0x87,
+			0x05, 0x2D, 0x30, 0x55, 0x41, 0x53, 0xC2, 0xE3, 0xBE, 0xA9, 0x0E, 0x7A, 0xC3, 0x3F, 0x0E, 0x92, 0x24, 0xE9, 0x77, 0x01,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0xDC, 0x0C, 0x80, 0x29, 0x8F, 0x8E, 0x96, 0x75, 0x3E, 0xF3, 0xAE, 0xB4, 0x6F, 0x47, 0xE4, 0x2D, 0x49, 0x92, 0xEA, 0xC5,
+			0xD3, 0x9E, 0x3D, 0x14, 0x40, 0x01, 0x14, 0x40, 0x01, 0x14, 0x40, 0x01, 0x14, 0xF4, 0xA3, 0x20, 0xDE, 0xF6, 0xF8, 0x41,
+			0x00, 0x04, 0x40, 0x00, 0x04, 0x40, 0x00, 0x04, 0x40, 0xA0, 0x0E, 0x01, 0x1B, 0x00, 0x1B, 0x00, 0x1B, 0x00, 0x1B, 0x00,
+			0x1B, 0x00, 0x1B, 0x00, 0x1B, 0x80, 0xEF, 0x6D, 0x00, 0x2E, 0xF6, 0xEE, 0xA0, 0x35, 0x8A, 0x18, 0x0A, 0xE0, 0xF8, 0x9B,
+			0x24, 0x93, 0x6A, 0xC1, 0x8B, 0x37, 0xBF, 0xFF, 0x17, 0xF3, 0xE6, 0x41, 0x50, 0xA8, 0xB8, 0xDA, 0x99, 0x24, 0x23, 0x33,
+			0xBA, 0x8B, 0xC5, 0xA3, 0xDA, 0xED, 0xC2, 0x2F, 0xE1, 0x51, 0xF4, 0x30, 0xBD, 0xFE, 0x79, 0xA4, 0xFC, 0xFE, 0xFF, 0x1B,
+			0x80, 0x87, 0xF7, 0x0F, 0x51, 0xEE, 0x4A, 0xDC, 0xBF, 0xBB, 0x3F, 0xFF, 0xD7, 0xB3, 0x9C, 0xD3, 0x87, 0x53, 0xB4, 0xC7,
+			0x76, 0xFE, 0xA7, 0x0D, 0x80, 0x0D, 0x80, 0x0D, 0x80, 0x0D, 0x80, 0x0D, 0x80, 0x0D, 0x80, 0x0D, 0xC0, 0x35, 0x36, 0x00,
+			0xCB, 0xE7, 0xC7, 0x18, 0x5F, 0x5B, 0x4C, 0xEB, 0x16, 0x65, 0x4A, 0x71, 0x57, 0xFF, 0xDD, 0xFB, 0xC2, 0xFD, 0x5B, 0xFB,
+			0x37, 0xF7, 0x6F, 0xEF, 0xBF, 0x63, 0xFF, 0x5D, 0x36, 0x00, 0x36, 0x00, 0x36, 0x00, 0x36, 0x00, 0x36, 0x00, 0x36, 0x00,
+			0x36, 0x00, 0x57, 0xDC, 0x00, 0xBC, 0xF9, 0xF6, 0x36, 0x6A, 0xAF, 0x51, 0x5B, 0x8D, 0x5A, 0x6B, 0xCC, 0xF3, 0x1C, 0xA5,
+			0x94, 0x27, 0x93, 0x73, 0x8E, 0x94, 0xD2, 0x65, 0xA6, 0x69, 0x3A, 0xE6, 0xF7, 0xB3, 0x6D, 0xDB, 0x31, 0x63, 0x8C, 0xCB,
+			0xF4, 0xDE, 0xA3, 0xB5, 0x16, 0xED, 0xD4, 0x7E, 0xFE, 0x6C, 0x2D, 0xD6, 0x75, 0x8D, 0x65, 0x59, 0x2E, 0x13, 0xF1, 0xF1,
+			0xFC, 0x09, 0x1B, 0x00, 0x1B, 0x00, 0x1B, 0x00, 0x1B, 0x00, 0x1B, 0x00, 0x1B, 0x00, 0x1B, 0x80, 0xE7, 0xDE, 0x00, 0x38,
+			0x8E, 0xE3, 0x38, 0x8E, 0x73, 0x3B, 0xE7, 0xAF, 0x37, 0x00, 0x5F, 0x5E, 0x7F, 0x3A, 0xDE, 0x00, 0x94, 0x57, 0x39, 0x4A,
+			0xCD, 0x91, 0xCF, 0x33, 0xA7, 0x48, 0x73, 0x8A, 0x5C, 0x52, 0xA4, 0x92, 0x62, 0xCA, 0x53, 0xA4, 0x34, 0xC5, 0xF4, 0x6B,
+			0xFE, 0xF8, 0xFB, 0x83, 0x2D, 0x62, 0x1B, 0xDB, 0x31, 0x63, 0xFF, 0xD9, 0xB7, 0x18, 0x6D, 0x44, 0x6F, 0x23, 0xC6, 0x3A,
+			0xA2, 0xEF, 0xB3, 0xF4, 0x63, 0xDA, 0x3E, 0xDF, 0x7B, 0xB4, 0xEC, 0x0D, 0x80, 0x37, 0x00, 0xDE, 0x00, 0x78, 0x03, 0xE0,
+			0x0D, 0x80, 0x37, 0x00, 0xDE, 0x00, 0x5C, 0xF5, 0x0D, 0x80, 0xEB, 0xBA, 0xAE, 0xEB, 0xBA, 0xB7, 0x73, 0x05, 0x80, 0x00,
+			0x10, 0x00, 0x02, 0x40, 0x00, 0x08, 0x00, 0x01, 0x20, 0x00, 0x04, 0x80, 0x00, 0x10, 0x00, 0x02, 0x40, 0x00, 0x08, 0x00,
+			0x01, 0x20, 0x00, 0x04, 0x80, 0x00, 0x10, 0x00, 0x02, 0x40, 0x00, 0x08, 0x00, 0x01, 0x20, 0x00, 0x04, 0x80, 0x00, 0x10,
+			0x00, 0x02, 0x40, 0x00, 0x08, 0x00, 0x01, 0x20, 0x00, 0x04, 0x80, 0x00, 0x10, 0x00, 0x02, 0x40, 0x00, 0x08, 0x00, 0x01,
+			0x20, 0x00, 0x04, 0x80, 0x00, 0x10, 0x00, 0x02, 0x40, 0x00, 0x08, 0x00, 0x01, 0x20, 0x00, 0x04, 0x80, 0x00, 0x10, 0x00,
+			0x02, 0x40, 0x00, 0x08, 0x00, 0x01, 0x20, 0x00, 0x04, 0x80, 0x00, 0x10, 0x00, 0x02, 0x40, 0x00, 0x08, 0x00, 0x01, 0xF0,
+			0x72, 0x02, 0xE0, 0x36, 0x2C, 0x80, 0xC6, 0x02, 0x60, 0x01, 0xB0, 0x00, 0x58, 0x00, 0x2C, 0x00, 0x16, 0x00, 0x0B, 0x80,
+			0x05, 0xC0, 0x02, 0x60, 0x01, 0xB0, 0x00, 0x58, 0x00, 0x2C, 0x00, 0x16, 0x00, 0x0B, 0x80, 0x05, 0xC0, 0x02, 0x60, 0x01,
+			0xB0, 0x00, 0x58, 0x00, 0x2C, 0x00, 0x16, 0x00, 0x0B, 0x80, 0x05, 0xC0, 0x02, 0x60, 0x01, 0xB0, 0x00, 0x58, 0x00, 0x2C,
+			0x00, 0x16, 0x00, 0x0B, 0x80, 0x05, 0xC0, 0x02, 0x60, 0x01, 0xB0, 0x00, 0x58, 0x00, 0x2C, 0x00, 0x16, 0x00, 0x0B, 0x80,
+			0x05, 0xC0, 0x02, 0x60, 0x01, 0xB0, 0x00, 0x58, 0x00, 0x2C, 0x00, 0x16, 0x00, 0x0B, 0x80, 0x05, 0xC0, 0x02, 0x60, 0x01,
+			0xB0, 0x00, 0x58, 0x00, 0x2C, 0x00, 0x16, 0x00, 0x0B, 0x80, 0x05, 0xC0, 0x02, 0x60, 0x01, 0xB0, 0x00, 0x58, 0x00, 0x2C,
+			0x00, 0x16, 0x00, 0x0B, 0x80, 0x05, 0xC0, 0x02, 0x60, 0x01, 0xB0, 0x00, 0x58, 0x00, 0x2C, 0x00, 0x16, 0x00, 0x0B, 0x80,
+			0x05, 0xC0, 0x02, 0x60, 0x01, 0xB0, 0x00, 0x58, 0x00, 0x2C, 0x00, 0x16, 0x00, 0x0B, 0x80, 0x05, 0xC0, 0x02, 0x60, 0x01,
+			0xB0, 0x00, 0x58, 0x00, 0x2C, 0x00, 0x16, 0x00, 0x0B, 0x80, 0x05, 0xC0, 0x02, 0x60, 0x01, 0xB0, 0x00, 0x58, 0x00, 0x2C,
+			0x00, 0x16, 0x00, 0x0B, 0x80, 0x05, 0xC0, 0x02, 0x60, 0x01, 0xB0, 0x00, 0x58, 0x00, 0x2C, 0x00, 0x16, 0x00, 0x0B, 0x80,
+			0x05, 0xC0, 0x02, 0x60, 0x01, 0xB0, 0x00, 0x58, 0x00, 0x2C, 0x00, 0x16, 0x00, 0x0B, 0x80, 0x05, 0xC0, 0x02, 0x60, 0x01,
+			0xB0, 0x00, 0x58, 0x00, 0x2C, 0x00, 0x16, 0x00, 0x0B, 0x80, 0x05, 0xC0, 0x02, 0x60, 0x01, 0xB0, 0x00, 0x58, 0x00, 0x2C,
+			0x00, 0x16, 0x00, 0x0B, 0x80, 0x05, 0xC0, 0x02, 0x60, 0x01, 0xB0, 0x00, 0x58, 0x00, 0x2C, 0x00, 0x16, 0x00, 0x0B, 0x80,
+			0x05, 0xC0, 0x02, 0x60, 0x01, 0xB0, 0x00, 0x58, 0x00, 0x2C, 0x00, 0x16, 0xC0, 0x13, 0x0B, 0xE0, 0x07, 0x7B, 0xE7, 0x93,
+			0xDB, 0xC6, 0x0D, 0x85, 0xF1, 0x8F, 0xE4, 0x8C, 0x1C, 0xC1, 0x40, 0x81, 0x7A, 0xE7, 0x5B, 0x14, 0xDD, 0x38, 0x05, 0x0A,
+			0x14, 0x41, 0xD0, 0x75, 0x53, 0x04, 0xEA, 0x11, 0x72, 0x82, 0xAE, 0x63, 0xB8, 0xEB, 0x9E, 0x20, 0x57, 0x30, 0x0A, 0xE4,
+			0x06, 0xDE, 0x64, 0xE3, 0x4D, 0x51, 0x14, 0xBD, 0x40, 0x57, 0xDE, 0x39, 0x40, 0x00, 0x43, 0x91, 0x86, 0x7F, 0x8A, 0x27,
+			0x3E, 0x7A, 0xA8, 0x89, 0x62, 0xC9, 0xB2, 0x94, 0x04, 0xC5, 0xC7, 0x31, 0x21, 0x69, 0xCC, 0x21, 0x67, 0xF7, 0x7E, 0x24,
+			0x3F, 0x7E, 0xEF, 0xE1, 0xDF, 0xCC, 0xEA, 0xDB, 0xBB, 0xB9, 0x52, 0x9A, 0x38, 0xE0, 0x6B, 0x0B, 0x1C, 0x58, 0xA0, 0xB1,
+			0x80, 0xB3, 0xC0, 0xA1, 0x01, 0xA6, 0x06, 0x18, 0x0F, 0xC6, 0x9E, 0x26, 0x60, 0x9C, 0x80, 0x9B, 0x04, 0x84, 0x08, 0xF8,
+			0x08, 0xCC, 0x22, 0xF0, 0x36, 0x1A, 0x73, 0x1E, 0x4A, 0x2B, 0x02, 0x00, 0x01, 0x80, 0x00, 0x40, 0x00, 0x20, 0x00, 0x10,
+			0x00, 0x08, 0x00, 0x5F, 0x28, 0x00, 0xA4, 0x74, 0x6A, 0x81, 0x2B, 0x07, 0x1C, 0x3B, 0xE0, 0xC6, 0x01, 0xD6, 0x02, 0x46,
+			0xEB, 0x81, 0x01, 0xE6, 0x06, 0x18, 0x0D, 0xC6, 0x9E, 0x27, 0x60, 0x94, 0x80, 0xD9, 0x42, 0x0E, 0x98, 0x6B, 0x8C, 0xC0,
+			0x61, 0x00, 0xAE, 0x02, 0x70, 0x1C, 0x8C, 0x39, 0x8B, 0xA5, 0x35, 0x01, 0x80, 0x00, 0x40, 0x00, 0x20, 0x00, 0x10, 0x00,
+			0x08, 0x00, 0x04, 0x80, 0x2F, 0x08, 0x00, 0x52, 0x3A, 0x6D, 0x80, 0x6B, 0x07, 0x1C, 0x39, 0x60, 0xEA, 0x80, 0xB1, 0x03,
+			0xE6, 0x2E, 0x6F, 0x37, 0x08, 0x04, 0xB4, 0x06, 0xE8, 0x4C, 0xFE, 0xAC, 0x4B, 0x97, 0x80, 0x36, 0xE5, 0x4F, 0x09, 0xFE,
+			0x88, 0xC0, 0x28, 0x00, 0xD3, 0x00, 0x8C, 0x03, 0x70, 0x1D, 0x80, 0x23, 0x81, 0x00, 0x5F, 0x9E, 0x20, 0x00, 0x10, 0x00,
+			0x08, 0x00, 0x04, 0x00, 0x02, 0x00, 0x01, 0x80, 0x00, 0xF0, 0x99, 0x01, 0x20, 0x25, 0x18, 0xE0, 0x45, 0x93, 0x67, 0xFD,
+			0x68, 0x80, 0xF7, 0x32, 0xF3, 0x6F, 0x80, 0x91, 0x06, 0xFF, 0xBA, 0x7A, 0x03, 0x34, 0x83, 0xB1, 0x7D, 0x02, 0x9A, 0x94,
+			0x03, 0x7F, 0x5D, 0xE7, 0x01, 0x88, 0x1E, 0x78, 0x24, 0xDB, 0x00, 0x3E, 0xAF, 0x06, 0xBC, 0xF2, 0xC6, 0x20, 0x11, 0x00,
+			0x08, 0x00, 0x04, 0x00, 0x02, 0x00, 0x01, 0x80, 0x00, 0x40, 0x00, 0xF8, 0x8C, 0x00, 0xD0, 0x07, 0xFF, 0xC3, 0x06, 0x68,
+			0x9B, 0x1C, 0xF8, 0x0F, 0x14, 0x04, 0xBC, 0x03, 0x9A, 0x01, 0x04, 0x04, 0x03, 0xB8, 0xC1, 0xD8, 0x21, 0x01, 0x6E, 0x00,
+			0x00, 0x3E, 0x00, 0x8D, 0x06, 0xFE, 0x99, 0x82, 0x40, 0xE7, 0x81, 0x1B, 0x4F, 0x08, 0x20, 0x04, 0x10, 0x02, 0x08, 0x01,
+			0x84, 0x00, 0x42, 0x00, 0x21, 0x60, 0x7B, 0x08, 0xD8, 0x11, 0x00, 0xBC, 0x68, 0xFB, 0xE0, 0x3F, 0x6E, 0x73, 0xE0, 0xEF,
+			0x1C, 0x60, 0x9A, 0x1C, 0xFC, 0x83, 0x03, 0x5C, 0x05, 0x01, 0xD1, 0x00, 0x76, 0x30, 0x76, 0x4C, 0x80, 0xAD, 0x00, 0x20,
+			0x04, 0xC0, 0x85, 0x0C, 0x01, 0xC9, 0x03, 0xAD, 0x82, 0xC0, 0xB4, 0x2B, 0x10, 0x60, 0xCC, 0xAB, 0xAE, 0x3C, 0x4D, 0x00,
+			0x20, 0x00, 0x10, 0x00, 0x08, 0x00, 0x04, 0x00, 0x02, 0x00, 0x01, 0xE0, 0x13, 0x02, 0x40, 0xDE, 0xF3, 0x97, 0x80, 0x3F,
+			0x6D, 0xFB, 0xE0, 0x5F, 0xD7, 0x12, 0xFC, 0xE3, 0x60, 0x15, 0x60, 0x15, 0x00, 0x94, 0xE0, 0x2F, 0xD5, 0x86, 0x1E, 0x02,
+			0xE0, 0x97, 0xAB, 0x40, 0xC0, 0x58, 0x82, 0xBF, 0xA7, 0x26, 0x80, 0x9A, 0x00, 0x6A, 0x02, 0xA8, 0x09, 0xA0, 0x26, 0x80,
+			0x9A, 0x00, 0x6A, 0x02, 0xEE, 0xAF, 0x09, 0x78, 0x90, 0x11, 0x50, 0x56, 0xFB, 0x5F, 0xEB, 0x52, 0xBF, 0xAD, 0x82, 0xBE,
+			0xD4, 0xA0, 0x30, 0xE0, 0x5A, 0x20, 0xB6, 0x80, 0x95, 0xDF, 0xA3, 0xCD, 0xAA, 0xD5, 0x67, 0x5C, 0xBB, 0xDC, 0x17, 0x9A,
+			0xE5, 0xB1, 0xAE, 0x5D, 0x7E, 0x07, 0xE6, 0x02, 0x60, 0x2E, 0x00, 0xE6, 0x02, 0x60, 0x2E, 0x00, 0xE6, 0x02, 0x60, 0x2E,
+			0x00, 0xE6, 0x02, 0xF8, 0x84, 0xB9, 0x00, 0xCA, 0x51, 0x3F, 0x11, 0xFC, 0x3D, 0x72, 0x79, 0xD9, 0xBF, 0xAD, 0x02, 0x76,
+			0x2C, 0x01, 0xBB, 0x40, 0x82, 0xAD, 0x56, 0x02, 0x86, 0xAB, 0x0F, 0xBA, 0x02, 0x20, 0x33, 0x7F, 0x88, 0x80, 0xD0, 0x03,
+			0xB1, 0xB4, 0x33, 0x80, 0xDE, 0x5E, 0x9C, 0x12, 0x38, 0x88, 0xC0, 0xFB, 0x08, 0x1C, 0x45, 0xE0, 0xAA, 0xAC, 0x1A, 0x10,
+			0x00, 0x08, 0x00, 0x04, 0x00, 0x02, 0x00, 0x01, 0x80, 0x00, 0x40, 0x00, 0xD8, 0x37, 0x00, 0x64, 0x93, 0x9F, 0x63, 0x3D,
+			0xEA, 0xE7, 0x34, 0xD0, 0x1B, 0xFD, 0x5C, 0x2C, 0xF9, 0xEB, 0xF7, 0x1E, 0x04, 0x2E, 0xCF, 0x2F, 0xDE, 0x94, 0xE7, 0xEF,
+			0x2A, 0x27, 0x93, 0x27, 0xDF, 0xF7, 0x81, 0xBF, 0xEC, 0x54, 0x2C, 0xF4, 0x01, 0x09, 0x30, 0xFA, 0x29, 0xBF, 0xA7, 0x11,
+			0x38, 0x8E, 0x29, 0x4D, 0x68, 0x16, 0x44, 0xB3, 0x20, 0x9A, 0x05, 0xD1, 0x2C, 0x88, 0x66, 0x41, 0x34, 0x0B, 0xA2, 0x59,
+			0xD0, 0x3D, 0xCC, 0x82, 0xCC, 0xEA, 0xDB, 0xEB, 0xAF, 0x2C, 0xFC, 0xFB, 0x6A, 0x04, 0x1C, 0xEA, 0xD2, 0xBE, 0x6F, 0x81,
+			0xA6, 0xCD, 0xB3, 0x7F, 0x59, 0xBA, 0x8F, 0xED, 0xE5, 0xF9, 0xC5, 0x5F, 0xA5, 0xFD, 0x43, 0xCA, 0xC9, 0xE4, 0xC9, 0x37,
+			0x80, 0xED, 0x80, 0xD0, 0x01, 0xAE, 0x03, 0x7C, 0x07, 0x34, 0xA2, 0x01, 0x98, 0x03, 0x37, 0x1D, 0xF0, 0x6E, 0x4E, 0x41,
+			0x20, 0x05, 0x81, 0x14, 0x04, 0x52, 0x10, 0x48, 0x41, 0x20, 0x05, 0x81, 0x14, 0x04, 0x6E, 0x2E, 0x08, 0xDC, 0x6A, 0xFF,
+			0x3C, 0xEF, 0xBB, 0x8B, 0xBD, 0xAF, 0xB5, 0xBD, 0xC9, 0x4F, 0xAD, 0xF6, 0x8F, 0x6E, 0x57, 0xC1, 0x5F, 0xAE, 0xDC, 0x57,
+			0xD4, 0xBE, 0x43, 0x75, 0xAC, 0x70, 0xAE, 0x2E, 0x83, 0x07, 0x96, 0x5A, 0x00, 0x6A, 0x01, 0xA8, 0x05, 0xA0, 0x16, 0x80,
+			0x5A, 0x00, 0x6A, 0x01, 0xA8, 0x05, 0xD8, 0x5C, 0x0B, 0xB0, 0x6D, 0xD0, 0x54, 0x6F, 0x7F, 0x53, 0xA9, 0xFA, 0x61, 0xAB,
+			0xE0, 0xFF, 0x77, 0x69, 0xB8, 0xAB, 0x92, 0xFB, 0x2C, 0x10, 0x50, 0x8F, 0x69, 0xF4, 0x5D, 0x60, 0x77, 0x31, 0x0E, 0x01,
+			0x80, 0x00, 0x40, 0x00, 0x20, 0x00, 0x10, 0x00, 0x08, 0x00, 0x04, 0x80, 0x8F, 0x02, 0xC0, 0x95, 0x18, 0xF9, 0x68, 0xF0,
+			0x5D, 0x86, 0x80, 0xCB, 0xF3, 0x8B, 0x7F, 0x4A, 0xAB, 0x5D, 0x17, 0xED, 0xBB, 0x1A, 0xAF, 0x8C, 0x2F, 0x49, 0x86, 0xAE,
+			0xCC, 0x4E, 0x06, 0xA1, 0x08, 0x90, 0x22, 0x40, 0x8A, 0x00, 0x29, 0x02, 0xA4, 0x08, 0x90, 0x22, 0x40, 0x8A, 0x00, 0x3F,
+			0x14, 0x01, 0x66, 0xD7, 0xBF, 0x03, 0xCD, 0xEA, 0x77, 0x2B, 0xD4, 0xAB, 0x82, 0xF2, 0x66, 0xD7, 0xC9, 0xB3, 0xDF, 0xCB,
+			0xD7, 0xC5, 0x75, 0xF9, 0xFA, 0xD7, 0xF2, 0x75, 0x5D, 0xA9, 0xC6, 0x6A, 0x75, 0x7C, 0x49, 0x30, 0x34, 0xB3, 0xF2, 0x6E,
+			0x74, 0x07, 0xA4, 0x3B, 0x20, 0xDD, 0x01, 0xE9, 0x0E, 0x48, 0x77, 0x40, 0xBA, 0x03, 0xD2, 0x1D, 0x70, 0xBD, 0x3B, 0xA0,
+			0xD9, 0xF2, 0xEC, 0xBF, 0x98, 0xFE, 0x8C, 0x00, 0x37, 0x02, 0xCC, 0xA0, 0x62, 0x74, 0x79, 0x7E, 0xF1, 0x67, 0x69, 0xBF,
+			0x2E, 0xF0, 0x0F, 0xCB, 0x5D, 0x20, 0x70, 0x32, 0x79, 0xF2, 0x6D, 0x16, 0xFE, 0xA5, 0x41, 0x0D, 0x73, 0x60, 0x3A, 0x07,
+			0x8E, 0x3A, 0x66, 0x0C, 0x64, 0xC6, 0x40, 0x66, 0x0C, 0x64, 0xC6, 0x40, 0x66, 0x0C, 0x64, 0xC6, 0x40, 0x66, 0x0C, 0x5C,
+			0x9F, 0x31, 0x70, 0xCB, 0x2D, 0x80, 0x23, 0xCD, 0xE7, 0x3F, 0xD2, 0xAC, 0x7E, 0x8D, 0x7A, 0xFB, 0x8B, 0xBB, 0x9F, 0x35,
+			0x27, 0x93, 0xA7, 0xDF, 0x95, 0x96, 0xF7, 0x09, 0xFE, 0x77, 0xB5, 0xC9, 0x7D, 0x5A, 0x1D, 0xC3, 0xE9, 0x98, 0xAD, 0xBE,
+			0xC3, 0xD8, 0x00, 0x47, 0xDC, 0x02, 0xE0, 0x16, 0x00, 0xB7, 0x00, 0xB8, 0x05, 0xC0, 0x2D, 0x00, 0x6E, 0x01, 0x70, 0x0B,
+			0x60, 0xC3, 0x2D, 0x00, 0xFB, 0xA0, 0xA7, 0xEF, 0x28, 0x27, 0x93, 0xA7, 0x3F, 0x94, 0xEF, 0x5F, 0x52, 0x5F, 0xD4, 0x00,
+			0x50, 0x03, 0x40, 0x0D, 0x00, 0x35, 0x00, 0xD4, 0x00, 0x50, 0x03, 0x40, 0x0D, 0xC0, 0x16, 0x1A, 0x80, 0xFB, 0x5C, 0x27,
+			0x93, 0xA7, 0x3F, 0x16, 0x7B, 0x5F, 0x63, 0xCE, 0x5E, 0xA7, 0xF9, 0x66, 0xFB, 0xF3, 0x8F, 0x7F, 0x79, 0x63, 0x52, 0x3A,
+			0x7D, 0x96, 0x97, 0xFB, 0x31, 0x1F, 0xFE, 0x9F, 0x85, 0x85, 0x85, 0x85, 0x85, 0x85, 0xE5, 0x61, 0xC5, 0xEE, 0xB9, 0x7F,
+			0x2D, 0x29, 0x7D, 0x9A, 0x67, 0x08, 0x00, 0x04, 0x00, 0x02, 0x00, 0x01, 0x80, 0x00, 0x40, 0x00, 0x20, 0x00, 0xEC, 0x11,
+			0x00, 0xAE, 0xC5, 0x86, 0x37, 0x01, 0xF3, 0x94, 0xBD, 0xF9, 0x7D, 0xCA, 0xF9, 0xFC, 0xE3, 0xA0, 0x96, 0xCC, 0x7E, 0x26,
+			0xA5, 0xF4, 0xF2, 0xB9, 0x19, 0x3D, 0x5E, 0xBB, 0x4F, 0x2F, 0x6D, 0xA4, 0xAD, 0x5A, 0xFE, 0xEA, 0xF3, 0x75, 0x9F, 0x51,
+			0xC7, 0xF2, 0x3A, 0xF6, 0x5C, 0xDF, 0xE5, 0x3A, 0x11, 0x00, 0x08, 0x00, 0x04, 0x00, 0x02, 0x00, 0x01, 0x80, 0x00, 0x40,
+			0x00, 0xD8, 0x1B, 0x00, 0x9C, 0x69, 0xA0, 0x1D, 0x27, 0x60, 0x94, 0x80, 0x36, 0x01, 0x4D, 0x02, 0xDC, 0x20, 0x9F, 0x7F,
+			0x5D, 0x53, 0x00, 0x4C, 0x28, 0x01, 0x7E, 0x15, 0x08, 0x2C, 0xDF, 0x97, 0xB6, 0x29, 0xF4, 0xCF, 0xD7, 0xD5, 0xEA, 0x58,
+			0x8D, 0x8E, 0x2D, 0xEF, 0x30, 0x4E, 0xCB, 0xEF, 0x46, 0x00, 0x20, 0x00, 0x10, 0x00, 0x08, 0x00, 0x04, 0x00, 0x02, 0x00,
+			0x01, 0x60, 0xA7, 0x00, 0x90, 0xCF, 0xD9, 0xCF, 0x22, 0x70, 0x93, 0x80, 0x99, 0xCE, 0xC2, 0x57, 0x06, 0xEA, 0xB0, 0x1C,
+			0xFC, 0x53, 0x48, 0xE9, 0xE5, 0xCF, 0xC3, 0x80, 0x5F, 0x6A, 0xB9, 0x9F, 0xDB, 0xF4, 0xCF, 0x2C, 0xF7, 0x35, 0xAC, 0x9D,
+			0xBE, 0xC3, 0xE2, 0x5D, 0x22, 0x3D, 0x00, 0xE8, 0x01, 0x40, 0x0F, 0x00, 0x7A, 0x00, 0xD0, 0x03, 0x80, 0x1E, 0x00, 0xF4,
+			0x00, 0x58, 0xEF, 0x01, 0xF0, 0x00, 0x11, 0xE0, 0x71, 0x02, 0xDE, 0x45, 0xC0, 0x49, 0x80, 0x5F, 0x11, 0x98, 0x4B, 0x4A,
+			0xDF, 0x18, 0xB2, 0x57, 0x7F, 0x9F, 0xD9, 0x2F, 0x8B, 0xFB, 0x92, 0x33, 0xE6, 0xB7, 0x3F, 0x4A, 0x6F, 0x1A, 0xF8, 0x9F,
+			0x6B, 0xD0, 0xF7, 0x00, 0xB4, 0xCA, 0xEF, 0x45, 0x1F, 0xDA, 0x67, 0xAC, 0xC6, 0x80, 0x8E, 0x2D, 0x35, 0x48, 0x56, 0xC0,
+			0xC4, 0x53, 0x00, 0x3C, 0x05, 0xC0, 0x53, 0x00, 0x3C, 0x05, 0xC0, 0x53, 0x00, 0x3C, 0x05, 0xC0, 0x53, 0x00, 0xFB, 0x3D,
+			0x05, 0x10, 0x01, 0x1F, 0x81, 0xB6, 0x0A, 0xC6, 0x12, 0x90, 0x43, 0xC8, 0xB6, 0xBC, 0x92, 0xC7, 0xDF, 0xFA, 0x0F, 0x53,
+			0xFA, 0xCA, 0x0C, 0x5D, 0x02, 0xB6, 0x89, 0x29, 0xBD, 0xFC, 0x09, 0x30, 0xFA, 0x3F, 0x11, 0x09, 0x9A, 0xAE, 0x9F, 0xF9,
+			0x17, 0x00, 0xA8, 0xEB, 0x02, 0x04, 0x82, 0x8E, 0x51, 0x8D, 0x99, 0xF4, 0x5D, 0x40, 0x03, 0x20, 0x1A, 0x00, 0xD1, 0x00,
+			0x88, 0x06, 0x40, 0x34, 0x00, 0xA2, 0x01, 0x10, 0x0D, 0x80, 0xD6, 0x18, 0x00, 0x95, 0x62, 0x96, 0x7E, 0xED, 0x38, 0x1D,
+			0x70, 0x06, 0x0C, 0xDB, 0x2A, 0x68, 0x34, 0x32, 0xF3, 0x07, 0x4C, 0x9D, 0xCC, 0xA7, 0x2E, 0x25, 0xA0, 0x0F, 0x20, 0x20,
+			0x76, 0x19, 0x02, 0x98, 0x0E, 0x98, 0xE9, 0x80, 0x99, 0x0E, 0x98, 0xE9, 0x80, 0x99, 0x0E, 0x98, 0xE9, 0x80, 0x99, 0x0E,
+			0x78, 0x57, 0xE9, 0x80, 0xB7, 0x5D, 0x01, 0x00, 0xF0, 0x56, 0x96, 0xDD, 0x03, 0x30, 0x95, 0x2C, 0x80, 0x01, 0x18, 0x59,
+			0xC0, 0x97, 0xCC, 0x7C, 0xD5, 0xCC, 0x3F, 0xEA, 0xCC, 0x5F, 0xAA, 0x95, 0x1F, 0xE1, 0x6E, 0x00, 0x30, 0xA2, 0xFA, 0xFF,
+			0xC8, 0x2A, 0x80, 0x0B, 0x80, 0x0F, 0x40, 0x13, 0x80, 0xB9, 0xAE, 0x06, 0x1C, 0x06, 0xE0, 0x5F, 0xCE, 0xFE, 0x39, 0xFB,
+			0xE7, 0xEC, 0x9F, 0xB3, 0x7F, 0xCE, 0xFE, 0x39, 0xFB, 0xE7, 0xEC, 0x7F, 0xC3, 0xD9, 0xFF, 0x96, 0xA7, 0x00, 0x74, 0xE9,
+			0xC0, 0x9C, 0x07, 0xE0, 0x2A, 0x00, 0x63, 0xD9, 0x9B, 0xD7, 0x00, 0x9D, 0xF4, 0x33, 0x0C, 0xF6, 0xF2, 0x65, 0xF6, 0x6E,
+			0x75, 0xC6, 0x2E, 0x33, 0xFA, 0xA8, 0xDF, 0xEB, 0x5A, 0xDF, 0xBF, 0x6D, 0xAF, 0xCF, 0x27, 0xED, 0xB3, 0x1E, 0x23, 0xFA,
+			0x3C, 0xF6, 0x55, 0xC8, 0xEF, 0x02, 0x70, 0x05, 0x80, 0x2B, 0x00, 0x5C, 0x01, 0xE0, 0x0A, 0x00, 0x57, 0x00, 0xB8, 0x02,
+			0xC0, 0x15, 0x80, 0xBD, 0xAF, 0x00, 0xC8, 0xDF, 0x71, 0x00, 0xAE, 0x2D, 0x70, 0x64, 0x25, 0x1B, 0x5F, 0xCE, 0x12, 0x28,
+			0x9B, 0xFD, 0xAE, 0x4C, 0xF4, 0x45, 0x98, 0x27, 0x67, 0xF8, 0x45, 0xC4, 0x27, 0x33, 0xFB, 0xDB, 0x7F, 0xF4, 0x2B, 0x04,
+			0xB7, 0x45, 0x4F, 0x13, 0xC4, 0x22, 0xF8, 0xD3, 0x3D, 0xFF, 0x02, 0x14, 0x4E, 0x81, 0xA0, 0x0D, 0xC0, 0x2C, 0x00, 0x8F,
+			0x64, 0xEC, 0x90, 0xDF, 0x81, 0x85, 0x85, 0x85, 0x85, 0x85, 0x85, 0xE5, 0x3E, 0xC5, 0xAC, 0xBE, 0xBD, 0xF9, 0x95, 0xD2,
+			0xA9, 0xEE, 0xEF, 0x4F, 0x25, 0x43, 0x60, 0xB5, 0xDF, 0x5F, 0x6A, 0x70, 0x80, 0x73, 0x1A, 0xFC, 0xCB, 0xD2, 0xBF, 0x88,
+			0x04, 0x07, 0x63, 0xC7, 0xF4, 0xE1, 0x49, 0x82, 0x45, 0xE0, 0x0F, 0xCB, 0xDB, 0x00, 0x02, 0x01, 0xD3, 0x0E, 0x18, 0x2F,
+			0x80, 0xC0, 0x98, 0x33, 0x8F, 0xE5, 0x8E, 0x08, 0x00, 0x04, 0x00, 0x02, 0x00, 0x01, 0x80, 0x00, 0x40, 0x00, 0x20, 0x00,
+			0xEC, 0x1B, 0x00, 0x7A, 0x41, 0xE0, 0x61, 0x03, 0xB4, 0x4D, 0x0F, 0x01, 0x9D, 0x03, 0x4C, 0x03, 0x34, 0xAE, 0x87, 0x80,
+			0x12, 0xFC, 0xA3, 0x66, 0xF5, 0xAB, 0xAF, 0x38, 0x30, 0x12, 0x2A, 0xC1, 0xDF, 0xEB, 0x76, 0x42, 0xAB, 0x20, 0x20, 0xC1,
+			0xBF, 0xF3, 0xC0, 0x8D, 0xA7, 0xF0, 0x8F, 0xC2, 0x3F, 0x0A, 0xFF, 0x28, 0xFC, 0xA3, 0xF0, 0x8F, 0xC2, 0x3F, 0x0A, 0xFF,
+			0x36, 0x17, 0xFE, 0xED, 0x01, 0x00, 0x04, 0x24, 0x5E, 0x34, 0x3D, 0x04, 0xD8, 0x06, 0x38, 0x90, 0xE5, 0xFE, 0x06, 0xF0,
+			0x2E, 0x43, 0x40, 0x09, 0xFE, 0x52, 0x83, 0xA6, 0xF4, 0xAD, 0xAF, 0xA0, 0x0E, 0x7F, 0x05, 0x00, 0x10, 0x7B, 0xC1, 0x9F,
+			0x04, 0xFE, 0x99, 0x6A, 0x0D, 0x72, 0xF0, 0x07, 0x5E, 0x79, 0x1A, 0xFF, 0xD0, 0xF8, 0x87, 0xC6, 0x3F, 0x34, 0xFE, 0xA1,
+			0xF1, 0x0F, 0x8D, 0x7F, 0xFE, 0x97, 0xC6, 0x3F, 0xFF, 0xB1, 0x77, 0xF7, 0x28, 0x6E, 0x03, 0x61, 0x18, 0x80, 0xBF, 0x91,
+			0xC3, 0x66, 0x83, 0xAB, 0xA4, 0xF3, 0xC1, 0x72, 0x1E, 0xE3, 0x6B, 0xE4, 0x82, 0xEE, 0x9C, 0x2A, 0x38, 0x96, 0x2C, 0x2B,
+			0x0C, 0xFA, 0x04, 0x8A, 0x48, 0x0A, 0xFF, 0xB0, 0xC6, 0xF0, 0xCC, 0xA0, 0x66, 0x59, 0xAC, 0xF2, 0x7D, 0x46, 0xA3, 0x57,
+			0xF3, 0x2A, 0x00, 0xF8, 0x1B, 0x01, 0x9B, 0x0C, 0xFE, 0xDF, 0xAB, 0x11, 0x02, 0x6F, 0x8B, 0xF0, 0xAF, 0x00, 0x38, 0xE7,
+			0x79, 0xFE, 0xF3, 0x79, 0xCE, 0xCF, 0xFB, 0xCE, 0x01, 0x50, 0xAF, 0x36, 0x83, 0xFF, 0x3D, 0x21, 0xB0, 0xEF, 0x85, 0xBF,
+			0xF0, 0x17, 0xFE, 0xC2, 0x5F, 0xF8, 0x0B, 0x7F, 0xE1, 0x7F, 0x7B, 0xF8, 0xDF, 0xD5, 0x02, 0x58, 0xCE, 0x1A, 0xC8, 0xF9,
+			0x48, 0xFE, 0x1C, 0x71, 0xE8, 0x22, 0xDE, 0x6B, 0x6F, 0x3F, 0xDF, 0xF0, 0x6F, 0xBB, 0xF1, 0xEA, 0xF2, 0x2D, 0xFF, 0x61,
+			0xD1, 0x00, 0x98, 0xFF, 0xAD, 0xCB, 0xFF, 0x6D, 0xF3, 0x7F, 0xFB, 0x76, 0xFC, 0xAD, 0x43, 0xEE, 0xF9, 0xFF, 0xE8, 0x84,
+			0xBF, 0xF0, 0x17, 0xFE, 0xC2, 0x5F, 0xF8, 0x0B, 0x7F, 0xE1, 0x7F, 0x7B, 0xF8, 0x3F, 0xF4, 0x09, 0xC0, 0x7C, 0x0E, 0xC3,
+			0xB6, 0x89, 0xD8, 0xAF, 0xC6, 0xA7, 0x01, 0xBF, 0xEA, 0x93, 0x80, 0x26, 0xA2, 0xE4, 0xF5, 0xB9, 0x44, 0xB4, 0x25, 0xE2,
+			0x6D, 0x71, 0xEF, 0x36, 0x0F, 0xF6, 0x39, 0xE5, 0xD7, 0x02, 0x87, 0x6C, 0x04, 0xAC, 0xB3, 0x6E, 0xB8, 0xE9, 0x4B, 0xD9,
+			0xE9, 0xFB, 0xEB, 0xFB, 0xEB, 0xFB, 0xEB, 0xFB, 0xEB, 0xFB, 0xEB, 0xFB, 0xEB, 0xFB, 0x5F, 0xD1, 0xF7, 0xFF, 0x50, 0x00,
+			0x4C, 0x73, 0x18, 0xBE, 0xAF, 0x22, 0xBE, 0x66, 0x3D, 0xF0, 0x53, 0xFD, 0x60, 0x50, 0x13, 0xB1, 0x2E, 0x11, 0xC7, 0x12,
+			0xF1, 0x65, 0x71, 0xEF, 0x63, 0x9E, 0xEA, 0x57, 0x0F, 0xF6, 0xE9, 0xF3, 0xF3, 0xBE, 0xF5, 0xD0, 0xA1, 0x9F, 0x17, 0x3D,
+			0x7F, 0x3D, 0x7F, 0x3D, 0x7F, 0x3D, 0x7F, 0x3D, 0x7F, 0x3D, 0x7F, 0x3D, 0xFF, 0xEB, 0x7A, 0xFE, 0x4F, 0x05, 0xC0, 0x34,
+			0xC7, 0x27, 0x02, 0x75, 0xEF, 0x7F, 0x5F, 0xF2, 0x5B, 0x01, 0x11, 0xF1, 0x6D, 0x71, 0xEF, 0xE9, 0x3C, 0xFF, 0xD3, 0x74,
+			0xB0, 0xCF, 0xC5, 0x8A, 0xDF, 0x8A, 0xDF, 0x8A, 0xDF, 0x8A, 0xDF, 0x8A, 0xDF, 0x8A, 0xDF, 0x8A, 0xFF, 0x31, 0x2B, 0xFE,
+			0xA7, 0x00, 0x60, 0x3E, 0xC7, 0x97, 0x05, 0xB7, 0xFF, 0xB9, 0xEF, 0x6E, 0xB0, 0xBF, 0x6F, 0x7F, 0xDF, 0xFE, 0xBE, 0xFD,
+			0x7D, 0xFB, 0xFB, 0xF6, 0xF7, 0xED, 0xEF, 0xDF, 0xB7, 0xBF, 0x6F, 0x18, 0x86, 0x61, 0x18, 0x86, 0xF1, 0xCF, 0xD1, 0x3C,
+			0xF8, 0xF7, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xE0, 0x85, 0x01,
+			0xF0, 0x87, 0xBD, 0x33, 0x54, 0x6E, 0x1B, 0x08, 0xC2, 0xB0, 0xA5, 0x29, 0x2F, 0x6E, 0x40, 0x48, 0x49, 0x48, 0x49, 0xB1,
+			0x51, 0x81, 0x91, 0x81, 0x71, 0x48, 0x0B, 0x8A, 0x02, 0x8A, 0x0C, 0xFC, 0x08, 0x06, 0x41, 0x05, 0x41, 0x05, 0x2D, 0x09,
+			0x36, 0x08, 0x32, 0x28, 0x32, 0x2E, 0x29, 0x09, 0x29, 0x09, 0x68, 0x71, 0x9F, 0x20, 0x1D, 0x6B, 0x66, 0x33, 0xB1, 0x6B,
+			0x5B, 0x96, 0x7C, 0xB7, 0x5A, 0xED, 0x7E, 0xFF, 0x4E, 0xA7, 0x17, 0xC5, 0x92, 0xEF, 0xDB, 0x93, 0xE4, 0xDF, 0x3B, 0xA7,
+			0x1C, 0x06, 0x00, 0x03, 0x80, 0x01, 0xC0, 0x00, 0x60, 0x00, 0x30, 0x00, 0x18, 0x00, 0x0C, 0x00, 0x06, 0x00, 0x03, 0x80,
+			0x01, 0xC0, 0x00, 0x60, 0x00, 0x30, 0x00, 0x18, 0x00, 0x0C, 0x00, 0x06, 0x00, 0x03, 0x80, 0x01, 0xC0, 0x00, 0x60, 0x00,
+			0x30, 0x00, 0x18, 0x00, 0x0C, 0x00, 0x06, 0x00, 0x03, 0x80, 0x01, 0xC0, 0x00, 0x60, 0x00, 0x30, 0x00, 0x18, 0x00, 0x0C,
+			0x40, 0x2A, 0x03, 0xF0, 0x42, 0x1A, 0x5A, 0x7A, 0x3B, 0x7C, 0xF3, 0x28, 0xED, 0x5D, 0xFA, 0xB1, 0xFA, 0x59, 0x48, 0xDB,
+			0xA3, 0xE0, 0x87, 0x1F, 0x7E, 0xF8, 0xE1, 0x87, 0xDF, 0x02, 0x7F, 0x61, 0x05, 0xBC, 0xEB, 0x44, 0xC0, 0x0F, 0x3F, 0xFC,
+			0xF0, 0xC3, 0x0F, 0x7F, 0x24, 0xFE, 0xD2, 0x22, 0x7C, 0xDB, 0x7D, 0xAC, 0x0A, 0x7E, 0xF8, 0xE1, 0x87, 0x1F, 0x7E, 0xF8,
+			0xAD, 0xF1, 0x97, 0x16, 0xE1, 0x53, 0xEC, 0x6B, 0x45, 0xF0, 0xC3, 0x0F, 0x3F, 0xFC, 0xF0, 0xC3, 0x6F, 0x91, 0x5F, 0xA5,
+			0x02, 0xD0, 0x55, 0x02, 0x3D, 0x08, 0x7E, 0xF8, 0xE1, 0x87, 0x1F, 0x7E, 0xF8, 0x73, 0xF0, 0x9B, 0x37, 0x00, 0x04, 0x41,
+			0x10, 0x04, 0x41, 0xA4, 0x0F, 0x0C, 0x00, 0x06, 0x00, 0x03, 0x80, 0x01, 0xC0, 0x00, 0x60, 0x00, 0x30, 0x00, 0x01, 0x0D,
+			0x80, 0xDA, 0x4C, 0xCB, 0xDF, 0x77, 0xD7, 0xD5, 0x5C, 0x80, 0xF1, 0xFC, 0x9B, 0x6C, 0xDA, 0xA9, 0xBB, 0xD9, 0xFB, 0xEA,
+			0xFF, 0xB3, 0xF1, 0x54, 0xAD, 0x6F, 0xF0, 0xC3, 0xAF, 0xCD, 0x7F, 0x31, 0xF8, 0x2B, 0xBF, 0xAA, 0xE2, 0x7E, 0xF0, 0x12,
+			0x7E, 0xF8, 0xC3, 0xF0, 0xEF, 0x0B, 0xF8, 0x75, 0xF9, 0xD5, 0xFF, 0x0E, 0x80, 0x00, 0x46, 0x15, 0xFC, 0xB1, 0xF9, 0xB7,
+			0x6F, 0xFC, 0x75, 0xDB, 0xE1, 0x87, 0xDF, 0x13, 0x3F, 0xF7, 0x3F, 0x5B, 0xF7, 0xBF, 0x52, 0xD3, 0xFD, 0xE4, 0xDE, 0xC7,
+			0xAA, 0xE0, 0x87, 0x7F, 0xCD, 0x3F, 0x9D, 0x7F, 0x96, 0x4D, 0x7B, 0x25, 0xAF, 0x81, 0x1F, 0x7E, 0x6F, 0xFC, 0xB9, 0xF7,
+			0x81, 0xBF, 0x39, 0x3F, 0x73, 0x00, 0x98, 0x03, 0xC0, 0x1C, 0x00, 0xE6, 0x00, 0x30, 0x07, 0x80, 0x39, 0x00, 0xCC, 0x01,
+			0x08, 0x38, 0x07, 0xA0, 0x13, 0x03, 0xF0, 0xEE, 0x6A, 0x5E, 0xFD, 0xAB, 0xDB, 0xE6, 0x49, 0x11, 0x99, 0x8F, 0xC9, 0x41,
+			0x14, 0x1D, 0xF3, 0xED, 0xAF, 0xCD, 0x6B, 0xE1, 0x87, 0xBF, 0x0F, 0xFC, 0xDC, 0x27, 0xFE, 0xBF, 0x4F, 0xEC, 0xE2, 0xD4,
+			0x66, 0x67, 0x31, 0x20, 0x16, 0x03, 0x62, 0x31, 0x20, 0x16, 0x03, 0x62, 0x31, 0x20, 0x16, 0x03, 0x62, 0x31, 0x20, 0x16,
+			0x03, 0xCA, 0xBF, 0x18, 0xD0, 0x3A, 0xBE, 0xDF, 0xCC, 0xA4, 0x79, 0x70, 0x9B, 0xA7, 0x88, 0xC8, 0x1C, 0x9D, 0x17, 0x21,
+			0xD4, 0x5C, 0x72, 0x9F, 0x38, 0x1B, 0x4F, 0x65, 0x93, 0x6B, 0xCE, 0xBA, 0x6D, 0x54, 0x00, 0xA8, 0x00, 0x50, 0x01, 0xA0,
+			0x02, 0x40, 0x05, 0x80, 0x0A, 0x00, 0x15, 0x00, 0x2A, 0x00, 0x49, 0x2B, 0x00, 0x2A, 0x06, 0xA0, 0xCD, 0x33, 0x8D, 0x5A,
+			0xCF, 0x41, 0xC2, 0x0F, 0xBF, 0x06, 0xFF, 0xF5, 0xEC, 0x93, 0xFC, 0x58, 0xAB, 0xF5, 0x6B, 0xE1, 0x87, 0xDF, 0x13, 0xBF,
+			0xB4, 0x73, 0xEE, 0x03, 0x7F, 0x73, 0x7E, 0x2A, 0x00, 0x54, 0x00, 0xA8, 0x00, 0x50, 0x01, 0xA0, 0x02, 0x40, 0x05, 0x80,
+			0x0A, 0x40, 0xC0, 0x0A, 0x40, 0xA1, 0xF8, 0x5E, 0x1B, 0xCF, 0x36, 0x2E, 0xBE, 0x2C, 0xA5, 0xF9, 0xA4, 0xC9, 0xC7, 0x91,
+			0xAA, 0xFB, 0xD1, 0x8E, 0x9B, 0xC9, 0xE8, 0x71, 0x1F, 0x73, 0x17, 0xEE, 0x4F, 0x5B, 0xC7, 0x3E, 0xDB, 0xEA, 0x99, 0xBF,
+			0x6E, 0x86, 0xB7, 0xB7, 0x6F, 0x7F, 0xF0, 0xC3, 0xFF, 0x9C, 0x5F, 0xDA, 0x87, 0x14, 0x89, 0x7F, 0xD7, 0xE7, 0xE0, 0xD5,
+			0x62, 0xA9, 0xC6, 0x5F, 0x58, 0x48, 0x42, 0x84, 0xC1, 0x87, 0x1F, 0xFE, 0xE7, 0xFC, 0xDB, 0x1F, 0x04, 0x52, 0x22, 0x86,
+			0x1F, 0xFE, 0x08, 0xFC, 0xFB, 0x04, 0xBF, 0x2E, 0xBF, 0xDA, 0x9B, 0x8D, 0x2E, 0x87, 0x1B, 0xE0, 0x5F, 0x2F, 0x27, 0xD2,
+			0xAC, 0xE2, 0xC3, 0xED, 0x42, 0x9A, 0x55, 0x2C, 0x6F, 0x57, 0xAA, 0x89, 0x80, 0x3F, 0x2F, 0xFF, 0x76, 0x0E, 0x0E, 0xF1,
+			0x7B, 0x67, 0x8F, 0x38, 0xFE, 0xF0, 0xC7, 0xE6, 0xDF, 0xCE, 0xC1, 0x21, 0x7E, 0xEF, 0xEC, 0x96, 0xC6, 0xBF, 0xD0, 0x06,
+			0x6F, 0x1A, 0x1E, 0x4E, 0x86, 0xB6, 0x39, 0xF0, 0xC0, 0xDE, 0x36, 0x07, 0x91, 0xD9, 0x3D, 0xE5, 0xA0, 0x2D, 0x7B, 0xF0,
+			0x1C, 0x54, 0x39, 0xF0, 0xC0, 0xDE, 0x36, 0x07, 0x91, 0xD9, 0x35, 0x73, 0x50, 0x58, 0x04, 0xEF, 0x22, 0x11, 0xF0, 0xA7,
+			0xE7, 0x3F, 0x35, 0x0F, 0x51, 0xB9, 0xBD, 0xE4, 0x01, 0xFE, 0xD8, 0xFC, 0xA7, 0xE6, 0x21, 0x2A, 0xB7, 0x66, 0x1E, 0x4A,
+			0xEB, 0xF0, 0xA9, 0x8F, 0x85, 0x74, 0x75, 0xCA, 0xD8, 0x31, 0xEE, 0x31, 0xC7, 0x3D, 0xE7, 0xB1, 0x38, 0x0F, 0xFA, 0x73,
+			0x1E, 0x30, 0xEE, 0xF9, 0xC7, 0xBD, 0xB4, 0x36, 0xE8, 0x9A, 0xC7, 0xCC, 0xA9, 0x94, 0xFD, 0xED, 0x1B, 0x7B, 0x70, 0x55,
+			0x8A, 0x3C, 0xFE, 0x39, 0xFA, 0x1B, 0x39, 0x07, 0x7D, 0x63, 0x0F, 0xAE, 0x4A, 0x7D, 0x19, 0xFF, 0xD2, 0x32, 0xB8, 0xE6,
+			0xB1, 0xAD, 0xF7, 0xB3, 0x2F, 0xEC, 0xA9, 0xFB, 0x1C, 0x95, 0x5B, 0xE3, 0x98, 0x7D, 0x61, 0xD7, 0x38, 0xB6, 0xF5, 0x7E,
+			0xF6, 0x85, 0x3D, 0x75, 0x9F, 0xA3, 0x72, 0x6B, 0x1C, 0x33, 0x5B, 0x05, 0x80, 0x20, 0x08, 0x82, 0x20, 0x08, 0xDB, 0x51,
+			0xF4, 0xC5, 0xA9, 0x68, 0x4C, 0x88, 0x48, 0xA1, 0xF3, 0xF3, 0xD7, 0x1B, 0xFC, 0x17, 0xC3, 0x57, 0xD2, 0x74, 0xCD, 0x1F,
+			0x95, 0x9B, 0x3C, 0x6C, 0xE6, 0x21, 0xFA, 0xF5, 0x9F, 0x92, 0xFF, 0x7E, 0xF5, 0x67, 0xB0, 0x7D, 0x2E, 0x59, 0xE7, 0xCF,
+			0x91, 0x87, 0x5D, 0xB9, 0x78, 0x78, 0xF8, 0x55, 0x78, 0xE7, 0xD6, 0x18, 0xFF, 0xD2, 0x2A, 0x7C, 0x97, 0xEF, 0x81, 0x10,
+			0x6A, 0x2E, 0xAE, 0x7F, 0xAE, 0x7F, 0xAE, 0xFF, 0xA7, 0xEB, 0xFF, 0x1F, 0x7B, 0x77, 0x90, 0x9B, 0x38, 0x0C, 0x85, 0x01,
+			0x58, 0xA0, 0x9E, 0xA3, 0xEB, 0x1E, 0xA2, 0xC7, 0xE7, 0x10, 0xAC, 0x39, 0x49, 0x25, 0x90, 0x25, 0x04, 0x4D, 0x70, 0x4C,
+			0x6C, 0xFC, 0xEC, 0xCF, 0xDE, 0x64, 0x31, 0x62, 0xF8, 0xFD, 0xE2, 0xCC, 0x27, 0x3F, 0x4D, 0x08, 0x71, 0xA1, 0x05, 0xA0,
+			0x05, 0xA0, 0x05, 0xA0, 0x05, 0xA0, 0x05, 0xA0, 0x05, 0xA0, 0x05, 0x30, 0x61, 0x0B, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0xE0, 0x09, 0x00, 0x2D, 0xFE, 0x8B, 0x4A, 0x8B, 0xBF, 0xC3, 0x30, 0x8C, 0xED, 0xC3, 0xFE, 0xB7, 0xFF, 0xED,
+			0xFF, 0x58, 0xFB, 0xFF, 0xE8, 0x01, 0xEB, 0x01, 0x7B, 0xFF, 0x80, 0xED, 0xF1, 0xBB, 0x46, 0xCA, 0x1D, 0x65, 0x4D, 0x8D,
+			0x3A, 0xA3, 0x66, 0x8D, 0x22, 0xD5, 0x7F, 0xCF, 0xEF, 0x3A, 0x6B, 0xEE, 0x16, 0x9F, 0x0D, 0x00, 0x81, 0x00, 0x60, 0x9A,
+			0xA6, 0x69, 0x9A, 0x7B, 0xCD, 0x43, 0xAB, 0x97, 0x75, 0x5C, 0x4E, 0xE7, 0x74, 0x99, 0x35, 0xBE, 0x7F, 0x7F, 0xD2, 0x65,
+			0x55, 0x01, 0xB5, 0x7A, 0x13, 0xDC, 0xBB, 0xF9, 0x7B, 0xCF, 0xFE, 0x98, 0x3B, 0xBD, 0xA9, 0x2B, 0xDD, 0x0B, 0xA5, 0xF9,
+			0x23, 0xD4, 0x7C, 0x6D, 0x1D, 0x66, 0xA9, 0xBF, 0xFD, 0x7F, 0xDB, 0xFF, 0x4B, 0x6B, 0x50, 0x92, 0x3F, 0xCA, 0xDB, 0xEF,
+			0x72, 0xD6, 0xA1, 0xB4, 0xFE, 0xA9, 0xE6, 0x4B, 0xCF, 0x97, 0xDE, 0x73, 0xF7, 0x5E, 0xFF, 0x2A, 0x27, 0x00, 0x35, 0x36,
+			0x6A, 0x8D, 0xCF, 0x8C, 0x32, 0x66, 0xCE, 0x3E, 0xF9, 0xB8, 0x8E, 0x68, 0xF5, 0xAF, 0xF1, 0x7D, 0x27, 0x5F, 0x83, 0xEB,
+			0x1A, 0x18, 0x71, 0x46, 0x94, 0xFA, 0x1F, 0x23, 0x2C, 0x40, 0x94, 0xC5, 0x34, 0x9E, 0xC7, 0x3B, 0xB5, 0x53, 0xF7, 0x39,
+			0xEB, 0x5E, 0xF3, 0xB3, 0xDC, 0x07, 0x71, 0xEE, 0x03, 0x75, 0xAF, 0x5F, 0xF7, 0x43, 0xEB, 0xA3, 0x90, 0xD1, 0x6F, 0x82,
+			0xFF, 0x8E, 0xAA, 0x4A, 0xD7, 0x20, 0x52, 0xF6, 0x57, 0x47, 0x74, 0x5B, 0xD7, 0x20, 0x5A, 0xDD, 0xD7, 0xD6, 0x61, 0x86,
+			0xFA, 0x2F, 0x8D, 0xD2, 0xEC, 0x93, 0xAF, 0xC1, 0x75, 0x0D, 0x46, 0x68, 0x01, 0x94, 0xAE, 0xC1, 0x63, 0xDD, 0x5F, 0x3D,
+			0x5F, 0x46, 0xCA, 0xDE, 0xB2, 0xFE, 0x87, 0xDE, 0x42, 0xE7, 0xDC, 0x0C, 0x91, 0xFE, 0x01, 0x48, 0x3D, 0xE0, 0xD1, 0xB3,
+			0xAF, 0x6D, 0xD0, 0xD2, 0x7B, 0x21, 0x52, 0xDD, 0x97, 0xD6, 0x61, 0x96, 0xFA, 0xDB, 0xFF, 0xB7, 0xFD, 0xBF, 0xE7, 0x1A,
+			0x8C, 0x02, 0x80, 0xD2, 0x75, 0xB8, 0xAF, 0xFB, 0xDA, 0xF3, 0x65, 0xB4, 0xDC, 0x2D, 0xEB, 0x5F, 0xAD, 0x05, 0x60, 0x9A,
+			0xA6, 0x69, 0x9A, 0x66, 0xBF, 0xF3, 0x2B, 0x5D, 0x7C, 0x62, 0x24, 0xE1, 0xBD, 0x2B, 0xA5, 0x9E, 0xC6, 0xBD, 0xD2, 0x5E,
+			0xE5, 0x1A, 0x29, 0x7F, 0x89, 0x4E, 0xD5, 0x7F, 0x9C, 0xFA, 0x97, 0x0C, 0xF9, 0xD7, 0xF3, 0xD7, 0xF8, 0xFD, 0xF7, 0x48,
+			0xF9, 0x97, 0xF6, 0xD5, 0x2C, 0xF9, 0x5B, 0xD4, 0xDF, 0x09, 0x80, 0x13, 0x00, 0x27, 0x00, 0x4E, 0x00, 0x9C, 0x00, 0x38,
+			0x01, 0x70, 0x02, 0x30, 0xE1, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xEC, 0x0B, 0x80, 0x6A, 0xBF, 0x06, 0x78, 0x39, 0x9D, 0xD3, 0xE5,
+			0xAE, 0x7F, 0x36, 0xD2, 0xCC, 0xCD, 0x35, 0x6A, 0xFE, 0xDC, 0x7C, 0xA3, 0xE6, 0xCF, 0xCD, 0x35, 0x62, 0xFE, 0x2D, 0x99,
+			0x46, 0xCC, 0xBF, 0x25, 0xD7, 0xA8, 0xF9, 0x73, 0xF3, 0x8D, 0x9A, 0x3F, 0x37, 0xD7, 0x27, 0xF3, 0x6B, 0x01, 0x68, 0x01,
+			0x68, 0x01, 0x68, 0x01, 0x68, 0x01, 0x68, 0x01, 0x68, 0x01, 0x68, 0x01, 0x68, 0x01, 0x68, 0x01, 0x68, 0x01, 0x68, 0x01,
+			0x68, 0x01, 0x68, 0x01, 0x68, 0x01, 0xCC, 0xD0, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80,
+			0x4D, 0x00, 0x18, 0xE3, 0xE2, 0x6F, 0x00, 0x3F, 0xDB, 0x71, 0xF9, 0x38, 0xD2, 0x74, 0x5D, 0x00, 0x00, 0x00, 0x00, 0x49,
+			0x45, 0x4E, 0x44, 0xAE, 0x42, 0x60, 0x82,
+		},
+	}
+)
@@ -0,0 +1,56 @@
+package wolfenstein
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSpriteFrameFrom(t *testing.T) {
+	guard := Sprite{X: 64, Y: 64, Angle: 0, Kind: spriteKinds["guard"]}
+
+	tests := []struct {
+		name  string
+		x, y  float64
+		frame int
+	}{
+		{"front", 128, 64, 16},
+		{"almost front", 128, 70, 16},
+		{"diagonal", 128, 128, 17},
+		{"side", 64, 128, 18},
+		{"back", 0, 64, 20},
+		{"other side", 64, 0, 22},
+		{"last", 128, 10, 23},
+	}
+
+	for _, test := range tests {
+		if frame := guard.FrameFrom(test.x, test.y); frame != test.frame {
+			t.Errorf("%s: got frame %d, expected %d", test.name, frame, test.frame)
+		}
+	}
+
+	barrel := Sprite{X: 64, Y: 64, Kind: spriteKinds["barrel"]}
+	if frame := barrel.FrameFrom(0, 64); frame != 0 {
+		t.Errorf("barrel seen from behind: got frame %d, expected 0", frame)
+	}
+}
+
+func TestSpritesOfEntities(t *testing.T) {
+	sprites := spritesOf([]Entity{
+		{Type: "guard", X: 1.5, Y: 2.5, Angle: 90},
+		{Type: "key", X: 2.5, Y: 2.5},
+		{Type: "barrel", X: 3.5, Y: 1.5},
+	}, 64)
+
+	if len(sprites) != 2 {
+		t.Fatalf("got %d sprites, expected 2 as keys have none", len(sprites))
+	}
+
+	guard := sprites[0]
+	if guard.X != 96 || guard.Y != 160 || math.Abs(guard.Angle-math.Pi/2) > 1e-9 || guard.Kind != spriteKinds["guard"] {
+		t.Errorf("unexpected guard sprite %+v", guard)
+	}
+
+	if sprites[1].Kind != spriteKinds["barrel"] {
+		t.Errorf("unexpected barrel sprite %+v", sprites[1])
+	}
+}
@@ -0,0 +1,90 @@
+package wolfenstein
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+)
+
+// textureSize is the width and height of the embedded wall textures.
+const textureSize = 64
+
+// TextureAtlas is a PNG holding square wall textures side by side, read left
+// to right then top to bottom.
+type TextureAtlas struct {
+	image    *image.RGBA
+	tileSize int
+	columns  int
+	count    int
+}
+
+// embeddedTextureCount returns the number of textures of the embedded atlas,
+// reading only the PNG header.
+func embeddedTextureCount() int {
+	config, err := png.DecodeConfig(bytes.NewReader(TextureData["textures.png"]))
+	if err != nil {
+		return 0
+	}
+
+	return (config.Width / textureSize) * (config.Height / textureSize)
+}
+
+// NewTextureAtlas decodes a PNG atlas made of tileSize x tileSize textures.
+func NewTextureAtlas(data []byte, tileSize int) (*TextureAtlas, error) {
+	if tileSize <= 0 {
+		return nil, fmt.Errorf("texture atlas: invalid tile size %d", tileSize)
+	}
+
+	src, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("texture atlas: %w", err)
+	}
+
+	bounds := src.Bounds()
+	if bounds.Dx()%tileSize != 0 || bounds.Dy()%tileSize != 0 {
+		return nil, fmt.Errorf(
+			"texture atlas: size %dx%d is not a multiple of tile size %d",
+			bounds.Dx(), bounds.Dy(), tileSize,
+		)
+	}
+
+	// work on RGBA so texels can be read straight from Pix
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+
+	columns := bounds.Dx() / tileSize
+
+	return &TextureAtlas{
+		image:    rgba,
+		tileSize: tileSize,
+		columns:  columns,
+		count:    columns * (bounds.Dy() / tileSize),
+	}, nil
+}
+
+// Count returns the number of textures in the atlas.
+func (ta *TextureAtlas) Count() int {
+	return ta.count
+}
+
+func (ta *TextureAtlas) TileSize() int {
+	return ta.tileSize
+}
+
+// Texel returns the color at (x, y) of the given texture. Coordinates wrap
+// around the texture size.
+func (ta *TextureAtlas) Texel(tile, x, y int) color.RGBA {
+	x = (x%ta.tileSize + ta.tileSize) % ta.tileSize
+	y = (y%ta.tileSize + ta.tileSize) % ta.tileSize
+
+	px := (tile%ta.columns)*ta.tileSize + x
+	py := (tile/ta.columns)*ta.tileSize + y
+
+	i := ta.image.PixOffset(px, py)
+	pix := ta.image.Pix[i : i+4 : i+4]
+
+	return color.RGBA{pix[0], pix[1], pix[2], pix[3]}
+}
@@ -0,0 +1,2150 @@
+package wolfenstein
+
+type fs map[string][]byte
+
+var (
+	// TextureData contains the binarized texture folder
+	TextureData = fs{
+		"textures.png": []byte{
+			0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A, 0x00, 0x00, 0x00, 0x0D, 0x49, 0x48, 0x44, 0x52, 0x00, 0x00, 0x02, 0x00,
+			0x00, 0x00, 0x00, 0x40, 0x08, 0x02, 0x00, 0x00, 0x00, 0xE3, 0xDD, 0x58, 0xE8, 0x00, 0x00, 0x80, 0x00, 0x49, 0x44, 0x41,
+			0x54, 0x78, 0x9C, 0x5C, 0xBD, 0xE9, 0x93, 0x1C, 0xE7, 0x95, 0xDE, 0x9B, 0x99, 0x95, 0x99, 0x95, 0x59, 0x7B, 0x55, 0x57,
+			0x55, 0x57, 0x77, 0x03, 0x9E, 0xC5, 0x9A, 0x91, 0x46, 0x12, 0x29, 0xEE, 0x20, 0x08, 0x12, 0x00, 0xB1, 0x70, 0x01, 0x37,
+			0x51, 0xA2, 0xE4, 0x59, 0x3C, 0x73, 0x6F, 0xF8, 0x5F, 0x70, 0x84, 0xC3, 0x76, 0x84, 0xC7, 0x76, 0x38, 0x26, 0x1C, 0xE1,
+			0x88, 0xFB, 0xF9, 0xFA, 0xCB, 0xF5, 0xF5, 0x9D, 0x19, 0x6B, 0x34, 0x1A, 0x89, 0xE2, 0x22, 0xAE, 0x20, 0x08, 0x02, 0x20,
+			0x16, 0x92, 0x00, 0x09, 0x92, 0xA2, 0x34, 0x8B, 0x3C, 0x33, 0x12, 0xD0, 0xDD, 0xD5, 0xB5, 0xEF, 0x95, 0x95, 0x95, 0xCB,
+			0x8D, 0x37, 0x7F, 0xDD, 0xC7, 0x1D, 0xAE, 0x99, 0x50, 0x80, 0xDD, 0xD5, 0x55, 0x99, 0xF9, 0xBE, 0xEF, 0x59, 0x9E, 0xF3,
+			0x9C, 0xE7, 0x98, 0x3F, 0xFC, 0xE1, 0x9F, 0x46, 0x51, 0x64, 0x9A, 0xE6, 0x62, 0xB1, 0xD0, 0x75, 0x3D, 0x0C, 0x43, 0xD7,
+			0x75, 0x7D, 0xDF, 0xD7, 0x34, 0xCD, 0xB2, 0x2C, 0xCF, 0xF3, 0x32, 0x99, 0x4C, 0x10, 0x04, 0xCB, 0xE5, 0xD2, 0x71, 0x1C,
+			0x2D, 0x79, 0x45, 0x51, 0x94, 0x4A, 0xA5, 0x78, 0x7F, 0x14, 0x45, 0x71, 0x1C, 0x6B, 0x9A, 0x66, 0xDB, 0xF6, 0x72, 0xB9,
+			0xE4, 0x57, 0x96, 0x65, 0x19, 0x86, 0xE1, 0x79, 0x9E, 0x69, 0x9A, 0x86, 0x61, 0x68, 0x9A, 0xE6, 0xFB, 0xBE, 0x61, 0x18,
+			0x51, 0x14, 0xE9, 0xBA, 0xCE, 0x4F, 0x34, 0x4D, 0x4B, 0xA5, 0x52, 0xCB, 0xE5, 0x32, 0xF9, 0xA7, 0x66, 0x9A, 0x26, 0xDF,
+			0x6E, 0x9A, 0x66, 0x18, 0x86, 0x7C, 0xCE, 0x72, 0xB9, 0xE4, 0x3F, 0x0D, 0xC3, 0x48, 0xA5, 0x52, 0x41, 0x10, 0xF0, 0x81,
+			0x61, 0x18, 0xEA, 0xBA, 0xBE, 0x58, 0x2C, 0x0C, 0xC3, 0xF8, 0xAF, 0xFF, 0xF5, 0x4F, 0xE3, 0x38, 0x5E, 0x2E, 0x97, 0x7C,
+			0x5A, 0x2A, 0x95, 0x32, 0x0C, 0x83, 0x8F, 0xE2, 0xDF, 0x51, 0x14, 0x2D, 0x97, 0x4B, 0xCB, 0xB2, 0x7C, 0xDF, 0x4F, 0xA7,
+			0xD3, 0x7C, 0xA6, 0xA6, 0x69, 0x7C, 0xAC, 0xA6, 0x69, 0x71, 0x1C, 0x1B, 0x86, 0x11, 0x04, 0x41, 0x2A, 0x95, 0xE2, 0x8E,
+			0xF8, 0x28, 0x23, 0x79, 0x69, 0x9A, 0xC6, 0xAF, 0xF8, 0xC0, 0x20, 0x08, 0x78, 0xB3, 0xE3, 0x38, 0x51, 0x14, 0xF1, 0x2B,
+			0xD3, 0x34, 0x7D, 0xDF, 0xB7, 0x2C, 0x2B, 0x08, 0x82, 0x28, 0x8A, 0x6C, 0xDB, 0xE6, 0x6D, 0x61, 0x18, 0x5A, 0x96, 0x15,
+			0x26, 0xAF, 0x74, 0x3A, 0xCD, 0x05, 0xA7, 0x52, 0x29, 0xDE, 0xBC, 0xFB, 0x1C, 0xB6, 0x3F, 0xFF, 0xBD, 0x43, 0xB5, 0x99,
+			0x1F, 0x8D, 0xE7, 0x4B, 0x3F, 0x32, 0x7E, 0xAD, 0x9C, 0xF2, 0x23, 0xC3, 0x0B, 0xB4, 0xFE, 0x78, 0xBE, 0x08, 0xF5, 0x83,
+			0x45, 0xDD, 0x8F, 0x8C, 0xE9, 0x22, 0x5C, 0x84, 0xFA, 0xAF, 0x95, 0x53, 0x33, 0x3F, 0x7A, 0xF9, 0xD3, 0xE1, 0xF1, 0xAF,
+			0x94, 0x02, 0xCD, 0x5C, 0xCB, 0x86, 0x7E, 0x64, 0xCC, 0x97, 0xF1, 0xCC, 0x8F, 0x0E, 0x16, 0xF5, 0x99, 0x1F, 0x45, 0x86,
+			0x3D, 0xF1, 0x82, 0x45, 0xA8, 0x6F, 0xE4, 0xE3, 0x89, 0x17, 0x68, 0xA6, 0x33, 0x98, 0x78, 0x97, 0xFE, 0xA6, 0xFF, 0xBB,
+			0x0F, 0x36, 0xBC, 0x40, 0xF3, 0x02, 0x6D, 0xE2, 0x05, 0x8D, 0x8C, 0x7A, 0xC3, 0x7C, 0x19, 0x6B, 0xA6, 0x53, 0x77, 0xFC,
+			0x99, 0x1F, 0xE9, 0x96, 0x3B, 0xF1, 0x82, 0xF9, 0x32, 0x5E, 0xCF, 0x45, 0x33, 0x3F, 0x7A, 0xE9, 0xE3, 0xCE, 0x63, 0x77,
+			0xAD, 0x6F, 0xE4, 0xE3, 0x40, 0x33, 0x07, 0x13, 0x8F, 0x6F, 0xFF, 0xB5, 0x72, 0x2A, 0xD0, 0x4C, 0x2E, 0xE3, 0x60, 0x51,
+			0x9F, 0x2E, 0xC2, 0x99, 0x1F, 0xF9, 0x91, 0xE1, 0x47, 0xC6, 0xFF, 0xFB, 0xB3, 0xDF, 0xD6, 0x75, 0x9D, 0x15, 0x0C, 0x82,
+			0x20, 0x8E, 0x63, 0x96, 0x8C, 0x05, 0x0D, 0x82, 0x20, 0x0C, 0x43, 0x7E, 0xC8, 0x9E, 0x31, 0x4D, 0x93, 0x47, 0xB4, 0x58,
+			0x2C, 0x2C, 0xCB, 0xE2, 0x6F, 0x3D, 0xCF, 0x93, 0x25, 0xD6, 0x75, 0xDD, 0xB2, 0xAC, 0x28, 0x8A, 0x78, 0xF2, 0xB2, 0x01,
+			0x4C, 0xD3, 0x8C, 0xE3, 0x98, 0x4F, 0x63, 0xB3, 0xB1, 0x0D, 0x6C, 0xDB, 0xE6, 0x9D, 0x41, 0x10, 0x58, 0x96, 0x15, 0xC7,
+			0xB1, 0xAE, 0xEB, 0xF2, 0x66, 0x76, 0x9D, 0xAC, 0x23, 0xBF, 0xF2, 0x7D, 0x3F, 0x8A, 0x22, 0xD7, 0x75, 0xE3, 0x38, 0x5E,
+			0x79, 0xEE, 0x97, 0xD6, 0x79, 0x6D, 0x7A, 0xC4, 0x4F, 0xA5, 0x52, 0xC5, 0x0F, 0x32, 0xDB, 0x77, 0x74, 0x2A, 0x95, 0x4A,
+			0xB7, 0xDB, 0x0D, 0xC3, 0xB0, 0x7E, 0xB3, 0x2C, 0x57, 0x12, 0x9F, 0x56, 0x07, 0x24, 0x77, 0x25, 0xDD, 0xBC, 0xB3, 0x6B,
+			0x59, 0x96, 0xEB, 0xBA, 0xCE, 0xC5, 0xDD, 0xDD, 0xAB, 0xF6, 0xCF, 0x13, 0xE9, 0x56, 0xAB, 0x95, 0xC9, 0x64, 0x8A, 0x1F,
+			0x64, 0xE2, 0x38, 0x56, 0xFB, 0xFC, 0x71, 0xDB, 0x78, 0x27, 0x64, 0xB3, 0x19, 0x86, 0xC1, 0x81, 0x92, 0x87, 0xB0, 0x7C,
+			0x54, 0xEB, 0xF7, 0xFB, 0xF9, 0x7C, 0xBE, 0xDF, 0xEF, 0x17, 0x0A, 0x05, 0xC3, 0x30, 0xB2, 0x97, 0xED, 0x74, 0x3A, 0x7D,
+			0xFB, 0xAB, 0x3B, 0xC5, 0x62, 0xD1, 0x3A, 0xAF, 0x19, 0x4F, 0xA4, 0x7B, 0xBD, 0x5E, 0x1C, 0xC7, 0x6B, 0x9F, 0x57, 0xE7,
+			0xF3, 0x39, 0xCF, 0x64, 0xF6, 0xF0, 0xD2, 0xBD, 0x64, 0x36, 0xEF, 0xEC, 0x56, 0xAB, 0x55, 0xFB, 0x3D, 0x3D, 0x4E, 0x5E,
+			0xE9, 0x5C, 0x5D, 0xD7, 0x75, 0xDF, 0xF7, 0x75, 0x5D, 0xFD, 0x84, 0xF3, 0xA5, 0xEB, 0x3A, 0x27, 0x82, 0xD3, 0xCA, 0x26,
+			0xDC, 0xBF, 0x21, 0xE5, 0x70, 0x45, 0xC9, 0xCB, 0x34, 0xCD, 0xE5, 0x72, 0xC9, 0x93, 0xE4, 0x44, 0xF3, 0x51, 0xA9, 0x54,
+			0x8A, 0xC3, 0x25, 0x87, 0x74, 0xB9, 0x5C, 0x72, 0x31, 0xBE, 0xAF, 0x9E, 0x18, 0x3F, 0x31, 0x0C, 0x63, 0xF7, 0xAE, 0xF7,
+			0x9E, 0x3C, 0x37, 0xCE, 0x29, 0xE0, 0xD4, 0xB0, 0xE2, 0xAC, 0x23, 0x07, 0x87, 0xCF, 0x4F, 0xB5, 0x52, 0xB2, 0xAC, 0xEA,
+			0x3F, 0x93, 0x37, 0xB3, 0xB2, 0x96, 0x65, 0x71, 0x4E, 0xF9, 0x07, 0xCB, 0xC7, 0xD9, 0x09, 0xC3, 0x50, 0xD3, 0x34, 0x8E,
+			0x33, 0xA7, 0x6C, 0xB1, 0x58, 0x68, 0x9A, 0xE6, 0x38, 0x0E, 0xDB, 0x80, 0x83, 0xAC, 0x69, 0xDA, 0x72, 0xB9, 0xE4, 0xF3,
+			0x5D, 0xD7, 0xE5, 0x57, 0x5C, 0x1B, 0x26, 0x82, 0xBB, 0x66, 0xA3, 0xB2, 0x79, 0xE6, 0xF3, 0x79, 0x3A, 0x9D, 0xE6, 0xD1,
+			0x61, 0x70, 0x16, 0x8B, 0x85, 0x6D, 0xDB, 0x3C, 0x16, 0x76, 0xF5, 0x7C, 0x3E, 0xD7, 0x34, 0x8D, 0x4F, 0xF8, 0xED, 0xBB,
+			0x76, 0xCD, 0x4B, 0x10, 0x04, 0x62, 0x33, 0x79, 0x32, 0x8B, 0xC5, 0x22, 0x93, 0xC9, 0x60, 0x15, 0xD9, 0xDE, 0xDC, 0x97,
+			0x61, 0x18, 0x7C, 0x02, 0x57, 0xCB, 0x0E, 0xC7, 0x1E, 0xF2, 0x54, 0x31, 0x41, 0xBE, 0xEF, 0x67, 0xB3, 0x59, 0xF6, 0x18,
+			0x2B, 0xB5, 0x5C, 0x2E, 0xD3, 0xE9, 0xB4, 0xEF, 0xFB, 0x6A, 0xCB, 0x25, 0xCF, 0x9C, 0xB5, 0xE0, 0xF8, 0xF0, 0xAC, 0xB0,
+			0xD2, 0x86, 0x61, 0x70, 0xE3, 0x6A, 0x2B, 0xEE, 0xAD, 0x23, 0xBB, 0xC2, 0xB6, 0x6D, 0xD6, 0x8B, 0xE7, 0xA0, 0x56, 0x6B,
+			0xFB, 0xDF, 0xFC, 0x1B, 0xCE, 0x21, 0xE6, 0xAC, 0x58, 0x2C, 0x4E, 0xA7, 0xD3, 0xE5, 0x72, 0xE9, 0xBA, 0xAE, 0xAE, 0xEB,
+			0x53, 0x4D, 0x9B, 0x4E, 0xA7, 0xA9, 0x54, 0x2A, 0x9D, 0x4E, 0xF3, 0x95, 0xAC, 0xAB, 0xEF, 0xFB, 0xCB, 0xE5, 0xB2, 0x5A,
+			0xAD, 0xEA, 0xBA, 0xDE, 0x6E, 0xB7, 0x39, 0x4E, 0xB9, 0x5C, 0x6E, 0xEC, 0x79, 0x8B, 0xC5, 0x22, 0x08, 0x82, 0x5C, 0x2E,
+			0xE7, 0x38, 0x8E, 0xF5, 0x2F, 0xFF, 0x65, 0xFB, 0xDF, 0xFD, 0x3B, 0x5D, 0xD7, 0x33, 0x99, 0xCC, 0x64, 0x32, 0xE1, 0x24,
+			0xAC, 0xAE, 0xAE, 0xCE, 0xE7, 0xF3, 0xC9, 0x64, 0x62, 0x9A, 0xA6, 0x65, 0x59, 0xD3, 0xE9, 0x34, 0x9D, 0x4E, 0xB3, 0x83,
+			0x79, 0x28, 0x9E, 0xE7, 0xAD, 0xAC, 0xAC, 0x8C, 0xC7, 0xE3, 0x4C, 0x26, 0x33, 0x9B, 0xCD, 0x16, 0x8B, 0x05, 0x06, 0x14,
+			0x53, 0x9B, 0x4E, 0xA7, 0x1D, 0xC7, 0x99, 0xCD, 0x66, 0xCA, 0x55, 0xFC, 0xFA, 0x57, 0xEF, 0xB9, 0xE7, 0x1E, 0x1E, 0xFD,
+			0x62, 0xB1, 0xA8, 0xD5, 0x6A, 0x41, 0x10, 0x74, 0x3A, 0x1D, 0xDB, 0x56, 0x27, 0x2A, 0x9B, 0xCD, 0x06, 0x41, 0xC0, 0x61,
+			0xE3, 0xCE, 0x59, 0xB6, 0x7E, 0xBF, 0x5F, 0x2C, 0x16, 0x97, 0xCB, 0xE5, 0x70, 0x38, 0xFC, 0xF8, 0xE3, 0x8F, 0x4F, 0x9D,
+			0x3A, 0x35, 0x99, 0x4C, 0xD8, 0x0D, 0xA5, 0x52, 0x69, 0x34, 0x1A, 0x15, 0x0A, 0x85, 0x7E, 0xBF, 0xCF, 0x4A, 0x64, 0xB3,
+			0x59, 0xDF, 0xF7, 0x47, 0xA3, 0xD1, 0xCA, 0xCA, 0x4A, 0xAB, 0xD5, 0xAA, 0xD5, 0x6A, 0x1C, 0x03, 0xD7, 0x75, 0x5B, 0xAD,
+			0x96, 0x69, 0x9A, 0x1F, 0x7C, 0xF0, 0xC1, 0x89, 0x13, 0x27, 0xC6, 0xE3, 0x31, 0xAE, 0x6E, 0x75, 0x75, 0x15, 0x7F, 0xD3,
+			0xED, 0x76, 0x6B, 0xB5, 0x5A, 0xBB, 0xDD, 0xB6, 0x2C, 0x2B, 0x9F, 0xCF, 0x73, 0xF6, 0x86, 0xC3, 0x61, 0xA1, 0x50, 0x18,
+			0x0E, 0x87, 0x6C, 0xD9, 0x9F, 0x6D, 0x7F, 0xAE, 0xFE, 0xA1, 0x5B, 0xE9, 0x74, 0x3A, 0xF4, 0xD5, 0x66, 0x5A, 0x04, 0x5A,
+			0xA4, 0x5B, 0xCA, 0x9E, 0xAA, 0x3D, 0xA1, 0x87, 0x4B, 0xB5, 0x6B, 0xE7, 0x4B, 0x7F, 0xB9, 0x8C, 0x6C, 0x5B, 0xAD, 0x88,
+			0x7A, 0x50, 0x91, 0x5A, 0x08, 0x75, 0x76, 0xA3, 0x58, 0x7C, 0xD8, 0x7C, 0xB1, 0x08, 0x43, 0x2D, 0x8A, 0x8C, 0x64, 0xAF,
+			0xC6, 0x7E, 0xB2, 0x71, 0xB1, 0x3E, 0x81, 0xFA, 0xE1, 0xAE, 0xDF, 0xD2, 0x95, 0x03, 0x98, 0x7B, 0x9E, 0x67, 0x17, 0x9C,
+			0xF9, 0x72, 0x81, 0x75, 0x36, 0xD5, 0xFF, 0x46, 0x69, 0x5D, 0x4F, 0xA7, 0xD3, 0x89, 0x1D, 0x37, 0x82, 0x50, 0x6D, 0x86,
+			0x70, 0x19, 0x24, 0x76, 0x21, 0x4C, 0x8C, 0xA6, 0x6E, 0x18, 0x56, 0x98, 0xFC, 0x3C, 0x9D, 0xB6, 0xB4, 0x50, 0x5F, 0x2E,
+			0x94, 0x53, 0xCC, 0x54, 0x1F, 0x2E, 0x16, 0x8B, 0x61, 0x18, 0xCE, 0xE7, 0xF3, 0x7C, 0x3E, 0x3F, 0x1C, 0x0E, 0x2B, 0x95,
+			0xCA, 0x68, 0x34, 0xE2, 0x9C, 0x67, 0xB3, 0x59, 0xCB, 0xB2, 0x52, 0xA9, 0xD4, 0x7C, 0x3E, 0xB7, 0x6D, 0x7B, 0x34, 0x1A,
+			0x55, 0xAB, 0xD5, 0xF1, 0x78, 0xBC, 0x58, 0x2C, 0xCA, 0xE5, 0xB2, 0xE7, 0x79, 0xDD, 0x5B, 0x67, 0xFF, 0xC9, 0x57, 0xBE,
+			0xC9, 0x23, 0x2D, 0x95, 0x4A, 0xE3, 0xF1, 0x58, 0x0E, 0x70, 0xBF, 0xDF, 0x2F, 0x97, 0xCB, 0xBE, 0xEF, 0x4F, 0x26, 0x93,
+			0x83, 0x07, 0x0F, 0x36, 0x9B, 0x4D, 0xD3, 0x34, 0x8B, 0xC5, 0xE2, 0x78, 0x3C, 0xCE, 0x66, 0xB3, 0xED, 0x76, 0x5B, 0x7D,
+			0x7B, 0x26, 0xB3, 0xF5, 0x8B, 0x9F, 0xAC, 0x7F, 0xE9, 0x69, 0xCB, 0xB2, 0x32, 0x99, 0xCC, 0x7C, 0x3E, 0xE7, 0xAB, 0xB3,
+			0xD9, 0x6C, 0x2E, 0x97, 0xEB, 0xF5, 0x7A, 0xD9, 0x6C, 0x76, 0x32, 0x99, 0xA8, 0x87, 0xBC, 0xB7, 0xF1, 0x88, 0x51, 0xDA,
+			0xED, 0x76, 0x36, 0x9B, 0x75, 0xDF, 0xFF, 0xEF, 0x3B, 0xF7, 0xF5, 0x1E, 0x5B, 0x3C, 0xFC, 0x51, 0xF9, 0x8B, 0x5F, 0x7E,
+			0x79, 0xEB, 0x77, 0x73, 0xCF, 0x9E, 0x0B, 0xAF, 0xFE, 0x41, 0xED, 0xF9, 0xFF, 0x76, 0xFB, 0xFB, 0xF3, 0x47, 0x82, 0x53,
+			0xE9, 0x63, 0x6F, 0x84, 0x17, 0xC2, 0x30, 0x1C, 0x35, 0x3B, 0xCF, 0x3A, 0xA7, 0xCE, 0x3F, 0xF0, 0x81, 0xAB, 0xBB, 0xCF,
+			0xA4, 0x4F, 0xFE, 0xD5, 0xF0, 0xB5, 0xC5, 0x21, 0xFB, 0xC8, 0xF4, 0x6E, 0xCB, 0xB2, 0x6E, 0xDE, 0xFC, 0xE2, 0x9E, 0xE1,
+			0x1D, 0x63, 0x7F, 0x6C, 0x6B, 0xF6, 0xC5, 0xFB, 0xAF, 0xEB, 0xBA, 0x7E, 0x3A, 0x3E, 0xF2, 0xDE, 0xFC, 0xC3, 0xF4, 0x89,
+			0xF4, 0xA9, 0xF0, 0x58, 0x2A, 0x95, 0x9A, 0x4C, 0x26, 0x57, 0xF3, 0x9F, 0xDE, 0xD5, 0xFA, 0xED, 0x74, 0x3A, 0x6D, 0x18,
+			0xC6, 0x95, 0xDC, 0xCD, 0xEE, 0xF6, 0x76, 0x14, 0x45, 0xA7, 0xA2, 0x87, 0xDC, 0x86, 0xFB, 0x56, 0x7C, 0x29, 0x7B, 0xD9,
+			0x7E, 0xF0, 0xD8, 0xBD, 0x51, 0x14, 0xDD, 0x1F, 0xC7, 0x2F, 0x8D, 0xDE, 0x3E, 0x73, 0xE8, 0xF8, 0xBB, 0xE3, 0x6B, 0x4F,
+			0x18, 0x47, 0xE7, 0xF3, 0x79, 0xE9, 0x50, 0x69, 0xB9, 0x5C, 0x66, 0xB3, 0xD9, 0xD7, 0x96, 0xE7, 0xC7, 0xA3, 0xF1, 0x63,
+			0x8F, 0x9C, 0x49, 0xA7, 0xD3, 0xDF, 0xEB, 0xBC, 0x54, 0x3D, 0x51, 0xF5, 0xFD, 0xE5, 0xA3, 0xCB, 0x43, 0x9F, 0xFC, 0xF4,
+			0xD6, 0x3D, 0x87, 0x1E, 0xDE, 0x6F, 0xF4, 0x59, 0xD0, 0x28, 0x8A, 0xD2, 0xE9, 0x34, 0x7E, 0x94, 0xDF, 0x5A, 0x96, 0xD5,
+			0xED, 0x76, 0xB1, 0x5C, 0x62, 0x3B, 0xC4, 0x24, 0x61, 0x77, 0x88, 0x5D, 0x24, 0x82, 0x99, 0xCF, 0xE7, 0x1F, 0x5D, 0xB9,
+			0x70, 0xE8, 0xE1, 0x47, 0x79, 0x1B, 0xE6, 0x86, 0x77, 0x62, 0x2E, 0x71, 0xB1, 0x04, 0x7C, 0x51, 0x14, 0x75, 0xBB, 0xCA,
+			0x3B, 0x62, 0xD9, 0x71, 0x0C, 0x7C, 0x14, 0xC7, 0x73, 0x7F, 0xBC, 0xA5, 0x5C, 0x57, 0x3A, 0xDD, 0xE9, 0x74, 0x6E, 0xBE,
+			0x72, 0xE5, 0x4B, 0xF6, 0x9D, 0x86, 0x6D, 0xF0, 0x87, 0xA6, 0x69, 0x4E, 0xA7, 0xD3, 0x20, 0x08, 0x32, 0x99, 0x0C, 0x17,
+			0xEF, 0x38, 0xCE, 0x78, 0x3C, 0x36, 0x4D, 0x53, 0xB3, 0xB4, 0xD9, 0x6C, 0x96, 0x2D, 0x66, 0x31, 0x35, 0xD3, 0xE9, 0x34,
+			0x97, 0xCB, 0xF5, 0xFB, 0x7D, 0xDD, 0xD4, 0xF3, 0xF9, 0xFC, 0x68, 0x34, 0xCA, 0xE5, 0x72, 0x18, 0x13, 0x3E, 0x24, 0xD9,
+			0xD4, 0xF1, 0x2F, 0xF4, 0xCF, 0x7E, 0x23, 0xF5, 0xB5, 0xC8, 0x88, 0x2C, 0xDD, 0x72, 0xF2, 0x8E, 0xB2, 0xE6, 0x39, 0x7B,
+			0x3C, 0x1E, 0x57, 0xAB, 0x55, 0xCC, 0x94, 0xAE, 0xEB, 0xD9, 0x6C, 0x76, 0x38, 0x1C, 0x06, 0x41, 0x50, 0x2A, 0x95, 0xAC,
+			0x8A, 0xD5, 0xE9, 0x74, 0x4A, 0xF9, 0x12, 0x47, 0xB5, 0x56, 0xAA, 0xCD, 0xE7, 0x73, 0xDC, 0xC6, 0x62, 0xB1, 0x70, 0x5D,
+			0x57, 0xC5, 0x04, 0xD9, 0x08, 0x8B, 0x3F, 0x9B, 0xCD, 0xFC, 0xF8, 0xDA, 0xEF, 0x3D, 0xF7, 0x65, 0x2C, 0x98, 0x69, 0x9A,
+			0x9E, 0xE7, 0xB1, 0xEB, 0x56, 0x56, 0x56, 0x3A, 0x9D, 0x4E, 0x18, 0x86, 0x1C, 0x7C, 0x75, 0x84, 0xE7, 0xF3, 0x30, 0x0C,
+			0xA7, 0xD3, 0x69, 0xBD, 0x5E, 0xC7, 0x67, 0xB4, 0xDB, 0xED, 0x74, 0x3A, 0x9D, 0xCF, 0xE7, 0x75, 0x5D, 0x27, 0x14, 0x50,
+			0x97, 0x67, 0xDB, 0xEA, 0x6C, 0xDA, 0x36, 0x3E, 0xF5, 0xE5, 0xB3, 0xB7, 0x5E, 0x38, 0xF3, 0x9B, 0xBD, 0x5E, 0xCF, 0x34,
+			0xCD, 0x6C, 0x36, 0xCB, 0x39, 0x52, 0x16, 0x20, 0xF1, 0x97, 0x3C, 0xE1, 0xF1, 0x78, 0xCC, 0xF3, 0x5C, 0x2E, 0x97, 0xB9,
+			0x5C, 0x8E, 0x27, 0x43, 0xDC, 0xC0, 0x22, 0xA6, 0xD3, 0xE9, 0xC9, 0x64, 0x52, 0x2C, 0x16, 0xB1, 0xF0, 0x86, 0x61, 0x2C,
+			0x16, 0x0B, 0x5C, 0x9D, 0x72, 0x14, 0xC5, 0x62, 0xD1, 0xF7, 0x7D, 0xFC, 0x15, 0x0F, 0x3D, 0x97, 0xCB, 0xE5, 0xF3, 0xF9,
+			0xF9, 0x7C, 0x8E, 0xCF, 0xE1, 0x18, 0x7B, 0x9E, 0x87, 0x17, 0xE5, 0xEA, 0xB1, 0x95, 0xDD, 0x6E, 0x77, 0x36, 0x9B, 0x15,
+			0x0A, 0x05, 0xCF, 0xF3, 0x78, 0x64, 0x58, 0xD2, 0x4A, 0xA5, 0xE2, 0x38, 0x4E, 0x62, 0x35, 0xA2, 0x5C, 0x2E, 0x27, 0x21,
+			0x9E, 0xA6, 0x69, 0xAE, 0xEB, 0x62, 0xD3, 0x57, 0x56, 0x56, 0x88, 0xA3, 0xD5, 0x33, 0x8D, 0xA2, 0xC5, 0x62, 0xC1, 0x69,
+			0x21, 0xC2, 0x1A, 0x8F, 0xC7, 0xB9, 0x5C, 0xCE, 0x30, 0x0C, 0xD7, 0x75, 0x09, 0x1F, 0xA2, 0x28, 0x2A, 0x16, 0x8B, 0xAE,
+			0xEB, 0x72, 0x01, 0x9A, 0xA6, 0x55, 0xAB, 0x55, 0xF6, 0x56, 0x2E, 0x97, 0x2B, 0x14, 0x0A, 0xB8, 0xC7, 0x4E, 0xA7, 0xE3,
+			0xBA, 0x6E, 0x2E, 0x97, 0xC3, 0x32, 0xE2, 0x6C, 0x27, 0x93, 0xC9, 0x70, 0x38, 0xEC, 0x26, 0x2F, 0x22, 0xA6, 0xC9, 0x64,
+			0xE2, 0xBA, 0xEE, 0xCA, 0xCA, 0x0A, 0xCF, 0xC8, 0xF3, 0xBC, 0x62, 0xB1, 0x88, 0x7B, 0x27, 0x56, 0xE5, 0x79, 0x69, 0x9A,
+			0xD6, 0x6E, 0xB7, 0x47, 0xA3, 0x51, 0xB9, 0x5C, 0x0E, 0x82, 0x60, 0x63, 0x63, 0x83, 0xCD, 0x37, 0x9F, 0xCF, 0x0D, 0xC3,
+			0x60, 0xF1, 0xB8, 0xE0, 0x4C, 0x26, 0x93, 0x4E, 0xA7, 0x31, 0xEE, 0xED, 0x76, 0x7B, 0x3A, 0x9D, 0x16, 0x0A, 0x05, 0x49,
+			0x2F, 0x3A, 0x9D, 0xCE, 0xF6, 0xF6, 0xF6, 0x60, 0x30, 0xC8, 0xE5, 0x72, 0x84, 0x9F, 0x8D, 0x46, 0x03, 0xA7, 0xCD, 0xFF,
+			0xFA, 0xBE, 0x4F, 0x5C, 0x43, 0x46, 0x45, 0x44, 0x40, 0x40, 0x47, 0xD4, 0x43, 0x18, 0x42, 0x1C, 0x84, 0xC3, 0x56, 0xF6,
+			0x3F, 0x39, 0x8A, 0x1C, 0x7E, 0x39, 0x93, 0xBA, 0xAE, 0x13, 0x08, 0x28, 0x3F, 0x90, 0x7C, 0x1A, 0xB1, 0x27, 0x01, 0x11,
+			0x0F, 0x9C, 0xCD, 0xCA, 0xA3, 0x20, 0x71, 0x51, 0xCE, 0x24, 0x89, 0x0B, 0x8C, 0xE4, 0xB5, 0x5C, 0x2E, 0xF9, 0xAE, 0x38,
+			0x8E, 0x2D, 0x4B, 0xF9, 0xA7, 0xC4, 0x13, 0xA8, 0xAB, 0x0A, 0xC3, 0xD0, 0x4B, 0xDC, 0x3C, 0xD9, 0x95, 0xA6, 0x69, 0xC3,
+			0xE1, 0xD0, 0x30, 0x8C, 0x5A, 0xAD, 0x36, 0x1E, 0x8F, 0x0B, 0x85, 0x82, 0xE3, 0x38, 0x95, 0x4A, 0x45, 0xD3, 0xB4, 0x8D,
+			0x8D, 0x0D, 0xC2, 0x34, 0xD7, 0x75, 0xF1, 0x10, 0xE9, 0x74, 0xBA, 0xDD, 0x6E, 0x6B, 0x9A, 0x96, 0xC9, 0x64, 0xFA, 0xFD,
+			0x7E, 0x26, 0x93, 0xF1, 0x7D, 0x7F, 0x36, 0x9B, 0x95, 0x4A, 0x25, 0x5D, 0xD7, 0x87, 0xC3, 0xA1, 0x9D, 0xBC, 0x38, 0xBD,
+			0x85, 0x42, 0xC1, 0xB2, 0xAC, 0x42, 0xA1, 0xC0, 0x8A, 0x94, 0x4A, 0xEA, 0x58, 0xF6, 0x7A, 0x3D, 0x62, 0xC9, 0x4A, 0xA5,
+			0x52, 0xAF, 0xD7, 0xB9, 0x53, 0x39, 0x60, 0xE2, 0x48, 0xF0, 0x7C, 0x85, 0x42, 0x81, 0x20, 0x71, 0x6D, 0x6D, 0x6D, 0x7D,
+			0x7D, 0xBD, 0xD5, 0x6A, 0x8D, 0x46, 0x23, 0xDB, 0xB6, 0x5B, 0xAD, 0x56, 0x3E, 0x9F, 0x67, 0x93, 0x7C, 0xA7, 0xF8, 0x54,
+			0xA5, 0x52, 0xE1, 0x69, 0xBC, 0x1E, 0xBC, 0x77, 0x32, 0x3C, 0xDC, 0x6A, 0xB5, 0x8A, 0xC5, 0xE2, 0xF3, 0xD9, 0xC7, 0x07,
+			0x83, 0xC1, 0x3D, 0x9D, 0xAF, 0x9C, 0x31, 0x8F, 0x3F, 0xA6, 0x29, 0x27, 0x77, 0x32, 0x3C, 0x7C, 0x68, 0x78, 0xC7, 0x9F,
+			0xED, 0xFC, 0xE8, 0xA8, 0x77, 0x9F, 0xEB, 0xBA, 0xF9, 0xE4, 0x25, 0x29, 0xCE, 0xEA, 0xEA, 0xEA, 0xB3, 0xCE, 0xA9, 0x5C,
+			0x2E, 0xE7, 0xFB, 0xFE, 0x89, 0xE0, 0xC1, 0xC1, 0x60, 0xD0, 0x6A, 0xB5, 0x7E, 0x3C, 0x7D, 0x6B, 0x34, 0x1A, 0x9D, 0x8E,
+			0x8F, 0xF0, 0x78, 0xC7, 0xE3, 0x71, 0x10, 0x04, 0x7F, 0xD4, 0x78, 0xA1, 0x5C, 0x2E, 0xBF, 0x3C, 0x3F, 0xFB, 0xD2, 0xEC,
+			0x6D, 0xD2, 0xEB, 0xE1, 0x70, 0xF8, 0xE3, 0xE9, 0x5B, 0x57, 0x72, 0x37, 0xC3, 0x30, 0x3C, 0xAB, 0x5F, 0x8E, 0xA2, 0xE8,
+			0x92, 0x7B, 0xC3, 0x71, 0x9C, 0x57, 0xBC, 0x77, 0xD2, 0xE9, 0xF4, 0x74, 0x3A, 0x75, 0x5D, 0x37, 0x9B, 0xCD, 0x9A, 0xA6,
+			0xF9, 0x8A, 0xF7, 0xCE, 0xEF, 0x57, 0xBF, 0xF9, 0x8D, 0x9D, 0xDF, 0xBA, 0xBF, 0xFF, 0x35, 0xBC, 0x35, 0xAB, 0x20, 0x67,
+			0x9B, 0x28, 0x1B, 0xA3, 0x6F, 0x26, 0x2F, 0x0E, 0x42, 0xBB, 0xDD, 0xDE, 0x8D, 0x1E, 0x92, 0x14, 0x8A, 0xDD, 0x62, 0x9A,
+			0xA6, 0x8A, 0xD2, 0x12, 0xE3, 0x4B, 0xB8, 0xC0, 0x1F, 0xAA, 0xC8, 0x2F, 0x31, 0xC4, 0x62, 0xE5, 0xF1, 0x5E, 0x7C, 0x14,
+			0x06, 0x51, 0xF6, 0x55, 0x1C, 0xC7, 0x41, 0x10, 0x88, 0xE7, 0xE6, 0x3D, 0x8E, 0xE3, 0x10, 0x32, 0x62, 0xA4, 0xC8, 0x3F,
+			0x38, 0x0E, 0x89, 0x3D, 0x54, 0xDE, 0x08, 0x33, 0x87, 0xC1, 0x21, 0xE2, 0x9E, 0xCD, 0x66, 0xA6, 0x69, 0x12, 0x07, 0xCC,
+			0x66, 0xB3, 0xE4, 0x1C, 0xA8, 0x9F, 0xCF, 0xE7, 0x73, 0xC7, 0x71, 0x8A, 0xC5, 0xE2, 0x6C, 0x36, 0x8B, 0xA2, 0x68, 0x30,
+			0x18, 0x70, 0x30, 0x89, 0x3C, 0x06, 0x83, 0x41, 0x1C, 0xC7, 0x9E, 0xE7, 0xB5, 0x5A, 0x2D, 0xC7, 0x71, 0xB0, 0x8C, 0x98,
+			0x6C, 0xC3, 0x30, 0x1C, 0xC7, 0xA9, 0xD7, 0xEB, 0x61, 0x18, 0xB2, 0x81, 0x67, 0xB3, 0x99, 0xEB, 0xBA, 0x93, 0xC9, 0x44,
+			0xBC, 0x17, 0x09, 0x1F, 0x4B, 0x39, 0x1A, 0x8D, 0x2A, 0x95, 0x0A, 0x11, 0x67, 0x3E, 0x9F, 0x9F, 0x4C, 0x26, 0x5C, 0x39,
+			0x3E, 0x72, 0x3C, 0x1E, 0x77, 0xBB, 0x5D, 0xCF, 0xF3, 0xFA, 0xFD, 0xFE, 0x6C, 0x36, 0x73, 0x1C, 0x87, 0xEF, 0xCD, 0xE5,
+			0x72, 0xB6, 0x6D, 0x27, 0x81, 0x51, 0x7A, 0x30, 0x18, 0x44, 0x51, 0xD4, 0xE9, 0x74, 0xC0, 0x45, 0x66, 0xB3, 0x59, 0xBF,
+			0xDF, 0x1F, 0x0C, 0x06, 0x9C, 0xB8, 0x4C, 0x26, 0x33, 0x9D, 0x4E, 0x0D, 0xC3, 0xE0, 0xF6, 0x39, 0xFB, 0xA3, 0xD1, 0xC8,
+			0x30, 0x0C, 0x72, 0x3E, 0x4C, 0x10, 0x9B, 0x19, 0x83, 0x39, 0x99, 0x4C, 0x88, 0xB3, 0x27, 0x93, 0x89, 0xE7, 0x79, 0x04,
+			0x04, 0xBA, 0xAE, 0xE3, 0x27, 0x16, 0x8B, 0x45, 0x3E, 0x9F, 0xC7, 0xAE, 0x9A, 0xA6, 0xD9, 0xEF, 0xF7, 0x17, 0x8B, 0x05,
+			0x6B, 0x1D, 0x45, 0xD1, 0x70, 0x38, 0xE4, 0x80, 0x70, 0x82, 0xF0, 0x79, 0xC4, 0x4F, 0x18, 0x0D, 0x43, 0xB2, 0x9B, 0x4A,
+			0xA5, 0x82, 0xE1, 0x20, 0x80, 0x52, 0x11, 0x68, 0x62, 0xF7, 0x87, 0xC3, 0x21, 0xEE, 0x0E, 0xBF, 0x81, 0x1B, 0xEF, 0xF7,
+			0xFB, 0xE4, 0x11, 0x9C, 0xD5, 0x30, 0x0C, 0xB3, 0xD9, 0x6C, 0xA9, 0x54, 0xE2, 0x94, 0x5A, 0x96, 0xD5, 0x4F, 0x5E, 0x96,
+			0x65, 0x39, 0x8E, 0x63, 0x9A, 0x66, 0xBD, 0x5E, 0xC7, 0x20, 0x66, 0x32, 0x19, 0xCF, 0xF3, 0x70, 0x59, 0x71, 0x1C, 0x2F,
+			0x16, 0x2A, 0x02, 0x8D, 0xA2, 0x28, 0x93, 0x51, 0xB9, 0x33, 0x6E, 0x9F, 0xF5, 0xF3, 0x3C, 0x6F, 0x30, 0x18, 0x4C, 0x26,
+			0x93, 0x5E, 0xAF, 0xA7, 0xCE, 0x59, 0x3E, 0x6F, 0x9A, 0x26, 0xCF, 0x88, 0x3C, 0x80, 0xE4, 0x34, 0x8A, 0xA2, 0x69, 0xF2,
+			0x6A, 0x36, 0x9B, 0xAE, 0xEB, 0xF6, 0x7A, 0xBD, 0xF5, 0xF5, 0x75, 0x33, 0x79, 0x4D, 0xA7, 0x53, 0x52, 0xE0, 0x4A, 0xA5,
+			0x52, 0xAB, 0xD5, 0x30, 0x25, 0x5C, 0x3C, 0x79, 0x46, 0xA7, 0xD3, 0x99, 0x4E, 0xA7, 0x3C, 0x71, 0x56, 0x8E, 0x23, 0xCA,
+			0x5E, 0x64, 0x9D, 0x26, 0x93, 0x49, 0xAD, 0x56, 0x2B, 0x95, 0x4A, 0xBD, 0x5E, 0xCF, 0xF7, 0xFD, 0xAD, 0xAD, 0xAD, 0x30,
+			0x0C, 0xF1, 0x43, 0x5C, 0x5E, 0xA9, 0x54, 0x8A, 0xA2, 0x08, 0xB3, 0xA2, 0xAE, 0x23, 0x39, 0x36, 0xF8, 0x12, 0x90, 0x90,
+			0x42, 0xA1, 0x50, 0x2C, 0x16, 0xAB, 0xD5, 0xAA, 0x78, 0xAF, 0x4E, 0xA7, 0xC3, 0x6E, 0xA8, 0xD7, 0xEB, 0x82, 0xAA, 0x71,
+			0xFC, 0xD8, 0x46, 0x0B, 0x15, 0xCB, 0x2B, 0x00, 0x81, 0x78, 0x2D, 0xB5, 0xF7, 0x92, 0x3D, 0x41, 0x1A, 0x28, 0xBE, 0x93,
+			0x74, 0x98, 0x5C, 0x5E, 0x52, 0x5A, 0x09, 0x13, 0x34, 0x4D, 0x93, 0x04, 0x39, 0x8E, 0x63, 0x2C, 0x3E, 0x76, 0x01, 0xE7,
+			0x21, 0x76, 0x01, 0xA4, 0x8E, 0x95, 0xC5, 0x67, 0x10, 0xD7, 0x60, 0x43, 0x25, 0x47, 0x8C, 0xE3, 0xD8, 0x75, 0x5D, 0x92,
+			0x36, 0x8E, 0x50, 0xB1, 0x58, 0x9C, 0x4C, 0x26, 0xED, 0x76, 0x7B, 0x75, 0x75, 0xD5, 0x71, 0x9C, 0xDB, 0xB7, 0x6F, 0x6B,
+			0x9A, 0x96, 0xCD, 0x66, 0x37, 0x37, 0x37, 0x1D, 0xC7, 0x51, 0x61, 0x7E, 0xB7, 0x5B, 0xAF, 0xD7, 0x89, 0xD1, 0xD6, 0xD7,
+			0xD7, 0x73, 0xB9, 0x5C, 0x3A, 0x9D, 0x5E, 0x5B, 0x5B, 0x5B, 0x2C, 0x16, 0xA6, 0x69, 0xE2, 0x86, 0x89, 0x45, 0xD8, 0x81,
+			0x86, 0x61, 0x0C, 0x06, 0x83, 0x7C, 0x3E, 0x4F, 0xEE, 0x95, 0xCF, 0xE7, 0x67, 0xB3, 0xD9, 0x78, 0x3C, 0xAE, 0xD5, 0x6A,
+			0x92, 0xA1, 0xB3, 0x1F, 0xD8, 0xC0, 0x8E, 0xE3, 0xE0, 0x63, 0x52, 0xA9, 0x94, 0xE3, 0x38, 0x84, 0x3F, 0x64, 0x78, 0xB8,
+			0xDB, 0xC5, 0x62, 0xD1, 0x6C, 0x36, 0x37, 0x36, 0x36, 0x24, 0xC2, 0x02, 0xCA, 0xD3, 0x75, 0xBD, 0xD9, 0x6C, 0xCE, 0xE7,
+			0xF3, 0x4C, 0x26, 0x53, 0x2A, 0x95, 0x5C, 0xD7, 0xFD, 0xF1, 0xF4, 0xAD, 0x52, 0xA9, 0x74, 0x6C, 0x71, 0x7F, 0x10, 0x04,
+			0x97, 0xDC, 0x1B, 0x6F, 0xC5, 0x97, 0x0C, 0xC3, 0x78, 0xC7, 0xB8, 0xD2, 0xE9, 0x74, 0x5E, 0x9A, 0xBD, 0xDD, 0x68, 0x34,
+			0x9E, 0xB6, 0x4F, 0x10, 0x67, 0x01, 0x5E, 0x0D, 0x1E, 0x50, 0x01, 0x97, 0xE3, 0x38, 0x2F, 0xCD, 0xDE, 0xFE, 0xF1, 0xF4,
+			0xAD, 0xD3, 0xF1, 0x91, 0xB7, 0xE2, 0x4B, 0x9A, 0xA6, 0x1D, 0x99, 0xDE, 0x7D, 0x21, 0xFD, 0xD1, 0x63, 0xDA, 0xC3, 0xE5,
+			0x72, 0xD9, 0x30, 0x8C, 0x8B, 0xCE, 0xF5, 0x77, 0x8C, 0x2B, 0x9F, 0x35, 0x7E, 0x71, 0x6F, 0xF7, 0x77, 0xA2, 0x28, 0x3A,
+			0x3C, 0xFE, 0xC6, 0xF3, 0xD9, 0xC7, 0xF1, 0x28, 0x96, 0x65, 0xAD, 0xAC, 0xAC, 0x3C, 0xE7, 0x9E, 0x7E, 0x68, 0x72, 0x57,
+			0x14, 0x45, 0xDF, 0xCA, 0x3D, 0x91, 0xC9, 0x64, 0x1E, 0x9E, 0xDD, 0x33, 0x9F, 0xCF, 0x1F, 0x99, 0xDF, 0x3B, 0x1C, 0x0E,
+			0xDF, 0x35, 0xAF, 0x35, 0x9B, 0xCD, 0x47, 0xE6, 0xF7, 0x7E, 0xAF, 0xF3, 0xD2, 0x73, 0xEE, 0xE9, 0xC1, 0x60, 0x50, 0x2C,
+			0x16, 0x3F, 0x6B, 0xFC, 0x82, 0x55, 0x36, 0xF6, 0x5E, 0x44, 0xE8, 0xAC, 0x2C, 0x4B, 0x83, 0xF9, 0xB6, 0x6D, 0x7B, 0x32,
+			0x99, 0x60, 0xFE, 0x6C, 0xDB, 0xC6, 0x44, 0xB2, 0xE5, 0x48, 0x55, 0x65, 0x59, 0xD9, 0x30, 0x51, 0x14, 0xCD, 0x66, 0x33,
+			0x8C, 0x35, 0x68, 0x86, 0x3C, 0xF0, 0xFD, 0x88, 0x2E, 0x79, 0x86, 0xCA, 0xC2, 0xA3, 0x08, 0xC7, 0x06, 0x42, 0x02, 0x94,
+			0x0A, 0x24, 0xA2, 0x36, 0xD6, 0x1E, 0xEA, 0x2B, 0xA9, 0x06, 0xDE, 0x02, 0x2C, 0x14, 0x87, 0x21, 0x7E, 0x05, 0x97, 0xD0,
+			0x6E, 0xB7, 0x31, 0x05, 0x84, 0x95, 0xEC, 0xEA, 0xF1, 0x78, 0x8C, 0x6F, 0x1B, 0x0E, 0x87, 0xB9, 0x5C, 0xCE, 0x75, 0xDD,
+			0x7A, 0xBD, 0x1E, 0x04, 0x41, 0xB9, 0x5C, 0xE6, 0xA0, 0x99, 0xA6, 0x89, 0xB3, 0x04, 0x3C, 0x49, 0xA7, 0xD3, 0x64, 0x3F,
+			0x04, 0x07, 0x96, 0x65, 0x61, 0xF8, 0x2A, 0x95, 0x4A, 0x2E, 0x97, 0x23, 0x5C, 0xCB, 0x66, 0xB3, 0x0A, 0x06, 0x5C, 0x59,
+			0xC1, 0x87, 0x91, 0x38, 0xE2, 0x24, 0x38, 0x5C, 0x99, 0x4C, 0x86, 0x6C, 0x15, 0x30, 0x20, 0x9D, 0x4E, 0x2B, 0xF4, 0x2F,
+			0xD9, 0x6C, 0x84, 0x4A, 0x9C, 0x53, 0xEC, 0x35, 0x21, 0x3F, 0xF7, 0xB2, 0xB2, 0xB2, 0x12, 0x86, 0x21, 0x61, 0x8D, 0x04,
+			0xDD, 0x92, 0x06, 0x25, 0x17, 0xAB, 0x0C, 0x2C, 0xD1, 0x70, 0x3E, 0x9F, 0x97, 0xDF, 0x82, 0x9C, 0x73, 0x52, 0xB8, 0x65,
+			0x92, 0xE0, 0x42, 0xA1, 0x00, 0x22, 0xE4, 0xBA, 0x2E, 0x8F, 0x3D, 0x9D, 0x4E, 0x0F, 0x87, 0x43, 0x02, 0x47, 0xC7, 0x51,
+			0xC9, 0x4D, 0x26, 0x93, 0xC9, 0xE5, 0x72, 0x0A, 0x45, 0x4C, 0x70, 0x21, 0xA0, 0x9A, 0xC9, 0x64, 0x02, 0xF2, 0x99, 0xC9,
+			0x64, 0x14, 0xD8, 0x98, 0xCD, 0xB2, 0xF9, 0x8B, 0xC5, 0x62, 0x36, 0x9B, 0x55, 0x19, 0x80, 0x98, 0xF8, 0xE9, 0x74, 0x8A,
+			0x51, 0xE6, 0xD8, 0xE0, 0xBE, 0xF2, 0xF9, 0x3C, 0x0E, 0x79, 0xB1, 0x58, 0x60, 0xB8, 0x33, 0x99, 0xCC, 0xCA, 0xCA, 0x8A,
+			0xA6, 0x69, 0xE3, 0xF1, 0x98, 0xBC, 0x1E, 0x2B, 0x8C, 0xA5, 0x9E, 0x4C, 0x26, 0x1C, 0x51, 0xC7, 0x71, 0xDA, 0xED, 0x36,
+			0x87, 0xBF, 0xDB, 0xED, 0x16, 0x0A, 0x85, 0x6E, 0xB7, 0xCB, 0x56, 0x28, 0x14, 0x0A, 0xF9, 0x7C, 0x9E, 0x08, 0x9A, 0xD4,
+			0x26, 0x8A, 0xA2, 0x7A, 0xBD, 0x8E, 0xCF, 0x08, 0xC3, 0x90, 0xFD, 0x57, 0x2A, 0x95, 0x4C, 0xD3, 0x74, 0x5D, 0x57, 0x2C,
+			0x75, 0xA9, 0x54, 0xC2, 0xD8, 0x99, 0xA6, 0x99, 0xCF, 0xE7, 0xC7, 0xE3, 0x31, 0x6B, 0x06, 0xF0, 0x32, 0x1C, 0x0E, 0x81,
+			0x6B, 0x88, 0x19, 0x57, 0x57, 0x57, 0x7B, 0xBD, 0x1E, 0xDE, 0x88, 0x4C, 0x42, 0xA0, 0x18, 0xA9, 0x0D, 0xF0, 0x0F, 0x8C,
+			0xE6, 0xEA, 0xEA, 0xEA, 0x2E, 0x96, 0x92, 0xA0, 0x04, 0xC3, 0xE1, 0x90, 0x4B, 0x25, 0xE2, 0xA8, 0xD5, 0x6A, 0xF9, 0x7C,
+			0x7E, 0x63, 0x63, 0x83, 0xBD, 0xCB, 0x2E, 0x29, 0x14, 0x0A, 0xAC, 0x7D, 0xB1, 0x58, 0x24, 0x83, 0x71, 0x5D, 0xB7, 0x50,
+			0x28, 0x4C, 0xA7, 0xD3, 0x28, 0x8A, 0xB8, 0x65, 0xB6, 0x08, 0xBB, 0x99, 0x38, 0xBA, 0x58, 0x2C, 0x8E, 0x46, 0x23, 0x42,
+			0x12, 0xE0, 0x08, 0x01, 0x5B, 0x3D, 0xCF, 0x63, 0xAB, 0x61, 0xD7, 0xD8, 0x85, 0x92, 0xB2, 0x61, 0x82, 0xC5, 0x0D, 0xC8,
+			0xC9, 0x59, 0x2E, 0x97, 0x98, 0x75, 0xDE, 0x80, 0xE7, 0x48, 0xFE, 0x3A, 0x02, 0x73, 0xE3, 0x30, 0x90, 0x90, 0x71, 0xFE,
+			0x09, 0x78, 0x39, 0xF9, 0xBC, 0x07, 0xF7, 0x2F, 0xC0, 0x3D, 0xDF, 0x22, 0xF5, 0x15, 0x02, 0x7F, 0xB2, 0x25, 0xF6, 0x9F,
+			0xEC, 0x30, 0x1E, 0x23, 0x21, 0x30, 0xDB, 0xA0, 0x5E, 0xAF, 0x0F, 0x06, 0x03, 0xC2, 0xF9, 0xD1, 0x68, 0xB4, 0xBA, 0xBA,
+			0x5A, 0x2A, 0x95, 0xF8, 0xCF, 0xF9, 0x7C, 0x3E, 0x1E, 0x8F, 0x7D, 0xDF, 0x9F, 0x4E, 0xA7, 0x9D, 0x4E, 0x07, 0xA4, 0x52,
+			0xD3, 0xB4, 0xDB, 0xB7, 0x6F, 0xD7, 0xEB, 0xF5, 0x66, 0xB3, 0x19, 0x04, 0x41, 0x3E, 0x9F, 0xEF, 0x74, 0x3A, 0x80, 0x75,
+			0xB8, 0xC0, 0x4C, 0x26, 0xA3, 0xEB, 0x7A, 0xA1, 0x50, 0x68, 0x36, 0x9B, 0xB3, 0xD9, 0xAC, 0x56, 0xAB, 0x0D, 0x87, 0x43,
+			0x9E, 0x7C, 0xAF, 0xD7, 0x4B, 0xA5, 0x52, 0xED, 0x76, 0x9B, 0xA3, 0x4E, 0x44, 0x92, 0x4E, 0xA7, 0xFB, 0xFD, 0xBE, 0xE0,
+			0xE0, 0xF2, 0xF3, 0x4E, 0xA7, 0xB3, 0xBF, 0x82, 0x35, 0x9D, 0x4E, 0x07, 0x0F, 0xA8, 0xB8, 0xA4, 0xD7, 0xEB, 0x1D, 0x99,
+			0xDE, 0x7D, 0x7F, 0xFF, 0x6B, 0x27, 0xC3, 0xC3, 0xC5, 0x62, 0xF1, 0xFB, 0xBD, 0x57, 0x08, 0xB7, 0x1F, 0xD3, 0x1E, 0xFE,
+			0x67, 0xE5, 0x67, 0xDE, 0xD6, 0xDE, 0xFF, 0xDD, 0xCA, 0xB3, 0x57, 0xF3, 0x9F, 0x3E, 0x99, 0x3A, 0xC6, 0x13, 0xBE, 0x92,
+			0xBB, 0x69, 0x59, 0xD6, 0x59, 0xFD, 0x72, 0x18, 0x86, 0xE5, 0x8F, 0x72, 0x3B, 0x3B, 0x3B, 0xB6, 0x6D, 0x1F, 0xF7, 0x1F,
+			0xC0, 0x6C, 0x3D, 0x93, 0x3E, 0x89, 0x43, 0x2A, 0x97, 0xCB, 0xB6, 0x6D, 0x4B, 0xE4, 0x9B, 0xCB, 0xE5, 0xEE, 0xEB, 0x7D,
+			0x35, 0x9D, 0x4E, 0xE3, 0x63, 0xDE, 0x31, 0xAE, 0x7C, 0x58, 0xFA, 0x69, 0xB5, 0x5A, 0xE5, 0x49, 0xB2, 0x34, 0x4F, 0x59,
+			0x8F, 0xCE, 0x66, 0xB3, 0xA7, 0xED, 0x13, 0xEF, 0x67, 0x3E, 0x7E, 0xC7, 0xB8, 0x52, 0xA9, 0x54, 0x2C, 0xCB, 0x3A, 0x32,
+			0xBD, 0xFB, 0x99, 0xF4, 0xC9, 0x8F, 0xCA, 0x5F, 0xB8, 0xAE, 0xBB, 0xB3, 0xB3, 0x53, 0x28, 0x14, 0x5E, 0x9E, 0x9F, 0x7D,
+			0x60, 0xF0, 0xF5, 0x5E, 0xAF, 0x07, 0x02, 0x8E, 0xD1, 0xFF, 0xCE, 0x53, 0x4F, 0xC9, 0xAE, 0x20, 0xDB, 0x23, 0xAE, 0xEF,
+			0xF7, 0xFB, 0xB2, 0x61, 0x30, 0xB8, 0xE4, 0x49, 0xAC, 0xBB, 0x93, 0xBC, 0x64, 0x2F, 0xA5, 0x52, 0xA9, 0x67, 0x4E, 0x9E,
+			0x64, 0x5B, 0x4A, 0x49, 0x00, 0x28, 0xF9, 0xDB, 0x4F, 0x3E, 0x49, 0x70, 0x20, 0x75, 0x2F, 0x4C, 0xC9, 0x68, 0x34, 0x92,
+			0x7A, 0x83, 0xD8, 0x3B, 0xFC, 0x0D, 0x1F, 0x08, 0xAC, 0x2F, 0x00, 0xEC, 0x33, 0x27, 0x4F, 0x4A, 0x66, 0x40, 0xD1, 0xB1,
+			0xDB, 0xED, 0x8E, 0x46, 0x23, 0xAE, 0x6D, 0x36, 0x9B, 0xD5, 0xEB, 0x75, 0x4E, 0x44, 0xB1, 0x58, 0x94, 0x7C, 0x05, 0x37,
+			0xB6, 0x58, 0x2C, 0x0A, 0x85, 0x02, 0xB8, 0x6E, 0xA7, 0xD3, 0x19, 0x0E, 0x87, 0xFD, 0x7E, 0x9F, 0x60, 0x05, 0xD4, 0x97,
+			0x6F, 0xE4, 0x44, 0xE7, 0x72, 0x39, 0x56, 0x99, 0xA3, 0x47, 0x86, 0xBD, 0x58, 0x2C, 0x3C, 0xCF, 0x23, 0x3C, 0x07, 0xDE,
+			0xE1, 0x46, 0x88, 0xFA, 0xD9, 0x57, 0x40, 0x11, 0xBD, 0xE4, 0x95, 0xCF, 0xE7, 0xC9, 0xB4, 0x82, 0x20, 0x20, 0xBB, 0xB5,
+			0x2C, 0x8B, 0xC8, 0x1A, 0xC8, 0x3E, 0x0C, 0xC3, 0xC5, 0x62, 0x31, 0x9D, 0x4E, 0x2D, 0xCB, 0x02, 0x3D, 0x4E, 0xA5, 0x52,
+			0xFD, 0x7E, 0x1F, 0x03, 0x8D, 0x0F, 0x00, 0x6D, 0x73, 0x1C, 0x27, 0x93, 0xBC, 0x26, 0x93, 0x89, 0x32, 0xBE, 0xC9, 0xB9,
+			0x8E, 0xE3, 0x78, 0x30, 0x18, 0x14, 0x0A, 0x05, 0x82, 0x7A, 0x4A, 0x08, 0x98, 0x97, 0x54, 0x2A, 0x35, 0x9B, 0xCD, 0x86,
+			0xC3, 0xE1, 0x68, 0x34, 0xEA, 0x76, 0xBB, 0x40, 0xB8, 0x20, 0x2B, 0x7A, 0x82, 0xD6, 0xE2, 0xBF, 0xC3, 0x30, 0x04, 0x4E,
+			0x27, 0x94, 0xC7, 0x13, 0x00, 0xAB, 0xF0, 0x48, 0x71, 0xBD, 0xA4, 0x0E, 0xBD, 0x5E, 0x6F, 0x3E, 0x9F, 0xF3, 0x00, 0x95,
+			0x47, 0xC7, 0xE1, 0x03, 0xF8, 0xE0, 0x46, 0xEA, 0xF5, 0x3A, 0x08, 0x78, 0x3E, 0x9F, 0x8F, 0xA2, 0xA8, 0xD7, 0xEB, 0x01,
+			0xC5, 0x62, 0xBB, 0x49, 0xAC, 0xE6, 0xF3, 0x39, 0x81, 0x1B, 0xE9, 0x3F, 0x78, 0x45, 0xB1, 0x58, 0x54, 0xD9, 0x7B, 0x12,
+			0x62, 0x60, 0x82, 0x89, 0x05, 0x00, 0x25, 0xC0, 0x1F, 0x7D, 0xDF, 0xA7, 0xC6, 0x30, 0x18, 0x0C, 0x7C, 0xDF, 0x1F, 0x0E,
+			0x87, 0x5C, 0x2B, 0x10, 0x0D, 0x98, 0x09, 0x2E, 0x01, 0xDC, 0x2A, 0x9D, 0x4E, 0x7B, 0x9E, 0xC7, 0xE3, 0xC3, 0xD3, 0xCA,
+			0x3E, 0x03, 0x4E, 0x21, 0xBC, 0x6D, 0x34, 0x1A, 0xCD, 0x66, 0x33, 0x8A, 0xA2, 0xF9, 0x7C, 0x3E, 0x9B, 0xCD, 0x96, 0xCB,
+			0xE5, 0x78, 0x3C, 0x06, 0xFF, 0xC5, 0x8E, 0x2F, 0x16, 0x0B, 0x05, 0x17, 0x26, 0xBB, 0x73, 0x36, 0x9B, 0x65, 0x32, 0x99,
+			0x6C, 0x36, 0x0B, 0x6A, 0xB4, 0x1F, 0xCE, 0x72, 0x5D, 0x17, 0x3B, 0x82, 0x1B, 0x93, 0x40, 0x78, 0x65, 0x65, 0x65, 0x30,
+			0x18, 0xF4, 0xFB, 0xFD, 0xDB, 0xB7, 0x6F, 0x57, 0xAB, 0x55, 0xD2, 0x37, 0x42, 0x48, 0x09, 0x57, 0x49, 0x35, 0xE2, 0x38,
+			0x1E, 0x0E, 0x87, 0xD4, 0xA8, 0x47, 0xA3, 0x11, 0x1B, 0x5D, 0x61, 0x85, 0x89, 0x81, 0x06, 0xFA, 0x27, 0xCF, 0xE5, 0x9D,
+			0x41, 0x10, 0xFC, 0xB2, 0xBB, 0xC8, 0xA7, 0xF5, 0x8C, 0x19, 0x15, 0x1C, 0x63, 0x67, 0xAA, 0x37, 0x27, 0x5A, 0x25, 0x67,
+			0x67, 0xCC, 0xA8, 0x94, 0x31, 0xDB, 0xF3, 0x54, 0xD6, 0x8A, 0x73, 0xB6, 0x96, 0xB5, 0xE2, 0xF6, 0x3C, 0xD5, 0x9A, 0x29,
+			0x77, 0x55, 0xCA, 0x98, 0x05, 0xC7, 0xB8, 0x3D, 0x08, 0xB2, 0x56, 0x6C, 0xC5, 0x8B, 0x9C, 0xAD, 0xF1, 0x57, 0xF9, 0xB4,
+			0x9E, 0xB5, 0xE2, 0xAC, 0x15, 0x77, 0x3C, 0xF5, 0x86, 0xAC, 0x15, 0xBB, 0x29, 0xB5, 0xE3, 0xB7, 0xC7, 0x71, 0x3E, 0xAD,
+			0xA7, 0xF5, 0x65, 0x39, 0x6B, 0x0D, 0x02, 0x27, 0x63, 0x46, 0x39, 0x5B, 0xAB, 0x16, 0x9C, 0xD6, 0xCC, 0x68, 0xCD, 0x8C,
+			0xAC, 0x15, 0x57, 0x72, 0xB6, 0x15, 0x2F, 0x5A, 0x33, 0xC3, 0x8A, 0x55, 0x42, 0x96, 0xB3, 0xB5, 0xED, 0x71, 0x3C, 0x08,
+			0x9C, 0x9C, 0xAD, 0x39, 0x46, 0xE0, 0x18, 0xC1, 0xAF, 0x7A, 0x7E, 0xD6, 0x8A, 0x4B, 0x19, 0x33, 0x67, 0x6B, 0xCD, 0x89,
+			0xD6, 0x5D, 0x58, 0x5C, 0xD5, 0x4A, 0x5E, 0xE5, 0x07, 0x8E, 0xE3, 0xF4, 0x7A, 0xBD, 0x6A, 0xB5, 0x8A, 0x89, 0xCF, 0x66,
+			0xB3, 0xF3, 0xF9, 0x9C, 0xC7, 0x5B, 0x2A, 0x95, 0x28, 0xD5, 0x90, 0xB4, 0x0D, 0x06, 0x03, 0x01, 0x15, 0xC9, 0x6A, 0xA5,
+			0x5A, 0x5B, 0xA9, 0x54, 0x06, 0x83, 0x41, 0xB9, 0x5C, 0x1E, 0x8F, 0xC7, 0x3B, 0x3B, 0x3B, 0x64, 0xDC, 0xDD, 0x6E, 0x17,
+			0x7A, 0xC2, 0x78, 0x3C, 0xD6, 0x75, 0x7D, 0x36, 0x9B, 0xAD, 0xAE, 0xAE, 0xB2, 0xCA, 0xCB, 0xE5, 0x92, 0x14, 0x4D, 0x4C,
+			0x09, 0x96, 0x6E, 0x67, 0x67, 0x67, 0x3C, 0x1E, 0xC3, 0x59, 0xF0, 0x3C, 0xAF, 0x52, 0xA9, 0xA8, 0xE2, 0x4A, 0x72, 0x44,
+			0xC1, 0x79, 0x08, 0x81, 0x49, 0x4C, 0xB3, 0xD9, 0x6C, 0xF1, 0x03, 0x95, 0x92, 0x7F, 0xBA, 0xFA, 0x77, 0xA4, 0x17, 0x86,
+			0x61, 0x9C, 0x8A, 0x1E, 0x3A, 0x19, 0x1E, 0xFE, 0xD1, 0xF8, 0x0D, 0x72, 0x7C, 0x5D, 0xD7, 0x9F, 0x30, 0x8E, 0x06, 0x41,
+			0x70, 0x6F, 0xF7, 0x77, 0x4C, 0xD3, 0xFC, 0xAB, 0xE1, 0x6B, 0x04, 0x0A, 0x87, 0x86, 0x77, 0x38, 0x8E, 0x73, 0x39, 0xFB,
+			0x89, 0xAE, 0xEB, 0x37, 0x56, 0x7E, 0x7E, 0xDE, 0xFA, 0xE0, 0x4A, 0xEE, 0xE6, 0x23, 0xF3, 0x7B, 0x7B, 0xBD, 0x5E, 0x10,
+			0x04, 0x83, 0xC1, 0xE0, 0xA3, 0xF2, 0x17, 0xFD, 0x7E, 0xBF, 0xDB, 0xED, 0x8E, 0xC7, 0xE3, 0x76, 0xBB, 0x7D, 0x2A, 0x7A,
+			0x68, 0x7B, 0x7B, 0x3B, 0x9B, 0xCD, 0x4E, 0xA7, 0xD3, 0xD3, 0xF1, 0x91, 0xC9, 0x64, 0xF2, 0x4C, 0xFA, 0xA4, 0x80, 0xE3,
+			0x3F, 0x9E, 0xBE, 0x45, 0x2E, 0x42, 0xAD, 0xAB, 0xD3, 0xE9, 0x9C, 0x08, 0x1E, 0xFC, 0xC3, 0xD5, 0x6F, 0x13, 0x9C, 0xE1,
+			0x06, 0xE6, 0xF3, 0xF9, 0xA9, 0xE8, 0xA1, 0xAB, 0xF9, 0x4F, 0x53, 0xA9, 0xD4, 0x19, 0xF3, 0xF8, 0x07, 0xC5, 0xCF, 0x39,
+			0x71, 0xA4, 0x83, 0xB6, 0x6D, 0xBF, 0x7C, 0xF6, 0x2C, 0x01, 0x0D, 0x27, 0x0E, 0xAF, 0xD0, 0x6E, 0xB7, 0x89, 0x04, 0x25,
+			0x4B, 0xC0, 0x61, 0x4B, 0x18, 0x44, 0xE8, 0x20, 0x41, 0x74, 0xA7, 0xD3, 0xF9, 0xFE, 0x2B, 0xAF, 0x00, 0x00, 0x12, 0xCC,
+			0xF2, 0x6C, 0x83, 0x20, 0x78, 0xF1, 0xCD, 0x37, 0x41, 0x39, 0x30, 0x2B, 0x9C, 0x6E, 0x42, 0x40, 0xEC, 0x3E, 0x0E, 0x86,
+			0x9A, 0x27, 0x91, 0x29, 0x15, 0x4E, 0xCC, 0x31, 0xA5, 0xA0, 0xC1, 0x60, 0xF0, 0x97, 0xAF, 0xBE, 0x8A, 0x53, 0x11, 0x58,
+			0xB2, 0x50, 0x28, 0xAC, 0xAC, 0xAC, 0xE0, 0x9B, 0xA9, 0x25, 0x60, 0x5E, 0x28, 0x07, 0x8E, 0xC7, 0x63, 0xC7, 0x71, 0xA6,
+			0xD3, 0xA9, 0x6D, 0xDB, 0x85, 0x42, 0x81, 0xB0, 0x8F, 0x22, 0x07, 0x71, 0x31, 0xA9, 0x1B, 0xBE, 0x19, 0x33, 0x45, 0x68,
+			0x9C, 0xC9, 0x64, 0xD8, 0x5A, 0xEA, 0xCE, 0x93, 0xD3, 0x0A, 0xCA, 0x44, 0x84, 0xB4, 0xB3, 0xB3, 0x33, 0x9B, 0xCD, 0xC8,
+			0x18, 0xB8, 0xA3, 0xE1, 0x70, 0x08, 0x1E, 0x0E, 0xDE, 0x0B, 0xE6, 0x8E, 0x09, 0xCA, 0x64, 0x32, 0xB6, 0x6D, 0xE3, 0x1E,
+			0x70, 0x78, 0x04, 0x94, 0x40, 0x91, 0x04, 0xE9, 0x20, 0xFE, 0xD4, 0x0E, 0x0B, 0x85, 0x02, 0x5B, 0x77, 0x32, 0x99, 0xE4,
+			0xF3, 0x79, 0xA9, 0xA5, 0x63, 0xE5, 0x33, 0x99, 0x0C, 0xE5, 0x2B, 0x12, 0x65, 0xAE, 0x0A, 0xA0, 0x05, 0xD3, 0x44, 0x8E,
+			0x45, 0xAA, 0x0D, 0x34, 0x52, 0xAB, 0xA9, 0x6A, 0x04, 0x46, 0x83, 0x8C, 0xA4, 0xDF, 0xEF, 0x53, 0x2B, 0xC5, 0x42, 0xE2,
+			0xB4, 0x08, 0x43, 0x09, 0xD4, 0x96, 0xCB, 0x65, 0x3E, 0x9F, 0x17, 0x9C, 0x8A, 0xB5, 0x06, 0x08, 0x01, 0xA5, 0x57, 0x8E,
+			0x01, 0x4F, 0xD8, 0xED, 0x76, 0xA9, 0xC8, 0xF1, 0x97, 0x83, 0xC1, 0x20, 0x9B, 0xCD, 0x8E, 0xC7, 0x63, 0x1E, 0x2B, 0xD1,
+			0x04, 0x67, 0x09, 0x60, 0x81, 0x45, 0x5A, 0x2E, 0x97, 0xC4, 0x38, 0x52, 0xBB, 0x20, 0xD3, 0xA4, 0x0C, 0x20, 0xF0, 0x53,
+			0x3A, 0x9D, 0xA6, 0x24, 0x50, 0x28, 0x14, 0x78, 0x88, 0x58, 0x0D, 0x30, 0xFD, 0xD9, 0x6C, 0x46, 0x92, 0x22, 0x54, 0x1C,
+			0x42, 0x66, 0xC7, 0x71, 0xC0, 0x9A, 0xC9, 0x34, 0x53, 0xA9, 0x14, 0x30, 0x0E, 0xA5, 0x18, 0x41, 0x24, 0x08, 0x0C, 0x61,
+			0x2B, 0xD5, 0xEB, 0xF5, 0x6C, 0x36, 0xEB, 0x38, 0x8E, 0x04, 0x92, 0xCB, 0xE5, 0x92, 0x67, 0x47, 0x2E, 0x4C, 0xC8, 0x53,
+			0x2E, 0x97, 0x29, 0x23, 0x83, 0xE0, 0x13, 0x89, 0xF3, 0x94, 0xA9, 0xAC, 0x72, 0x60, 0x48, 0x30, 0x29, 0x21, 0x92, 0x1B,
+			0xAD, 0xAC, 0xAC, 0x94, 0x4A, 0x25, 0xF6, 0x07, 0x1E, 0xD8, 0xF7, 0xFD, 0x5C, 0x2E, 0x87, 0x15, 0x03, 0x4B, 0x95, 0x1C,
+			0xAD, 0x5E, 0xAF, 0x83, 0xE7, 0xA4, 0x52, 0xA9, 0xB5, 0xB5, 0xB5, 0x5C, 0xF2, 0x22, 0x48, 0x21, 0x0B, 0xE6, 0x62, 0x08,
+			0xA6, 0x08, 0xB7, 0x81, 0x65, 0xD9, 0xC1, 0x1C, 0x06, 0xE2, 0x71, 0x49, 0x36, 0x89, 0x86, 0x38, 0xE4, 0x42, 0xBE, 0xE2,
+			0x09, 0x4B, 0xBE, 0x2F, 0x10, 0x19, 0x41, 0xE8, 0xFE, 0xE3, 0x0A, 0xD6, 0x0F, 0x80, 0xCE, 0xA6, 0x27, 0x12, 0x94, 0xC2,
+			0x20, 0x31, 0x1A, 0x61, 0x1D, 0x6F, 0xE3, 0xDF, 0x18, 0x02, 0x0E, 0xB6, 0xF0, 0x05, 0x84, 0x07, 0x85, 0x07, 0xF5, 0x3C,
+			0xAF, 0x5C, 0x2E, 0x0F, 0x87, 0x43, 0x62, 0x10, 0x4A, 0x79, 0x86, 0x61, 0xF0, 0xC4, 0xF8, 0xBA, 0x4C, 0x26, 0x43, 0xDE,
+			0x46, 0xA8, 0xA1, 0x9C, 0x59, 0x92, 0xD2, 0xE1, 0x1A, 0xE7, 0xF3, 0x39, 0x58, 0x4D, 0xAD, 0x56, 0x2B, 0x14, 0x0A, 0x8D,
+			0x46, 0x03, 0xF4, 0xA9, 0x52, 0xA9, 0x10, 0x0D, 0x59, 0x96, 0x2A, 0xD0, 0xD5, 0x6A, 0xB5, 0xD1, 0x68, 0x44, 0x85, 0xB3,
+			0x5E, 0xAF, 0x57, 0xAB, 0x55, 0x22, 0x20, 0x29, 0x32, 0xE1, 0x6C, 0xA8, 0xE5, 0x80, 0xE9, 0x8D, 0x46, 0x23, 0x42, 0xAD,
+			0x46, 0xA3, 0x41, 0xB9, 0x8B, 0x3B, 0x22, 0x51, 0x5B, 0x5F, 0x5F, 0xBF, 0xAF, 0xF7, 0xD5, 0x7C, 0x3E, 0xFF, 0x83, 0xC1,
+			0x4F, 0x9A, 0xCD, 0x66, 0xAB, 0xD5, 0xD2, 0x75, 0xFD, 0x69, 0xFB, 0xC4, 0x74, 0x3A, 0x9D, 0x4C, 0x26, 0x14, 0x21, 0x5F,
+			0x0F, 0xDE, 0xFB, 0xAC, 0xF1, 0x0B, 0xB6, 0xD0, 0x6C, 0x36, 0x7B, 0x70, 0x74, 0x67, 0x2E, 0x97, 0x3B, 0xEA, 0xDD, 0x77,
+			0x77, 0xFB, 0xCB, 0x8B, 0xC5, 0xE2, 0x99, 0xF4, 0x49, 0xC3, 0x30, 0x86, 0xC3, 0xE1, 0x25, 0xF7, 0x86, 0x69, 0x9A, 0x17,
+			0x9D, 0xEB, 0x1F, 0x95, 0xBF, 0x38, 0x19, 0x1E, 0x76, 0x5D, 0xF7, 0x7A, 0xE5, 0x67, 0x5F, 0x6C, 0xFC, 0x03, 0xF6, 0xE2,
+			0x85, 0xC2, 0x99, 0xE1, 0x70, 0x78, 0xF0, 0xE0, 0xC1, 0xB7, 0xB5, 0xF7, 0xDF, 0xD6, 0xDE, 0x9F, 0xCF, 0xE7, 0x77, 0x36,
+			0xBF, 0x84, 0xBD, 0x38, 0x63, 0x1E, 0x9F, 0xCD, 0x66, 0x20, 0x00, 0x02, 0x7C, 0x75, 0x3A, 0x9D, 0xB3, 0xFA, 0xE5, 0xF1,
+			0x78, 0xFC, 0xA3, 0xF1, 0x1B, 0x98, 0xD4, 0x77, 0xCD, 0x6B, 0x83, 0xC1, 0x80, 0xB0, 0x94, 0xF2, 0x1B, 0xFB, 0x04, 0x90,
+			0x81, 0xF5, 0x05, 0x09, 0x21, 0xB8, 0x1E, 0x0C, 0x06, 0xCA, 0x78, 0xEF, 0x41, 0xED, 0xFB, 0x6B, 0xB0, 0x82, 0xED, 0x60,
+			0x20, 0x58, 0xCD, 0x5E, 0xAF, 0xC7, 0xF6, 0x93, 0x4A, 0x80, 0xC4, 0x70, 0x04, 0x46, 0x52, 0x34, 0x66, 0x1B, 0x70, 0xF1,
+			0x80, 0x90, 0xB2, 0x55, 0x84, 0x7A, 0xC4, 0x9F, 0x2B, 0xDE, 0x41, 0x72, 0x58, 0x74, 0x5D, 0xEF, 0xF5, 0x7A, 0x9C, 0x6B,
+			0xA9, 0x4C, 0x60, 0x7F, 0x08, 0xE4, 0x71, 0x0F, 0xE5, 0x72, 0x59, 0xB8, 0x73, 0x04, 0x7C, 0x52, 0x86, 0xE4, 0x33, 0x4B,
+			0xA5, 0x12, 0x9E, 0x80, 0x53, 0xE3, 0x79, 0x1E, 0x35, 0xB9, 0xD1, 0x68, 0x44, 0xB5, 0x8C, 0x6B, 0xCE, 0x66, 0xB3, 0x64,
+			0x81, 0x60, 0xB6, 0x58, 0x6D, 0xF0, 0x16, 0x15, 0xF9, 0x2A, 0x8F, 0x60, 0x50, 0x9E, 0x04, 0x20, 0xAD, 0x54, 0x2A, 0xC0,
+			0x56, 0xEC, 0x10, 0xC0, 0x7D, 0xA1, 0xA8, 0x41, 0xFA, 0x50, 0x0C, 0xAE, 0x24, 0x02, 0x86, 0xA2, 0x22, 0xDF, 0x05, 0x9C,
+			0x0B, 0x5E, 0x0A, 0xCA, 0x44, 0x92, 0x41, 0x56, 0x8D, 0x65, 0xE7, 0x4B, 0xB1, 0x48, 0xA4, 0x4D, 0x14, 0x2F, 0xC9, 0x15,
+			0xF0, 0x55, 0x7C, 0x02, 0x5E, 0x9F, 0x8C, 0x81, 0x43, 0x91, 0xC9, 0x64, 0x7A, 0xBD, 0x1E, 0x8C, 0x18, 0x96, 0x58, 0x55,
+			0x51, 0x12, 0x3C, 0x83, 0x14, 0x90, 0xA3, 0x01, 0x0F, 0xC2, 0x75, 0x5D, 0x32, 0x9E, 0x95, 0x95, 0x15, 0x3E, 0xD0, 0x34,
+			0xCD, 0x6A, 0xB5, 0x8A, 0x13, 0x62, 0x3F, 0x13, 0x4C, 0xAB, 0xE0, 0x15, 0xCF, 0x4F, 0xAA, 0x95, 0xCF, 0xE7, 0x41, 0x87,
+			0x1D, 0xC7, 0xA1, 0xAC, 0x37, 0x9D, 0x4E, 0xF3, 0xF9, 0x3C, 0xE9, 0x30, 0xDB, 0x0E, 0x14, 0x05, 0x82, 0x0D, 0x91, 0xFB,
+			0x74, 0x3A, 0x2D, 0x97, 0xCB, 0x60, 0x67, 0x10, 0x3F, 0xF0, 0x07, 0x78, 0x45, 0x1C, 0x06, 0x65, 0x5B, 0xEA, 0xAE, 0xB2,
+			0x2D, 0x52, 0xA9, 0x54, 0xAF, 0xD7, 0x03, 0xED, 0x01, 0xC9, 0xE1, 0xC0, 0x03, 0x41, 0xEA, 0xBA, 0x5E, 0x2E, 0x97, 0x49,
+			0xE8, 0xF2, 0xF9, 0x3C, 0x79, 0x9F, 0xE3, 0x38, 0xE5, 0x72, 0xB9, 0xDB, 0xED, 0x92, 0x21, 0x4A, 0x1D, 0x72, 0x3E, 0x9F,
+			0x57, 0x2A, 0x95, 0xED, 0xED, 0x6D, 0xC0, 0x90, 0x5A, 0xAD, 0x86, 0xBB, 0xE3, 0xC3, 0xD9, 0x19, 0xC9, 0x7E, 0x56, 0x51,
+			0x24, 0xC4, 0x2C, 0x2A, 0xF2, 0x60, 0x79, 0x24, 0x71, 0xEC, 0xE0, 0x6A, 0xB5, 0x9A, 0x4E, 0xA7, 0x8B, 0xC5, 0x62, 0xA7,
+			0xD3, 0xC9, 0x66, 0xB3, 0x5B, 0x5B, 0x5B, 0x18, 0x59, 0x40, 0x3D, 0xAC, 0x24, 0x55, 0x1D, 0x08, 0x7C, 0x18, 0x11, 0x1C,
+			0x32, 0xB7, 0xC9, 0xA3, 0xC7, 0x66, 0x01, 0xCB, 0x0E, 0x06, 0x03, 0xC2, 0x0D, 0x80, 0xA0, 0x5C, 0x2E, 0x47, 0x5D, 0x9D,
+			0x34, 0x10, 0xEC, 0x55, 0x50, 0x17, 0xC1, 0xA6, 0xB8, 0x3B, 0x39, 0x8D, 0x98, 0x36, 0x4C, 0x3C, 0x6E, 0x46, 0xE8, 0x71,
+			0x60, 0xF4, 0x72, 0xB0, 0x85, 0x75, 0xC7, 0x27, 0x50, 0x09, 0x87, 0xF8, 0x41, 0x92, 0x8E, 0xEF, 0xE1, 0x48, 0x13, 0x0D,
+			0x71, 0xEC, 0xC1, 0x4F, 0x88, 0x28, 0x39, 0xC6, 0x18, 0x6E, 0x9E, 0x12, 0x38, 0x29, 0x51, 0x0F, 0xD5, 0x63, 0xF6, 0x22,
+			0xC4, 0x9E, 0xD1, 0x68, 0x84, 0xCB, 0xE7, 0xE9, 0xA5, 0x52, 0x29, 0xEA, 0xE1, 0x78, 0x71, 0x72, 0x5E, 0x90, 0x5C, 0xF1,
+			0xBB, 0xAE, 0xEB, 0x52, 0x3B, 0xC1, 0x3A, 0x17, 0x8B, 0x45, 0xD2, 0x44, 0x4A, 0x58, 0x83, 0xC1, 0x80, 0xBB, 0x1E, 0x8D,
+			0x46, 0xEB, 0xEB, 0xEB, 0x93, 0xC9, 0x84, 0x27, 0x83, 0xB9, 0x09, 0xC3, 0x70, 0x7D, 0x7D, 0x1D, 0xD4, 0xD5, 0x30, 0x0C,
+			0x21, 0x5D, 0x60, 0xC8, 0xC8, 0x62, 0x8B, 0xC5, 0x22, 0xDE, 0x85, 0xCA, 0xD3, 0x68, 0x34, 0x02, 0xF2, 0x62, 0xC9, 0xE0,
+			0x86, 0x69, 0x9A, 0xF6, 0xDA, 0xF2, 0x7C, 0x3E, 0x9F, 0xCF, 0x64, 0x32, 0x4F, 0xDB, 0x27, 0x88, 0x15, 0xAE, 0x15, 0x3E,
+			0x7B, 0x75, 0x71, 0x8E, 0x2B, 0xA7, 0x66, 0xF8, 0xE0, 0xE8, 0xCE, 0xE3, 0xFE, 0x03, 0xD7, 0x0A, 0x9F, 0x9D, 0x08, 0x1E,
+			0x7C, 0x23, 0xBC, 0x40, 0x29, 0xEF, 0xBC, 0xF5, 0x01, 0xC1, 0xC4, 0x2B, 0xDE, 0x3B, 0xF3, 0xF9, 0xFC, 0x31, 0xED, 0x61,
+			0xD3, 0x34, 0xAF, 0xE6, 0x3F, 0x9D, 0x4E, 0xA7, 0x0F, 0x0C, 0xBE, 0xFE, 0xFD, 0xDE, 0x2B, 0xC3, 0xE1, 0xF0, 0xC9, 0xD4,
+			0xB1, 0xFB, 0x7A, 0x5F, 0x5D, 0x59, 0x59, 0xE9, 0x76, 0xBB, 0xF9, 0x7C, 0x9E, 0x2D, 0x1D, 0xC7, 0xF1, 0x3F, 0x2B, 0x3F,
+			0xA3, 0xEB, 0xFA, 0x3B, 0xC6, 0x15, 0xDF, 0xF7, 0x31, 0x0D, 0x18, 0xBE, 0x37, 0xC2, 0x0B, 0x96, 0x65, 0xBD, 0x63, 0x5C,
+			0xD1, 0x34, 0xED, 0x5A, 0xE1, 0xB3, 0xDF, 0x5B, 0x79, 0xCE, 0x71, 0x9C, 0xA7, 0xED, 0x13, 0xBD, 0x5E, 0xEF, 0x3B, 0xC5,
+			0xA7, 0xBE, 0x99, 0x79, 0x8C, 0x8A, 0xD4, 0x7C, 0x3E, 0x7F, 0x26, 0x7D, 0xF2, 0xA2, 0x73, 0x7D, 0x7F, 0x89, 0x55, 0x8A,
+			0xC0, 0x44, 0x0C, 0x60, 0x95, 0xD1, 0xDE, 0x4B, 0xF6, 0x4F, 0x62, 0x2E, 0x2C, 0x31, 0xEB, 0x00, 0x20, 0xF8, 0x60, 0x59,
+			0xF7, 0xE4, 0x63, 0x76, 0x0B, 0x84, 0xC4, 0x07, 0x78, 0x14, 0x4A, 0x83, 0x44, 0x2A, 0x14, 0x36, 0xC1, 0x03, 0xD9, 0x84,
+			0xE2, 0x30, 0xD8, 0x24, 0x78, 0x62, 0x32, 0x0C, 0x72, 0x65, 0xA1, 0x24, 0x70, 0xAE, 0x31, 0x0E, 0x70, 0x7E, 0xB0, 0xBF,
+			0xE0, 0x3C, 0xA9, 0x54, 0x6A, 0x94, 0xBC, 0x88, 0x1E, 0x80, 0xFE, 0xC9, 0x5D, 0x04, 0x4D, 0x02, 0xD5, 0x1C, 0x8D, 0x46,
+			0x18, 0x0D, 0x28, 0x8B, 0x92, 0x2F, 0x42, 0xFF, 0x2B, 0x97, 0xCB, 0x7C, 0x97, 0xE7, 0x79, 0xA5, 0x52, 0xA9, 0x5C, 0x2E,
+			0x53, 0x17, 0x05, 0xBE, 0x76, 0x1C, 0x87, 0x6B, 0x06, 0x84, 0xD0, 0x34, 0xAD, 0x5E, 0xAF, 0xF7, 0x7A, 0x3D, 0x0C, 0xBD,
+			0x6D, 0xDB, 0xAA, 0xF0, 0x98, 0x40, 0x23, 0x7B, 0xA0, 0x9A, 0x8A, 0xB4, 0xD2, 0xE9, 0x34, 0xDC, 0x13, 0xC7, 0x71, 0x06,
+			0x83, 0x01, 0xC1, 0x07, 0x01, 0xC7, 0x7E, 0x16, 0x06, 0x61, 0x6B, 0xA5, 0x52, 0x99, 0x4E, 0xA7, 0x3B, 0x3B, 0x3B, 0x20,
+			0x57, 0xE3, 0xF1, 0x18, 0xFF, 0x0D, 0xE9, 0x88, 0x43, 0x97, 0xCD, 0x66, 0xA9, 0x01, 0xC0, 0xBF, 0x07, 0xCE, 0xE2, 0xA1,
+			0x91, 0x2E, 0x2F, 0x97, 0x4B, 0x30, 0x18, 0xCA, 0x54, 0xA4, 0x08, 0x3C, 0x73, 0xAC, 0x19, 0xD1, 0x18, 0x28, 0xFF, 0x78,
+			0x3C, 0xEE, 0x74, 0x3A, 0x3C, 0xD2, 0xC9, 0x64, 0xD2, 0x6A, 0xB5, 0xE0, 0x5B, 0x02, 0x69, 0x48, 0x1E, 0x46, 0xF1, 0x43,
+			0x2D, 0x34, 0x8F, 0x0F, 0x00, 0x8E, 0x38, 0x9A, 0xB5, 0x21, 0x66, 0x27, 0xF1, 0x21, 0xFE, 0x8D, 0xA2, 0x08, 0x46, 0x23,
+			0xB6, 0x35, 0xD9, 0xC6, 0xBB, 0xF5, 0xDB, 0x76, 0xBB, 0xDD, 0xEF, 0xF7, 0xA7, 0xD3, 0x29, 0xB9, 0x18, 0x81, 0x12, 0xF7,
+			0x8C, 0xC7, 0x16, 0x26, 0x09, 0x58, 0x15, 0x45, 0x70, 0x0A, 0xA4, 0x10, 0x34, 0x2B, 0x95, 0x0A, 0xFC, 0x9F, 0x4A, 0xA5,
+			0x42, 0x92, 0x4E, 0x3E, 0x21, 0x6C, 0x7D, 0xD2, 0x02, 0x8C, 0x1A, 0x79, 0x34, 0xE1, 0x24, 0x29, 0x73, 0xB9, 0x5C, 0xA6,
+			0x70, 0xC4, 0xDD, 0x42, 0x47, 0xA3, 0x7E, 0x40, 0x21, 0x1A, 0x26, 0x00, 0xF8, 0xBB, 0x14, 0xA0, 0x66, 0xB3, 0x19, 0x06,
+			0x08, 0xAF, 0xB8, 0xB3, 0xB3, 0x33, 0x18, 0x0C, 0x28, 0xC0, 0x72, 0x0B, 0x78, 0x5A, 0x0A, 0x09, 0x24, 0x2E, 0xD0, 0xAD,
+			0xC0, 0xF5, 0xFA, 0xFD, 0xBE, 0x6D, 0xDB, 0xD4, 0x1E, 0x31, 0x7C, 0xEC, 0xD1, 0xD9, 0x6C, 0x76, 0xEB, 0xD6, 0xAD, 0x5C,
+			0x2E, 0x87, 0x79, 0xDA, 0xDE, 0xDE, 0x4E, 0x7C, 0xB0, 0x5A, 0x1E, 0xCF, 0xF3, 0xB0, 0xC5, 0xB5, 0x5A, 0xCD, 0x71, 0x9C,
+			0x6E, 0xB7, 0xCB, 0xD7, 0x49, 0x6D, 0x76, 0x3F, 0x69, 0x5D, 0x02, 0x2E, 0xF5, 0xC7, 0x49, 0x1D, 0x0C, 0xA3, 0x4C, 0xF6,
+			0xC3, 0xC9, 0xC4, 0x40, 0x93, 0x98, 0xD3, 0x6D, 0x80, 0x0B, 0x01, 0x9D, 0x14, 0x6A, 0xA0, 0x1C, 0x0F, 0x62, 0x1C, 0x52,
+			0x6F, 0x2C, 0xC5, 0xFE, 0xC4, 0x59, 0x5C, 0x75, 0xBC, 0xF7, 0x12, 0xD4, 0x58, 0x82, 0x1D, 0x41, 0x78, 0x31, 0x25, 0xBE,
+			0xEF, 0x37, 0x1A, 0x8D, 0xAD, 0xAD, 0x2D, 0x10, 0x46, 0x70, 0x55, 0xAA, 0x3B, 0xF2, 0x5D, 0xD5, 0x6A, 0x35, 0x09, 0x52,
+			0x53, 0xF8, 0x75, 0xDF, 0xF7, 0xF1, 0x28, 0x20, 0x03, 0x80, 0x33, 0x80, 0x3F, 0xE5, 0x72, 0xD9, 0x75, 0xDD, 0x8D, 0x8D,
+			0x0D, 0x12, 0x08, 0x88, 0x71, 0xB0, 0x83, 0xC0, 0xFA, 0x28, 0x1D, 0x91, 0x38, 0x53, 0x91, 0x12, 0x88, 0x89, 0x28, 0x84,
+			0x68, 0xAB, 0xDB, 0xED, 0x66, 0x32, 0x99, 0xC5, 0x62, 0xB1, 0xB5, 0xB5, 0xC5, 0x99, 0x21, 0x52, 0x0E, 0x82, 0xA0, 0x56,
+			0xAB, 0xB9, 0x7B, 0x2F, 0x9C, 0x9F, 0x32, 0x87, 0x96, 0xF5, 0xEA, 0xE2, 0x1C, 0x38, 0x03, 0x59, 0x33, 0x95, 0xD8, 0xD5,
+			0xD5, 0x55, 0x4C, 0xC9, 0x59, 0xFD, 0xF2, 0x0F, 0x06, 0x3F, 0x79, 0x78, 0x76, 0x4F, 0xAD, 0x56, 0x7B, 0xDA, 0x3E, 0x41,
+			0xBE, 0x78, 0x4F, 0xE7, 0x2B, 0xAC, 0x11, 0x70, 0xC7, 0x8D, 0x95, 0x9F, 0x3F, 0x3C, 0xBB, 0x07, 0xFE, 0x58, 0x2E, 0x97,
+			0xFB, 0x6E, 0xE9, 0x69, 0xAE, 0xDC, 0x34, 0xCD, 0xB7, 0xB5, 0xF7, 0x0B, 0x85, 0xC2, 0x5F, 0x0D, 0x5F, 0x63, 0x4B, 0x78,
+			0x9E, 0xF7, 0x3F, 0xDA, 0x3F, 0xF6, 0x7D, 0xFF, 0x54, 0xF4, 0x90, 0x2A, 0xA2, 0x9E, 0x4A, 0x79, 0x9E, 0xF7, 0xF2, 0xFC,
+			0x2C, 0xEE, 0xF9, 0x2F, 0xFB, 0xAF, 0xD6, 0xEB, 0xF5, 0x5A, 0xAD, 0xD6, 0xEB, 0xF5, 0xC6, 0xE3, 0xF1, 0xDB, 0xDA, 0xFB,
+			0x04, 0xB6, 0x94, 0x70, 0x8E, 0xFB, 0x0F, 0xFC, 0x78, 0xFA, 0xD6, 0x64, 0x32, 0x99, 0xCF, 0xE7, 0x4F, 0xDB, 0x27, 0x88,
+			0x49, 0x05, 0xA6, 0x97, 0xC6, 0x14, 0x58, 0x28, 0xD2, 0x27, 0xC1, 0xFF, 0x73, 0x9A, 0xA4, 0x3C, 0x4B, 0xCE, 0xCD, 0xBE,
+			0x85, 0x72, 0x43, 0x0C, 0x24, 0x04, 0x01, 0x01, 0x82, 0x24, 0x4C, 0x91, 0xA5, 0x1F, 0x0E, 0x87, 0xC2, 0x2E, 0xC7, 0xDC,
+			0xCB, 0xB6, 0x61, 0x67, 0xF2, 0x57, 0xE4, 0x19, 0x44, 0x8A, 0x64, 0xA5, 0x72, 0xB5, 0xEA, 0xC6, 0xF7, 0xB8, 0xAA, 0x50,
+			0x2A, 0x88, 0xC4, 0x25, 0x33, 0x00, 0x43, 0xCF, 0xE5, 0x72, 0x84, 0x71, 0xE2, 0x29, 0x47, 0xA3, 0x11, 0xE4, 0x1C, 0x0C,
+			0x14, 0x06, 0x84, 0xD2, 0x14, 0x81, 0x2C, 0x01, 0xF8, 0x74, 0x3A, 0x25, 0x94, 0x26, 0xAD, 0x49, 0x98, 0x35, 0x0A, 0x99,
+			0x84, 0xF4, 0x41, 0x14, 0x08, 0x14, 0x49, 0x71, 0x75, 0xB9, 0x5C, 0x0A, 0x7D, 0x9C, 0xFC, 0x7E, 0x3E, 0x9F, 0x63, 0xD3,
+			0x60, 0x76, 0xF5, 0x7A, 0x3D, 0xFE, 0x81, 0x25, 0xC4, 0x73, 0xC4, 0x71, 0x4C, 0x18, 0x47, 0xF0, 0x44, 0xA2, 0x00, 0x61,
+			0x01, 0xB2, 0x06, 0xF6, 0x5A, 0x08, 0x51, 0xE9, 0x74, 0x1A, 0xCA, 0x93, 0x69, 0x9A, 0x14, 0xC3, 0x70, 0xD5, 0x84, 0x86,
+			0xD5, 0x6A, 0x75, 0x3A, 0x9D, 0x02, 0x92, 0xC3, 0x79, 0x83, 0xAD, 0x5F, 0xA9, 0x54, 0x00, 0x5D, 0xE0, 0x02, 0x11, 0x9D,
+			0xC3, 0x64, 0x99, 0xCD, 0x66, 0xC5, 0x62, 0x91, 0x4F, 0x06, 0x51, 0x2F, 0x95, 0x4A, 0xEC, 0x64, 0xC7, 0x71, 0x08, 0x17,
+			0x80, 0x70, 0x80, 0x34, 0x5B, 0xAD, 0x96, 0xF0, 0xCA, 0x14, 0xF9, 0x82, 0x84, 0x91, 0x50, 0x8B, 0xA8, 0x1C, 0x62, 0x06,
+			0xEB, 0x04, 0x79, 0x88, 0x2B, 0x96, 0xE0, 0x42, 0xB1, 0x71, 0x13, 0x94, 0x8A, 0xB2, 0x09, 0x06, 0x91, 0xB0, 0x2E, 0x8E,
+			0xE3, 0x5E, 0xAF, 0x47, 0xB4, 0x0E, 0xF9, 0x5A, 0xFA, 0x83, 0xA0, 0x94, 0xC1, 0xEC, 0xA4, 0x60, 0x88, 0x21, 0xC6, 0xEE,
+			0xE3, 0x7B, 0x70, 0xB6, 0x94, 0x19, 0x58, 0x00, 0x56, 0x0B, 0x6E, 0x13, 0x80, 0x06, 0x08, 0x3E, 0x66, 0x57, 0x5A, 0x60,
+			0x00, 0x5E, 0x67, 0xB3, 0x19, 0xF5, 0x7D, 0xFA, 0x5C, 0x7A, 0xBD, 0x1E, 0x00, 0x22, 0xA6, 0x0A, 0xFB, 0x0E, 0x6F, 0x44,
+			0xE0, 0x7E, 0x69, 0x44, 0xD2, 0x75, 0x1D, 0x14, 0xB2, 0xDF, 0xEF, 0x53, 0x09, 0x30, 0x0C, 0x03, 0xAA, 0x00, 0x98, 0x2F,
+			0x44, 0x94, 0xF9, 0x7C, 0xBE, 0xB3, 0xB3, 0x03, 0xA4, 0x50, 0xA9, 0x54, 0xD8, 0x61, 0xD4, 0xC0, 0x29, 0x2E, 0xD5, 0x6A,
+			0x35, 0x0A, 0xFA, 0xAD, 0x56, 0x6B, 0x75, 0x75, 0xB5, 0x58, 0x2C, 0x62, 0x50, 0x5C, 0xD7, 0x9D, 0xCF, 0xE7, 0x6C, 0x50,
+			0x12, 0x73, 0x61, 0xEC, 0x91, 0xA6, 0x61, 0x0D, 0xF7, 0x63, 0x50, 0x98, 0x72, 0xCC, 0x74, 0x94, 0xBC, 0xD8, 0xE5, 0xB8,
+			0x25, 0xE1, 0xE7, 0xF1, 0x10, 0x04, 0xFB, 0x13, 0xC8, 0x15, 0x4A, 0x06, 0x41, 0x1C, 0x65, 0x1F, 0x6E, 0x13, 0xF8, 0x9B,
+			0x2F, 0xA2, 0xBC, 0x86, 0xD1, 0x24, 0x72, 0x61, 0xD7, 0x82, 0xEA, 0xC8, 0x4F, 0xF0, 0x52, 0x04, 0x5F, 0x9E, 0xE7, 0x49,
+			0x1C, 0xC7, 0x7A, 0xA5, 0x52, 0xA9, 0x83, 0x07, 0x0F, 0xAE, 0xAC, 0xAC, 0x00, 0x61, 0xC3, 0x81, 0xA3, 0x2E, 0x0A, 0x94,
+			0x49, 0x60, 0x2E, 0x7D, 0x37, 0x5C, 0x0C, 0x37, 0x4E, 0xB4, 0xB1, 0xB3, 0xB3, 0x43, 0x06, 0xB0, 0xB9, 0xB9, 0x09, 0xC1,
+			0x9F, 0xF7, 0xD0, 0x58, 0x80, 0xA9, 0x22, 0x7C, 0xE3, 0xCC, 0x10, 0x82, 0x70, 0xF0, 0x78, 0x7A, 0xBE, 0xEF, 0x6F, 0x6F,
+			0x6F, 0xCB, 0x0D, 0x92, 0x5C, 0xB2, 0xB5, 0x30, 0x25, 0x1B, 0x1B, 0x1B, 0xA5, 0x52, 0x89, 0x8B, 0x27, 0xCC, 0x04, 0xB4,
+			0x59, 0x3E, 0xAA, 0xCE, 0xC6, 0xDB, 0xDA, 0xFB, 0x0A, 0x9B, 0xEE, 0xBF, 0x2A, 0x4C, 0xC1, 0x57, 0x17, 0xE7, 0x5E, 0x9A,
+			0xBD, 0x0D, 0xE6, 0xFB, 0x9E, 0xFD, 0xA1, 0xEB, 0xBA, 0xC5, 0x62, 0xF1, 0x5C, 0xEA, 0xEA, 0x2B, 0xDE, 0x3B, 0xD8, 0x38,
+			0xA2, 0x22, 0xB8, 0x8F, 0xA7, 0xA2, 0x87, 0x5E, 0x28, 0x9C, 0x81, 0x96, 0xF7, 0xC8, 0xFC, 0xDE, 0x07, 0x47, 0x77, 0x92,
+			0xBE, 0x98, 0xA6, 0xF9, 0x46, 0x78, 0xE1, 0x72, 0xF6, 0x93, 0xC3, 0xE3, 0x6F, 0x9C, 0xD5, 0x2F, 0x13, 0x76, 0x0D, 0x06,
+			0x83, 0xEF, 0x96, 0x9E, 0x7E, 0xA1, 0x70, 0xA6, 0x50, 0x28, 0xAC, 0xAF, 0xAF, 0xD7, 0xEB, 0xF5, 0xF8, 0x2D, 0xC5, 0x5A,
+			0x01, 0x3E, 0x7D, 0x32, 0x75, 0x4C, 0x1D, 0xCB, 0xA4, 0x6E, 0x74, 0xC6, 0x3C, 0x2E, 0x11, 0x15, 0xE9, 0x08, 0x21, 0xE4,
+			0xA1, 0xE1, 0x1D, 0x84, 0x35, 0x7F, 0xD1, 0x7D, 0x59, 0x7C, 0x33, 0x48, 0x0B, 0xF1, 0x1C, 0xA8, 0x82, 0xC0, 0x38, 0x12,
+			0xF2, 0xB3, 0xC7, 0x24, 0x36, 0x57, 0x3E, 0x79, 0xEF, 0xA4, 0x48, 0x97, 0x13, 0x66, 0x5D, 0x8A, 0xE7, 0x52, 0xC3, 0x13,
+			0x7E, 0x27, 0xA6, 0x90, 0x80, 0x40, 0x60, 0x37, 0x3C, 0xC4, 0x7E, 0x7E, 0x0B, 0x7F, 0x92, 0xFC, 0x97, 0xD9, 0xED, 0x76,
+			0x05, 0x14, 0x92, 0x6F, 0x81, 0xF0, 0xC3, 0x1E, 0x1B, 0x0C, 0x06, 0xD0, 0x2E, 0xF9, 0x3F, 0x4C, 0x39, 0x41, 0x15, 0xD9,
+			0x2A, 0x3C, 0xC3, 0xF1, 0x78, 0x2C, 0xDD, 0x48, 0x92, 0xA9, 0xEC, 0x47, 0x4D, 0x89, 0x73, 0xC1, 0x21, 0xC9, 0x41, 0x71,
+			0x27, 0xD4, 0x12, 0x60, 0x82, 0xC0, 0x11, 0x67, 0xFB, 0x61, 0x7C, 0xE1, 0x7A, 0x10, 0xA4, 0x72, 0x7C, 0x00, 0xAB, 0xC3,
+			0x30, 0x2C, 0x14, 0x0A, 0xA3, 0xD1, 0x08, 0x2E, 0x26, 0x8C, 0x1D, 0x0A, 0x03, 0xF4, 0xB2, 0xC0, 0x25, 0xC5, 0x3A, 0x05,
+			0x41, 0xD0, 0xEB, 0xF5, 0xF0, 0x3A, 0x3C, 0xEA, 0xA4, 0xA0, 0xAB, 0x80, 0x20, 0x8E, 0x2D, 0x75, 0x41, 0x02, 0x1A, 0x01,
+			0x24, 0x48, 0xA0, 0x81, 0x55, 0x24, 0xBF, 0xC1, 0xE8, 0x63, 0x48, 0x29, 0xF3, 0x02, 0x63, 0x40, 0x48, 0x21, 0xAD, 0x49,
+			0xEE, 0xC3, 0x25, 0xA1, 0x04, 0x74, 0x81, 0x24, 0x4A, 0xFA, 0x02, 0x34, 0x4D, 0xEC, 0x8E, 0xA3, 0xA2, 0x25, 0x4D, 0x28,
+			0x8E, 0x30, 0x5C, 0x55, 0x8D, 0x0D, 0xB2, 0x3F, 0x15, 0x06, 0x49, 0x7C, 0x58, 0x30, 0x92, 0x2C, 0x58, 0x47, 0xB6, 0x6D,
+			0xC3, 0xFC, 0x19, 0x0C, 0x06, 0x54, 0xED, 0x61, 0x2F, 0xE0, 0xE2, 0xA8, 0x62, 0x3B, 0x8E, 0xD3, 0xE9, 0x74, 0xD8, 0x40,
+			0x3C, 0x3B, 0xE9, 0x97, 0x13, 0x5E, 0x23, 0x9B, 0x83, 0xFF, 0x85, 0xBE, 0x9A, 0xC9, 0x64, 0xA8, 0xD3, 0xE6, 0xF3, 0x79,
+			0x3E, 0x10, 0xA4, 0x7B, 0x97, 0x9C, 0x98, 0x3C, 0x4A, 0xDA, 0x49, 0xA6, 0xD3, 0x29, 0xF8, 0x1A, 0x8E, 0x07, 0x82, 0xA0,
+			0x59, 0x5C, 0xAB, 0xD5, 0x6A, 0xCD, 0x66, 0x13, 0x14, 0x22, 0x9F, 0xCF, 0x63, 0x50, 0xCA, 0xE5, 0xB2, 0x90, 0x44, 0x41,
+			0x45, 0x56, 0x56, 0x56, 0x88, 0x9B, 0x68, 0xCE, 0x82, 0xCB, 0x44, 0x5A, 0x3A, 0x9D, 0x4E, 0x6B, 0xB5, 0x1A, 0x11, 0x0A,
+			0xBE, 0x74, 0x34, 0x1A, 0x51, 0xCC, 0x84, 0x33, 0xCE, 0x43, 0xF4, 0x7D, 0x1F, 0xAA, 0x2B, 0x64, 0xF6, 0xC9, 0x64, 0x02,
+			0xB3, 0x8A, 0x82, 0x15, 0xF6, 0x7A, 0x3E, 0x9F, 0x6F, 0x6C, 0x6C, 0x6C, 0x6F, 0x6F, 0x37, 0x1A, 0x8D, 0xDB, 0xB7, 0x6F,
+			0x8B, 0xA3, 0xA6, 0xB5, 0x82, 0xD6, 0xB0, 0x4E, 0xA7, 0xD3, 0xED, 0x76, 0x73, 0xB9, 0x5C, 0xA5, 0x52, 0x51, 0x5F, 0x6A,
+			0x04, 0xB7, 0xFA, 0x61, 0x9C, 0x4A, 0xAF, 0xBA, 0x9A, 0x9B, 0xB6, 0xA6, 0x8B, 0x70, 0x18, 0xA6, 0x6B, 0xE9, 0x45, 0x9C,
+			0xD2, 0xC3, 0xA5, 0x76, 0xAB, 0xBF, 0x0C, 0x75, 0xFB, 0x40, 0x41, 0x4B, 0x5B, 0xBA, 0x1E, 0x2B, 0xD0, 0xD3, 0x31, 0x02,
+			0xCD, 0xD6, 0xEC, 0x48, 0x6F, 0xCD, 0x54, 0x9B, 0xEE, 0xAF, 0x57, 0x74, 0xD3, 0x30, 0xFD, 0xC8, 0xE8, 0x2E, 0x8C, 0xB5,
+			0x6C, 0x10, 0xA4, 0xC2, 0x38, 0x65, 0x76, 0x3C, 0xD3, 0x8F, 0xE2, 0x5A, 0x5A, 0xC5, 0x7A, 0xE5, 0xAC, 0xD5, 0x9C, 0x2C,
+			0x7E, 0xBD, 0xA2, 0xDB, 0xA6, 0x35, 0xF3, 0xA3, 0xDB, 0x83, 0x60, 0xE2, 0x05, 0x07, 0x8B, 0x7A, 0x64, 0x18, 0xA9, 0x45,
+			0xD8, 0x9A, 0xA5, 0x6A, 0xE9, 0x45, 0xD6, 0x8A, 0x4D, 0x43, 0xDB, 0x1A, 0xA9, 0xD8, 0xAD, 0x5A, 0x70, 0x06, 0x13, 0xCF,
+			0x48, 0xA9, 0x9A, 0x73, 0x23, 0x13, 0xA4, 0xAC, 0x94, 0x63, 0xDB, 0x9B, 0x43, 0x2F, 0x4E, 0x99, 0x75, 0x47, 0xB3, 0x4D,
+			0x23, 0xD4, 0x55, 0x29, 0xF8, 0x40, 0xC1, 0x4C, 0xE9, 0xCA, 0x13, 0xB0, 0xFA, 0x50, 0xB0, 0x06, 0x83, 0x41, 0xA9, 0x54,
+			0x22, 0xFA, 0x20, 0x16, 0x93, 0xAE, 0x5D, 0x18, 0x50, 0xAD, 0x56, 0xCB, 0x30, 0x8C, 0x56, 0xAB, 0x55, 0x2E, 0x97, 0x9B,
+			0xCD, 0x26, 0xEE, 0xAD, 0xD1, 0x68, 0x28, 0x38, 0xD2, 0x30, 0xD6, 0xD7, 0xD7, 0xB7, 0xB6, 0xB6, 0xF0, 0xBB, 0x00, 0x23,
+			0xF8, 0x7B, 0x0E, 0x39, 0x01, 0x3B, 0x41, 0x65, 0x12, 0x29, 0x2A, 0xDB, 0x87, 0xA9, 0xE5, 0xCC, 0x93, 0xE8, 0x48, 0xFD,
+			0x03, 0xC7, 0xBC, 0x5C, 0x2E, 0x37, 0x37, 0x37, 0x0B, 0x85, 0xC2, 0x60, 0x30, 0xA0, 0x7F, 0xD0, 0x30, 0x8C, 0xD5, 0xD5,
+			0x55, 0xDC, 0x55, 0xA7, 0xD3, 0xF9, 0xA3, 0xC6, 0x0B, 0x2A, 0x20, 0x28, 0xAA, 0x9A, 0x9E, 0x69, 0x9A, 0x3B, 0x3B, 0x3B,
+			0x4E, 0xC5, 0xB9, 0xBB, 0xFD, 0xE5, 0xEB, 0x95, 0x9F, 0x9D, 0x0B, 0xAF, 0x86, 0xCB, 0xF0, 0x4C, 0x7C, 0xFC, 0x9C, 0x73,
+			0xF5, 0x91, 0xF9, 0xBD, 0xD9, 0x6C, 0x56, 0x25, 0x6D, 0xEE, 0xE2, 0x1D, 0xE3, 0x4A, 0xA1, 0x51, 0x38, 0xEE, 0x3F, 0xA0,
+			0xF8, 0x1E, 0xF7, 0x4F, 0xF2, 0xE9, 0xFC, 0x2B, 0xDE, 0x3B, 0xDF, 0xCC, 0x3C, 0xF6, 0x83, 0xC1, 0x4F, 0x0A, 0xD5, 0xC2,
+			0x9D, 0xCD, 0x2F, 0xBD, 0x67, 0x7F, 0x58, 0x36, 0xCB, 0xDF, 0xCE, 0x3F, 0xA9, 0x6C, 0xF7, 0x34, 0xB4, 0x6C, 0xEB, 0x59,
+			0xF3, 0x54, 0xBB, 0xDD, 0xAE, 0xAF, 0xAB, 0x26, 0x20, 0xC7, 0x71, 0x68, 0x0A, 0x71, 0x5D, 0xF7, 0x42, 0xFA, 0xA3, 0xF1,
+			0x5D, 0x2A, 0x12, 0xA2, 0xBF, 0x89, 0x87, 0xF3, 0x9D, 0xE2, 0x53, 0x9D, 0x51, 0xE7, 0x5D, 0xF3, 0xDA, 0x93, 0xED, 0x63,
+			0xF7, 0xFA, 0xBF, 0xE3, 0x1B, 0xBB, 0xAD, 0xE3, 0xB0, 0x03, 0x1D, 0xC7, 0xF9, 0xF3, 0xD6, 0x8B, 0xA7, 0xA2, 0x87, 0xCE,
+			0xD8, 0xC7, 0xFF, 0xDA, 0xEA, 0x08, 0xBB, 0x86, 0xA7, 0x34, 0x99, 0x4C, 0x88, 0xF8, 0x68, 0x8B, 0x11, 0x22, 0x03, 0x11,
+			0x03, 0x9F, 0x03, 0xFA, 0x87, 0x51, 0xE6, 0x01, 0x4A, 0xDF, 0xBB, 0x50, 0x36, 0x01, 0x87, 0x95, 0xEB, 0x48, 0x1E, 0x0B,
+			0xB0, 0x12, 0xA8, 0x8B, 0x64, 0x0F, 0xE2, 0x2A, 0x24, 0xAD, 0x14, 0x43, 0x8F, 0x9B, 0xA4, 0x69, 0x06, 0x3F, 0x41, 0x14,
+			0xB8, 0x9F, 0x4E, 0x0A, 0x86, 0xA9, 0x78, 0x07, 0x09, 0x16, 0xDF, 0xEB, 0xF5, 0x08, 0x75, 0x71, 0xED, 0x62, 0x22, 0xB9,
+			0xA9, 0x62, 0xB1, 0x58, 0x2E, 0x97, 0xF1, 0xBB, 0xE9, 0xB4, 0xEA, 0x94, 0xA6, 0x06, 0x19, 0xC7, 0x31, 0x5C, 0x6D, 0x5C,
+			0x1A, 0xD8, 0x00, 0x26, 0x02, 0x30, 0xD9, 0xB0, 0xD4, 0x77, 0xB1, 0xF4, 0x10, 0xED, 0x6A, 0xB5, 0x1A, 0xA6, 0x89, 0x3F,
+			0x81, 0x96, 0x4D, 0x7A, 0x04, 0xF6, 0x4B, 0xA5, 0x94, 0x86, 0x32, 0x4C, 0x2A, 0xD1, 0x21, 0x68, 0x2A, 0xE5, 0xE8, 0x7C,
+			0x3E, 0x3F, 0x18, 0x0C, 0x08, 0x4D, 0x58, 0x02, 0x0A, 0x4E, 0xE0, 0xCF, 0xF0, 0xFA, 0xA5, 0xA3, 0x88, 0x90, 0x05, 0x8B,
+			0x27, 0x04, 0x27, 0x72, 0x20, 0xC7, 0x71, 0x28, 0xA1, 0x65, 0xB3, 0x59, 0xBA, 0x10, 0x26, 0x93, 0x09, 0x55, 0x68, 0x5C,
+			0x02, 0x01, 0xA5, 0xB4, 0x52, 0x03, 0x21, 0x50, 0x13, 0xA2, 0xE1, 0x06, 0x24, 0x16, 0x6A, 0x1C, 0x2E, 0x10, 0xF0, 0x6D,
+			0x36, 0x9B, 0x11, 0x84, 0x11, 0xA7, 0x56, 0xAB, 0x55, 0x1C, 0x0F, 0xE4, 0xDA, 0xDD, 0xD0, 0x0A, 0x4F, 0x88, 0xE1, 0xC6,
+			0x47, 0xF1, 0x11, 0xBC, 0x9B, 0x55, 0x84, 0x20, 0x0F, 0x92, 0x05, 0xF2, 0x65, 0x18, 0x46, 0xBB, 0xDD, 0xA6, 0x78, 0x20,
+			0x51, 0x40, 0x2E, 0x97, 0xE3, 0x70, 0x42, 0x47, 0x95, 0xFD, 0x27, 0xA9, 0x25, 0x8C, 0x5D, 0x36, 0x19, 0x6D, 0x59, 0x20,
+			0x6E, 0x92, 0xF7, 0x61, 0xE5, 0x79, 0x7F, 0x10, 0x04, 0xD4, 0xA9, 0x29, 0x2A, 0xB2, 0x0C, 0x80, 0x36, 0x18, 0x97, 0x72,
+			0xB9, 0x2C, 0xD9, 0x4D, 0x36, 0x9B, 0xA5, 0x79, 0x07, 0xA8, 0x9D, 0x62, 0x1A, 0x9C, 0x3F, 0xE8, 0x3D, 0xAA, 0x2B, 0xA1,
+			0xDF, 0x5F, 0x59, 0x59, 0x51, 0xDB, 0x79, 0xAF, 0x68, 0x29, 0x78, 0x34, 0x10, 0xAD, 0x74, 0x96, 0x52, 0x5E, 0xA3, 0x8C,
+			0xC3, 0x1A, 0x67, 0x32, 0x99, 0xED, 0xED, 0x6D, 0x96, 0x1F, 0x4F, 0x7B, 0xE0, 0xC0, 0x01, 0xBC, 0xAE, 0xEB, 0xBA, 0x54,
+			0xA2, 0xC8, 0x8D, 0xFA, 0xFD, 0x7E, 0xB3, 0xD9, 0x6C, 0x34, 0x1A, 0x12, 0x6B, 0xE7, 0x72, 0x39, 0xD2, 0x29, 0xA8, 0x9F,
+			0x96, 0x65, 0x81, 0xFF, 0xD0, 0x8C, 0xC7, 0xBD, 0x00, 0x2E, 0xF1, 0xFC, 0x05, 0xFB, 0xE3, 0xE8, 0xD2, 0x80, 0x03, 0xEC,
+			0x03, 0xF0, 0x22, 0x47, 0x88, 0x54, 0x5D, 0xAC, 0xA1, 0x48, 0x71, 0x80, 0x48, 0x60, 0x07, 0xA5, 0xFF, 0x5E, 0xCA, 0xDD,
+			0x72, 0xF0, 0xA4, 0x63, 0x93, 0xE0, 0x91, 0x70, 0x8F, 0x53, 0xCD, 0xB7, 0x93, 0x39, 0xF1, 0xFC, 0xC9, 0x37, 0x61, 0xD4,
+			0xA8, 0x73, 0xB9, 0xB7, 0x8D, 0x68, 0x4D, 0x6C, 0x36, 0x9B, 0x14, 0xF7, 0x08, 0x2B, 0x0E, 0x1C, 0x38, 0x50, 0x2E, 0x97,
+			0xE7, 0xF3, 0x79, 0xBB, 0xDD, 0x86, 0x9D, 0x35, 0x9B, 0xCD, 0xB6, 0xB6, 0xB6, 0x86, 0xC3, 0x61, 0x3E, 0x9F, 0x67, 0x15,
+			0x44, 0xA8, 0x03, 0xA8, 0x81, 0x7C, 0x7F, 0x30, 0x18, 0x6C, 0x6C, 0x6C, 0x80, 0xEA, 0x80, 0x47, 0x41, 0x8F, 0xA3, 0x99,
+			0x20, 0x9F, 0xCF, 0xD7, 0xEB, 0x75, 0xEE, 0x0B, 0xB8, 0x83, 0xA0, 0x5B, 0x6A, 0x1B, 0xD8, 0x02, 0x89, 0x2B, 0xA5, 0x9A,
+			0xCD, 0x87, 0x54, 0xAB, 0x55, 0x00, 0x04, 0xF8, 0x36, 0x4A, 0xFB, 0xA1, 0x58, 0x3C, 0xF8, 0xF3, 0x06, 0x44, 0x6F, 0xCB,
+			0xB2, 0x5E, 0x9E, 0x9F, 0xA5, 0xE5, 0xAA, 0xD3, 0xE9, 0x00, 0xF7, 0x9F, 0x0C, 0x0F, 0x3F, 0x99, 0x3A, 0xF6, 0xEA, 0xE2,
+			0xDC, 0x91, 0xE9, 0xDD, 0x93, 0xC9, 0xE4, 0xFB, 0xBD, 0x57, 0x3E, 0xAE, 0xFE, 0x75, 0x26, 0x93, 0x39, 0x19, 0x1E, 0x7E,
+			0x74, 0x79, 0xA8, 0xD5, 0x6A, 0x29, 0x70, 0xF6, 0x23, 0xC5, 0x02, 0x00, 0xD8, 0x7D, 0xC2, 0x38, 0xDA, 0x6E, 0xB7, 0xA3,
+			0x28, 0x7A, 0xDA, 0x3E, 0xF1, 0xB8, 0xFE, 0xC8, 0xF7, 0x7B, 0xAF, 0x60, 0xD9, 0x71, 0x93, 0x10, 0xF8, 0xDE, 0x08, 0x2F,
+			0xBC, 0x15, 0x5F, 0xBA, 0x56, 0xF8, 0xAC, 0x58, 0x2C, 0xBE, 0xBA, 0x38, 0x77, 0x32, 0x3C, 0xBC, 0xF6, 0x79, 0xB5, 0xD3,
+			0xE9, 0x00, 0x07, 0xD9, 0xB6, 0xFD, 0xB4, 0x7D, 0x22, 0x9D, 0x4E, 0x5F, 0x48, 0x7F, 0x54, 0x4B, 0x5E, 0x96, 0x65, 0x9D,
+			0xB7, 0x3E, 0x20, 0x2B, 0x7D, 0x69, 0xF6, 0xF6, 0x6C, 0x36, 0xEB, 0x74, 0x94, 0xD1, 0x7F, 0xCF, 0xFE, 0x10, 0x9C, 0x0D,
+			0xF2, 0x9F, 0x74, 0x6F, 0x01, 0x5F, 0x48, 0x17, 0xA1, 0x98, 0x66, 0x22, 0x1B, 0xC9, 0x9B, 0x79, 0xFE, 0x52, 0x68, 0x01,
+			0x09, 0x94, 0x42, 0x11, 0x50, 0x92, 0x74, 0x93, 0x70, 0x24, 0xC9, 0xAB, 0xA4, 0x19, 0x58, 0x48, 0x07, 0x52, 0xA9, 0xC2,
+			0xAF, 0x24, 0x80, 0xBF, 0x8A, 0x3C, 0x08, 0x9C, 0x45, 0xE8, 0x85, 0x2D, 0x27, 0xF4, 0x44, 0xD0, 0x4E, 0x90, 0x6B, 0x72,
+			0x77, 0xF6, 0x18, 0x6C, 0x2E, 0x2A, 0x6D, 0xAD, 0x56, 0x8B, 0x2E, 0xB0, 0x42, 0xA1, 0x30, 0x99, 0x4C, 0xC6, 0xE3, 0x31,
+			0xAD, 0xB6, 0x34, 0xA5, 0x42, 0x54, 0x81, 0xEC, 0x27, 0xD8, 0x5D, 0xA1, 0x50, 0x00, 0xCE, 0x52, 0xAE, 0x37, 0x79, 0x9E,
+			0xC0, 0x06, 0xF9, 0x7C, 0xBE, 0x5A, 0xAD, 0x42, 0x0C, 0xF5, 0x3C, 0x8F, 0xB5, 0x20, 0x3E, 0x13, 0x62, 0xA5, 0xB4, 0x28,
+			0xF3, 0x75, 0x6C, 0x4E, 0x88, 0x27, 0x72, 0xD8, 0xC1, 0x12, 0xF1, 0x0D, 0xF0, 0x24, 0xD9, 0xAE, 0xD2, 0xD1, 0x02, 0x09,
+			0x6D, 0x38, 0x1C, 0xE2, 0xD1, 0x49, 0xC1, 0x25, 0xFF, 0xD0, 0x75, 0x7D, 0x34, 0x1A, 0xD5, 0x6A, 0x35, 0x72, 0x05, 0x88,
+			0x3A, 0x7E, 0xF2, 0x82, 0xFF, 0x02, 0xAB, 0xAA, 0xD3, 0xE9, 0x80, 0x5E, 0xB0, 0x6F, 0x09, 0x61, 0xE9, 0x7E, 0x80, 0xBB,
+			0x88, 0x9B, 0xC7, 0x1A, 0xC3, 0x78, 0x04, 0xCD, 0x06, 0x4C, 0xE3, 0x6C, 0x92, 0x34, 0x13, 0x37, 0x10, 0x96, 0x01, 0x1E,
+			0x48, 0x69, 0x4D, 0x21, 0x0D, 0xAA, 0xCD, 0x3D, 0xC9, 0x71, 0x24, 0x10, 0x00, 0xCC, 0xC2, 0x69, 0xD0, 0x85, 0x8F, 0xB3,
+			0xAA, 0xD5, 0x6A, 0x24, 0x6E, 0x84, 0x75, 0xF5, 0x7A, 0x1D, 0xBF, 0xB7, 0x3F, 0xEF, 0x20, 0x99, 0xC2, 0x14, 0x8A, 0x5A,
+			0x08, 0xF6, 0x11, 0x80, 0x98, 0xFC, 0x0B, 0x30, 0x04, 0x4F, 0x88, 0xFB, 0x92, 0xDA, 0x34, 0x84, 0x7A, 0x42, 0x3F, 0x30,
+			0x4A, 0x88, 0x46, 0xB4, 0x26, 0x40, 0xEA, 0x9A, 0x4E, 0xA7, 0xA0, 0x31, 0x44, 0x3D, 0x74, 0xFF, 0x97, 0x4A, 0x25, 0x9A,
+			0xFB, 0xA7, 0xD3, 0x29, 0xCB, 0x40, 0xAD, 0xC6, 0xB6, 0x6D, 0x88, 0x40, 0x99, 0x4C, 0x66, 0x73, 0x73, 0xB3, 0x52, 0xA9,
+			0xB4, 0xDB, 0xED, 0x5C, 0x2E, 0x87, 0x53, 0x01, 0x3B, 0xA2, 0x7A, 0x4C, 0x0E, 0x01, 0xCF, 0x3A, 0x95, 0x4A, 0xD1, 0xFD,
+			0x20, 0x2C, 0x02, 0xA0, 0x46, 0x2A, 0xCC, 0x62, 0xA6, 0xF3, 0xF9, 0xFC, 0xED, 0xDB, 0xB7, 0x89, 0x1D, 0xE8, 0x14, 0x5B,
+			0x5B, 0x5B, 0x23, 0xAE, 0x21, 0x0B, 0x03, 0x6E, 0x13, 0xE9, 0x0F, 0x42, 0xBF, 0xED, 0xED, 0x6D, 0xA1, 0xE5, 0x50, 0x82,
+			0x13, 0x56, 0x9C, 0x10, 0xE6, 0x04, 0x27, 0x21, 0xE9, 0x16, 0x5C, 0x55, 0x9A, 0xFA, 0x90, 0xE2, 0x40, 0xAF, 0x09, 0x44,
+			0x15, 0x63, 0x2D, 0xE7, 0x53, 0x58, 0x55, 0x1C, 0x60, 0xFC, 0xB4, 0xA0, 0x9F, 0xF8, 0x12, 0x8E, 0xB1, 0xE8, 0x20, 0x61,
+			0x1D, 0x60, 0x0A, 0x91, 0x87, 0x29, 0x8C, 0x60, 0x8F, 0xF9, 0xC7, 0x56, 0x16, 0xF4, 0x09, 0xFF, 0x11, 0x86, 0x21, 0xFC,
+			0x2B, 0x5A, 0x55, 0xF1, 0x8B, 0x71, 0x1C, 0x37, 0x9B, 0x4D, 0xF0, 0x7A, 0xE8, 0xED, 0x7C, 0x3B, 0xCC, 0x39, 0xE1, 0x23,
+			0xD1, 0xE3, 0x4D, 0x83, 0x25, 0x87, 0x3C, 0x95, 0x4A, 0x91, 0xCC, 0xD1, 0x28, 0x37, 0x1A, 0x8D, 0x12, 0x6C, 0x76, 0xD9,
+			0xED, 0x76, 0xA1, 0x1B, 0x50, 0x78, 0x14, 0xB8, 0x99, 0xCA, 0x41, 0x18, 0x86, 0x07, 0x0E, 0x1C, 0x58, 0x5D, 0x5D, 0x85,
+			0x01, 0xDD, 0xEF, 0xF7, 0xE3, 0x38, 0xEE, 0x74, 0x3A, 0x98, 0x7E, 0x16, 0x0B, 0x4C, 0x12, 0xE7, 0x01, 0x73, 0x1C, 0xF6,
+			0x2A, 0x0A, 0x51, 0x00, 0xB2, 0xA7, 0xE3, 0x23, 0xC4, 0x5C, 0xF9, 0x7C, 0xFE, 0xC8, 0xF4, 0x6E, 0x6A, 0x6B, 0x93, 0xC9,
+			0xE4, 0x31, 0xED, 0x61, 0x5C, 0xDD, 0xF3, 0xD9, 0xC7, 0xEF, 0xEF, 0x7F, 0x8D, 0xDC, 0x7F, 0x67, 0x67, 0xE7, 0x83, 0xE2,
+			0xE7, 0xE7, 0xAD, 0x0F, 0xF0, 0xFD, 0x68, 0x90, 0x44, 0x51, 0xF4, 0xAC, 0x73, 0xEA, 0xC0, 0x81, 0x03, 0xEF, 0x67, 0x3E,
+			0x06, 0xB3, 0xFE, 0x89, 0xFF, 0x2E, 0xA7, 0xC9, 0xF7, 0xFD, 0x1F, 0x4F, 0xDF, 0x0A, 0xC3, 0xF0, 0xF0, 0xF8, 0x1B, 0x47,
+			0xA6, 0x77, 0x43, 0xC7, 0x3E, 0x1D, 0x1F, 0xB9, 0x56, 0xF8, 0x6C, 0xF9, 0xA8, 0x46, 0x1D, 0x92, 0x0E, 0xD5, 0xCB, 0xD9,
+			0x4F, 0xB6, 0xB6, 0xB6, 0xCE, 0x98, 0xC7, 0x8F, 0x4C, 0xEF, 0x96, 0x76, 0x0D, 0x1A, 0xE3, 0x4F, 0x04, 0x0F, 0x1A, 0x86,
+			0xB1, 0xB6, 0xB6, 0xF6, 0x7C, 0xF6, 0x71, 0x9E, 0x3C, 0x47, 0x5A, 0xE1, 0x83, 0x49, 0x79, 0x93, 0x3A, 0x81, 0xD0, 0x6C,
+			0xF8, 0x2D, 0x4E, 0x42, 0xEA, 0xB4, 0x38, 0x03, 0xF0, 0x34, 0xC1, 0x21, 0x25, 0x09, 0x10, 0x42, 0x1A, 0x05, 0x09, 0x21,
+			0x26, 0x60, 0xCD, 0x45, 0x49, 0x06, 0x5B, 0x4F, 0x78, 0x2E, 0xFC, 0x1F, 0xBC, 0x0E, 0x06, 0x04, 0x40, 0x46, 0xA2, 0x0D,
+			0x9A, 0xB9, 0x48, 0xFD, 0x79, 0xBF, 0xE8, 0xED, 0x50, 0x59, 0x04, 0xB6, 0x92, 0x6E, 0x2C, 0xF6, 0x24, 0xC0, 0x08, 0x26,
+			0x98, 0x7E, 0xFB, 0x95, 0x95, 0x15, 0xFE, 0x4D, 0xFD, 0x13, 0x6A, 0x06, 0x6D, 0x22, 0x54, 0x16, 0xE1, 0x65, 0x8A, 0xCC,
+			0x11, 0xC7, 0x84, 0xC4, 0x1D, 0xA4, 0x01, 0x10, 0x12, 0xB8, 0x9F, 0x46, 0x74, 0x2A, 0x43, 0x5C, 0x0F, 0xEF, 0xE1, 0xC0,
+			0xE6, 0x72, 0x39, 0x78, 0xDB, 0x5C, 0x0C, 0xA1, 0x15, 0x6D, 0x01, 0xA8, 0x53, 0x10, 0x8C, 0xCF, 0x66, 0xB3, 0x6E, 0xB7,
+			0x3B, 0x1C, 0x0E, 0xC7, 0xE3, 0x31, 0xCC, 0x02, 0xB6, 0x04, 0xE1, 0x36, 0x85, 0x84, 0x5C, 0x2E, 0x37, 0x18, 0x0C, 0xBA,
+			0xDD, 0x2E, 0xAC, 0x1E, 0xA0, 0x18, 0x80, 0x0D, 0xE8, 0x49, 0x30, 0x20, 0xA0, 0xDB, 0x60, 0xA9, 0xC1, 0x1B, 0x58, 0x11,
+			0x0E, 0x02, 0x65, 0x51, 0x89, 0xFC, 0xD0, 0x5F, 0x28, 0x16, 0x8B, 0x1C, 0x4F, 0x09, 0x1F, 0xA5, 0x80, 0xC1, 0x61, 0xC1,
+			0xA3, 0x40, 0x3D, 0x07, 0x10, 0xA3, 0x11, 0x81, 0x26, 0x79, 0x15, 0x14, 0x38, 0x8E, 0xB3, 0xB2, 0xB2, 0xC2, 0xE3, 0x08,
+			0x82, 0xA0, 0xDF, 0xEF, 0x83, 0xC3, 0x40, 0xCE, 0xA5, 0x1B, 0x8B, 0x7B, 0xE3, 0x21, 0x0A, 0x13, 0xA3, 0xD3, 0xE9, 0x10,
+			0x29, 0x63, 0xD9, 0x75, 0x5D, 0x27, 0xD9, 0xC1, 0xE8, 0x13, 0xF6, 0xE2, 0x8D, 0x41, 0x3F, 0x29, 0x58, 0x81, 0x7C, 0x61,
+			0x34, 0x05, 0xD5, 0x4A, 0x4A, 0x09, 0x8A, 0x59, 0xC4, 0xED, 0xC1, 0x72, 0x01, 0x60, 0xDD, 0xD9, 0xD9, 0xA1, 0xE8, 0xC7,
+			0xA2, 0x02, 0xDA, 0x50, 0x4B, 0xD8, 0xCF, 0x47, 0x66, 0x99, 0x6D, 0xDB, 0xAE, 0xD5, 0x6A, 0xFD, 0x7E, 0x7F, 0x6D, 0x6D,
+			0x8D, 0x65, 0x18, 0x8F, 0xC7, 0xB6, 0x6D, 0x37, 0x9B, 0xCD, 0x6C, 0x36, 0xBB, 0xB1, 0xB1, 0x41, 0x6B, 0x06, 0xC8, 0x55,
+			0xAB, 0xD5, 0x82, 0x9A, 0x09, 0xE3, 0x08, 0xA3, 0x0F, 0xC9, 0x92, 0x8D, 0x65, 0x59, 0x56, 0xA3, 0xD1, 0xC0, 0x40, 0x53,
+			0x3A, 0x87, 0x2C, 0x41, 0x8E, 0x09, 0x3C, 0x47, 0x80, 0x4F, 0xBD, 0xBE, 0x58, 0x2C, 0xF2, 0xF9, 0x44, 0xCD, 0x02, 0xDB,
+			0x21, 0x97, 0x34, 0x9F, 0xCF, 0xD1, 0x81, 0x48, 0xA7, 0xD3, 0xB8, 0x5F, 0x61, 0x73, 0xEE, 0x6F, 0x96, 0xE1, 0x63, 0x93,
+			0xC3, 0xA8, 0xFA, 0x44, 0x84, 0x9E, 0x04, 0x8C, 0x06, 0x25, 0x4E, 0xF8, 0xE3, 0x04, 0xE9, 0x92, 0x77, 0x0B, 0x55, 0x43,
+			0xAA, 0xC4, 0x9C, 0x28, 0xDC, 0x80, 0x74, 0xE1, 0x43, 0x6E, 0x93, 0x2A, 0x82, 0x44, 0x7F, 0x12, 0xB0, 0x90, 0x13, 0xB0,
+			0x0D, 0x40, 0xF0, 0x40, 0xCF, 0xC1, 0x01, 0x68, 0xB0, 0xE2, 0xF0, 0x53, 0x0E, 0xE5, 0xDA, 0x28, 0xD8, 0x90, 0x2C, 0x16,
+			0x0A, 0x85, 0xCD, 0xCD, 0x4D, 0xB2, 0x2B, 0x38, 0x5A, 0x94, 0xE3, 0xA8, 0x1C, 0x28, 0xCD, 0xA8, 0x24, 0xFD, 0xA2, 0xAE,
+			0x00, 0x0B, 0xC2, 0x34, 0x4D, 0x28, 0x37, 0x64, 0x45, 0x98, 0xF5, 0x7E, 0xBF, 0x8F, 0xF9, 0x40, 0x5B, 0x62, 0x65, 0x65,
+			0x85, 0xB6, 0x26, 0xBE, 0xD1, 0x30, 0x8C, 0x8D, 0x8D, 0x0D, 0xB6, 0x3E, 0xBC, 0x08, 0x12, 0x2F, 0x8A, 0x07, 0x94, 0xEE,
+			0xD7, 0xD7, 0xD7, 0x91, 0x88, 0x41, 0x4B, 0xA0, 0x50, 0x28, 0x94, 0x93, 0x17, 0xEC, 0xE9, 0xD1, 0x83, 0xF3, 0x37, 0xC2,
+			0x0B, 0x57, 0xF3, 0x9F, 0x5E, 0x72, 0x6F, 0xE0, 0x8F, 0x2D, 0xCB, 0x7A, 0x5C, 0x7F, 0xE4, 0x1D, 0xE3, 0xCA, 0xEB, 0xC1,
+			0x7B, 0xF4, 0xFA, 0x01, 0x80, 0x10, 0xB2, 0x14, 0x8B, 0xC5, 0xAB, 0xF9, 0x4F, 0xD9, 0xCF, 0xDF, 0xCA, 0x3D, 0xF1, 0x7C,
+			0xF6, 0x71, 0xD3, 0x34, 0x7F, 0x38, 0x7A, 0x3D, 0x8E, 0xE3, 0x37, 0xC2, 0x0B, 0x2F, 0x4E, 0xDE, 0xD4, 0x75, 0xFD, 0xBF,
+			0xDD, 0xFE, 0xFE, 0xB1, 0xC5, 0xFD, 0xE7, 0x52, 0x57, 0x1D, 0xC7, 0x79, 0x74, 0x79, 0x08, 0xBB, 0xFC, 0x9E, 0xFD, 0xE1,
+			0xF3, 0xD9, 0xC7, 0xE9, 0x83, 0x79, 0xC7, 0xB8, 0x42, 0xAB, 0x17, 0x10, 0x73, 0xB3, 0xD9, 0xE4, 0xE0, 0xCC, 0xE7, 0xF3,
+			0x2B, 0xB9, 0x9B, 0x47, 0xA6, 0x77, 0xD3, 0x5A, 0x4C, 0x08, 0x65, 0xDB, 0xF6, 0xE9, 0xF8, 0xC8, 0x8D, 0x95, 0x9F, 0x7B,
+			0x9E, 0xF7, 0x66, 0x74, 0xB1, 0x54, 0x2A, 0x7D, 0xBF, 0xF7, 0x4A, 0x18, 0x86, 0xFF, 0xE7, 0xFA, 0x77, 0x49, 0x52, 0x09,
+			0xCF, 0xB1, 0xB6, 0x78, 0x65, 0x1C, 0x24, 0x51, 0x30, 0xE7, 0x51, 0xFA, 0x84, 0xC1, 0x75, 0xC9, 0xB7, 0x84, 0xD1, 0xCB,
+			0x9E, 0x17, 0xF9, 0x33, 0x76, 0x0B, 0xD0, 0xAB, 0xA0, 0xF6, 0x94, 0x52, 0x85, 0x03, 0x2A, 0x82, 0x71, 0xD2, 0x43, 0x2B,
+			0x9F, 0xC6, 0x9A, 0x72, 0x4E, 0x09, 0x1A, 0xD8, 0xA8, 0xD2, 0xC3, 0xA8, 0x22, 0xF3, 0x3D, 0x71, 0x3A, 0x0C, 0xD9, 0x64,
+			0x32, 0xA1, 0xBE, 0x4A, 0x4B, 0x1A, 0x7D, 0x3C, 0x14, 0xD8, 0x09, 0x5F, 0x40, 0xFF, 0x88, 0x56, 0xB1, 0xE0, 0xAA, 0x05,
+			0xAF, 0x5C, 0xE6, 0x74, 0x80, 0x1F, 0xE2, 0xA5, 0x7A, 0xBD, 0x5E, 0xBB, 0xDD, 0xA6, 0x72, 0xC0, 0xD9, 0x27, 0xEA, 0x17,
+			0x30, 0x16, 0x19, 0x12, 0x0A, 0x8A, 0x9C, 0x35, 0xC1, 0xA2, 0x85, 0xD7, 0x20, 0x95, 0xF3, 0x52, 0xA9, 0x84, 0x12, 0x03,
+			0x16, 0x83, 0x9A, 0x19, 0xD1, 0xFD, 0x1E, 0x44, 0xAD, 0x8B, 0x5A, 0x09, 0x98, 0x27, 0xB8, 0x42, 0xA9, 0x54, 0x12, 0x5D,
+			0x26, 0xE8, 0xAD, 0xC8, 0x51, 0x60, 0x7C, 0xE5, 0xC4, 0xF9, 0xBE, 0x8F, 0x0D, 0xC4, 0xF8, 0x48, 0x97, 0x12, 0x84, 0x4E,
+			0x62, 0x17, 0x90, 0x03, 0x1C, 0xB0, 0x7A, 0xE8, 0xC9, 0x89, 0xAB, 0x56, 0xAB, 0xB8, 0x70, 0xCB, 0xB2, 0xD8, 0x8D, 0x94,
+			0x85, 0x48, 0xAD, 0xC8, 0xE6, 0x21, 0xCE, 0x92, 0x51, 0x71, 0x47, 0xD2, 0x2E, 0x0A, 0x1A, 0xAF, 0xF2, 0x12, 0x14, 0xD0,
+			0x08, 0x1C, 0x80, 0x7A, 0x56, 0x57, 0x57, 0x49, 0x19, 0xC0, 0xEF, 0x70, 0x3E, 0xC5, 0x62, 0x11, 0x7D, 0x15, 0x12, 0x76,
+			0x41, 0x1B, 0x30, 0xFD, 0x64, 0xD6, 0xF2, 0xD1, 0xFD, 0x7E, 0x9F, 0xBA, 0x84, 0x88, 0xD2, 0x88, 0x48, 0x13, 0xDB, 0x14,
+			0x43, 0x43, 0x3B, 0x28, 0x3F, 0x21, 0x67, 0x81, 0x08, 0x85, 0x5B, 0xE6, 0x3C, 0x53, 0xA6, 0xC7, 0x93, 0x13, 0x45, 0x92,
+			0xA6, 0x11, 0xCE, 0x80, 0xB2, 0x59, 0x96, 0xB5, 0xBA, 0xBA, 0x3A, 0x99, 0x4C, 0x06, 0x83, 0x01, 0xDD, 0x10, 0x54, 0x5D,
+			0x94, 0x7C, 0x63, 0x82, 0x99, 0xD2, 0x3A, 0xAF, 0xEB, 0x7A, 0xB7, 0xDB, 0x25, 0x00, 0x24, 0x9C, 0xAC, 0xD7, 0xEB, 0x64,
+			0xBB, 0x74, 0x7F, 0x50, 0x60, 0x04, 0xE7, 0x01, 0xEF, 0xA2, 0x0C, 0x50, 0xAF, 0xD7, 0x49, 0x51, 0xF7, 0xD3, 0x58, 0x95,
+			0x79, 0x4E, 0xE0, 0x3F, 0xE2, 0x29, 0x7A, 0x3D, 0x00, 0x16, 0x46, 0xA3, 0x11, 0x7A, 0x35, 0x9A, 0xA6, 0xE1, 0xF3, 0x69,
+			0x58, 0x27, 0xB2, 0x28, 0x95, 0x4A, 0xD5, 0x6A, 0x15, 0x6D, 0x0C, 0x36, 0x37, 0x76, 0x07, 0x29, 0x0F, 0x6A, 0xB9, 0xA4,
+			0xE7, 0xD2, 0xBA, 0x49, 0x31, 0x5C, 0x4A, 0xB5, 0x1C, 0x69, 0xE9, 0xD4, 0x17, 0x6A, 0x07, 0x8A, 0x46, 0xA0, 0x9C, 0xC0,
+			0x0E, 0x40, 0xB4, 0x3C, 0x49, 0xE0, 0x48, 0xE9, 0x27, 0x10, 0x0C, 0x5D, 0x08, 0x24, 0x49, 0xC0, 0xF4, 0xBF, 0x04, 0x1D,
+			0x09, 0xFD, 0xB0, 0x41, 0x52, 0x37, 0xDE, 0x6B, 0x27, 0x32, 0x84, 0xBC, 0x44, 0x7E, 0xAD, 0xEB, 0x3A, 0x71, 0x31, 0x94,
+			0x4D, 0xDA, 0xB5, 0xC8, 0x26, 0x45, 0xFF, 0xA7, 0x54, 0x2A, 0x09, 0x55, 0x89, 0xBF, 0xED, 0x74, 0x3A, 0xC0, 0x86, 0x74,
+			0x3F, 0x52, 0xA7, 0x21, 0xB7, 0x43, 0x63, 0x63, 0x34, 0x1A, 0x35, 0x1A, 0x0D, 0xD7, 0x75, 0xA9, 0xB1, 0x73, 0x9B, 0x30,
+			0x25, 0x00, 0x8E, 0x71, 0x4E, 0xEC, 0x5E, 0x11, 0x4E, 0xE1, 0x8B, 0xF2, 0xF9, 0x3C, 0x1A, 0x0F, 0x14, 0x7E, 0x38, 0xF3,
+			0x28, 0x8B, 0x40, 0xBA, 0xD8, 0x6D, 0xF1, 0xBB, 0x51, 0x78, 0xC2, 0x38, 0x7A, 0x2A, 0x7A, 0xE8, 0x09, 0xE3, 0xE8, 0xCB,
+			0xF3, 0xB3, 0x57, 0x72, 0x37, 0x61, 0x07, 0x7E, 0x3B, 0xFF, 0xE4, 0x77, 0x4B, 0x4F, 0xFB, 0xBE, 0xFF, 0xB6, 0xF6, 0xFE,
+			0x6C, 0x36, 0x5B, 0x5B, 0x5B, 0x3B, 0xAB, 0x5F, 0x46, 0xEC, 0x21, 0x08, 0x82, 0x0F, 0x8A, 0x9F, 0x37, 0x1A, 0x8D, 0x6E,
+			0xB7, 0xFB, 0xD2, 0xEC, 0xED, 0x5F, 0x7D, 0x65, 0xFB, 0x59, 0xE7, 0x94, 0xAE, 0xEB, 0xDF, 0x29, 0x3E, 0x45, 0xD4, 0x82,
+			0x39, 0x38, 0x19, 0x1E, 0x3E, 0x11, 0x3C, 0xF8, 0x56, 0x7C, 0xA9, 0x50, 0x28, 0x5C, 0xC9, 0xDD, 0x7C, 0xDA, 0x3E, 0xF1,
+			0xB6, 0xF6, 0x3E, 0x8C, 0xFB, 0xA7, 0xAC, 0x47, 0x3F, 0xAE, 0xFE, 0xF5, 0xF3, 0xD9, 0xC7, 0xDF, 0x08, 0x2F, 0x3C, 0x38,
+			0xBA, 0xF3, 0xD7, 0xFF, 0x76, 0xE3, 0xAF, 0x86, 0xAF, 0x5D, 0xAF, 0xFC, 0xCC, 0xF3, 0xBC, 0xD3, 0xF1, 0x11, 0x78, 0x20,
+			0xEF, 0x9A, 0xD7, 0x56, 0x56, 0x56, 0xC8, 0x84, 0xD6, 0xD7, 0xD7, 0x8F, 0x7A, 0xF7, 0x91, 0x8B, 0xF0, 0x45, 0xE7, 0xAD,
+			0x0F, 0x78, 0xB0, 0xA4, 0x7A, 0x04, 0xD1, 0xA2, 0xD8, 0xA8, 0x16, 0x72, 0xEF, 0x78, 0x8A, 0x6C, 0xA4, 0x74, 0x50, 0xD2,
+			0x7A, 0x2A, 0xDB, 0x58, 0xA4, 0x23, 0xC8, 0x03, 0x08, 0x21, 0xF7, 0xB2, 0x4D, 0xE5, 0x42, 0x58, 0x5C, 0x61, 0x7C, 0x4A,
+			0x0F, 0x2A, 0xB6, 0x0F, 0x8C, 0x45, 0x90, 0x1F, 0x36, 0x0F, 0xD6, 0x5F, 0x34, 0x47, 0x25, 0xAB, 0xD8, 0xAF, 0x50, 0x94,
+			0x18, 0x4F, 0x05, 0x4B, 0x0A, 0xFB, 0x13, 0x09, 0x00, 0xAA, 0x3E, 0x80, 0xD7, 0x10, 0x57, 0xE0, 0x8C, 0xE1, 0x15, 0xF8,
+			0xCC, 0x6C, 0x36, 0x5B, 0x2E, 0x97, 0xE1, 0x02, 0xC1, 0x20, 0x17, 0x25, 0x15, 0x24, 0x9E, 0x56, 0x56, 0x56, 0xF2, 0xF9,
+			0x3C, 0x35, 0x36, 0x30, 0x19, 0xC0, 0x31, 0x40, 0x48, 0xB6, 0x0A, 0x76, 0x80, 0xD0, 0x9B, 0x48, 0x9F, 0x32, 0x24, 0x21,
+			0x11, 0x74, 0x06, 0xDF, 0xF7, 0x2B, 0x95, 0x0A, 0x95, 0x61, 0x7A, 0x71, 0xB1, 0xEF, 0x54, 0x1A, 0xD8, 0x81, 0x78, 0x0E,
+			0x7C, 0x03, 0x64, 0x1E, 0x6A, 0xF5, 0x80, 0x96, 0x58, 0x5B, 0xE9, 0x2C, 0xA3, 0xBE, 0xCD, 0x5D, 0xD3, 0x39, 0x34, 0x4E,
+			0x5E, 0x50, 0x66, 0xE0, 0x56, 0x89, 0xF4, 0x85, 0x24, 0xE8, 0xA0, 0x2F, 0xD9, 0x6C, 0x16, 0xFB, 0x10, 0x86, 0x21, 0xDD,
+			0x2D, 0xC5, 0x62, 0xD1, 0xF3, 0x3C, 0x9A, 0xC8, 0x24, 0x53, 0x24, 0x95, 0x27, 0xA6, 0xE7, 0xA9, 0x52, 0xB2, 0x26, 0xB1,
+			0xA0, 0x15, 0x1F, 0xDA, 0xA4, 0x7A, 0x68, 0xC4, 0xCE, 0x04, 0xB0, 0xD8, 0x7D, 0xBA, 0xE3, 0xC8, 0x03, 0xA0, 0xF4, 0x21,
+			0xED, 0x80, 0xF9, 0x23, 0x8C, 0xA5, 0xF6, 0x8D, 0x5E, 0x02, 0x49, 0x00, 0x55, 0x66, 0x74, 0x84, 0x78, 0x0A, 0xFC, 0x44,
+			0x4B, 0xC4, 0x44, 0xB1, 0x1D, 0x14, 0x5B, 0x50, 0x98, 0x50, 0x65, 0xB7, 0x44, 0x5A, 0x0E, 0x3F, 0x49, 0xAE, 0x07, 0xE1,
+			0x04, 0xD0, 0x4A, 0x22, 0xD0, 0xD9, 0x6C, 0x26, 0x32, 0xCE, 0x94, 0xE0, 0xE1, 0x14, 0xC3, 0xDB, 0x45, 0x67, 0x0D, 0x53,
+			0x42, 0x35, 0x26, 0x97, 0xCB, 0x35, 0x1A, 0x8D, 0x3D, 0x1E, 0xA3, 0xC2, 0x91, 0x51, 0x70, 0x13, 0x97, 0x50, 0xAB, 0xD5,
+			0x36, 0x36, 0x36, 0x82, 0x20, 0x68, 0xB5, 0x5A, 0x50, 0x09, 0x07, 0x83, 0x01, 0x6C, 0x2A, 0xDF, 0xF7, 0x89, 0x58, 0x21,
+			0x0E, 0xD2, 0xD1, 0x86, 0xF5, 0xC1, 0xEC, 0x96, 0x4A, 0xA5, 0x6C, 0x36, 0x4B, 0xED, 0x97, 0x6A, 0x39, 0xB1, 0xC6, 0xCE,
+			0x8E, 0x12, 0xF2, 0x85, 0x5F, 0x0C, 0xA9, 0x9C, 0x32, 0x14, 0x6B, 0x4C, 0xEF, 0x42, 0xF2, 0x60, 0xA2, 0xC1, 0x60, 0xB0,
+			0xB5, 0xB5, 0x05, 0x5D, 0x8C, 0xF4, 0x2B, 0x67, 0x6B, 0x45, 0x37, 0x95, 0xB3, 0x55, 0xBD, 0x97, 0x96, 0xDD, 0xB4, 0xBE,
+			0xDC, 0x1E, 0xC7, 0xDB, 0xE3, 0xB8, 0xE0, 0x18, 0xE5, 0xAC, 0x55, 0xC9, 0xD9, 0xDB, 0xE3, 0xD8, 0xD6, 0xFC, 0x72, 0x56,
+			0x81, 0x4B, 0xDB, 0xE3, 0xF8, 0x56, 0x7F, 0x99, 0xD6, 0x97, 0x56, 0xBC, 0xC8, 0x98, 0xD1, 0xE6, 0x30, 0x2C, 0x67, 0x2D,
+			0xFA, 0x72, 0xA5, 0x91, 0xD8, 0x8A, 0x17, 0x45, 0x37, 0xD5, 0x5D, 0xA8, 0x40, 0xA0, 0xE8, 0xA6, 0x4A, 0x19, 0xF3, 0x1F,
+			0xDA, 0xF3, 0x9D, 0xA9, 0xEA, 0x37, 0xA6, 0x61, 0xB8, 0xE3, 0x99, 0xF9, 0xB4, 0x9E, 0x4F, 0xEB, 0x45, 0x37, 0xD5, 0xF1,
+			0x54, 0xCB, 0x71, 0x39, 0x6B, 0xAD, 0xE4, 0x55, 0x2E, 0xB9, 0x33, 0xD5, 0xAD, 0x78, 0x51, 0xCA, 0x98, 0x59, 0x4B, 0x5D,
+			0x03, 0xDD, 0xC2, 0x05, 0xC7, 0x48, 0xEB, 0xCB, 0xCD, 0x61, 0x48, 0x0F, 0x70, 0x25, 0x67, 0x37, 0x27, 0x5A, 0x6B, 0xA6,
+			0xDA, 0x06, 0xA9, 0x0D, 0xC0, 0x25, 0x65, 0x09, 0xD8, 0x12, 0x40, 0x70, 0xC5, 0x62, 0x71, 0x7B, 0x7B, 0x5B, 0x34, 0x84,
+			0xD1, 0xE4, 0xA1, 0x83, 0x09, 0x7B, 0x81, 0x84, 0x21, 0xDC, 0x35, 0xDB, 0xB6, 0xD7, 0xD6, 0xD6, 0x60, 0xB9, 0xB5, 0x5A,
+			0x2D, 0x36, 0x37, 0xC5, 0x7F, 0x1A, 0xCD, 0xE0, 0xD1, 0xE6, 0x72, 0x39, 0xE0, 0x7E, 0xF2, 0x12, 0x20, 0x78, 0x4A, 0xBE,
+			0xE4, 0xDA, 0xA2, 0x8F, 0x48, 0xBB, 0x10, 0x36, 0xAB, 0xDB, 0xED, 0xAE, 0xAC, 0xAC, 0x90, 0xD7, 0x0A, 0xA5, 0x8D, 0xCF,
+			0xC9, 0x66, 0xB3, 0x3F, 0x9E, 0xBE, 0x95, 0xCD, 0x66, 0x0F, 0x8F, 0xBF, 0xC1, 0x3E, 0xEF, 0xF7, 0xFB, 0x98, 0xF2, 0xFB,
+			0x7A, 0x5F, 0xAD, 0xD7, 0xEB, 0x93, 0xC9, 0xE4, 0x71, 0xFD, 0x91, 0x47, 0x97, 0x87, 0x38, 0x11, 0xC7, 0x16, 0xF7, 0xFF,
+			0x70, 0xF4, 0xFA, 0xEB, 0xC1, 0x7B, 0x2F, 0x14, 0xCE, 0xAC, 0xFF, 0xB4, 0x76, 0xD1, 0xB9, 0x7E, 0x4F, 0xE7, 0x2B, 0x9E,
+			0xE7, 0x7D, 0xB7, 0xF4, 0xF4, 0x9B, 0xD1, 0xC5, 0x67, 0xD2, 0x27, 0x87, 0xC3, 0xE1, 0x1B, 0xE1, 0x05, 0x1E, 0xC8, 0x7C,
+			0x3E, 0x27, 0xA8, 0x4F, 0xA5, 0x52, 0x2F, 0xCF, 0xCF, 0xC2, 0xE7, 0x99, 0x4E, 0xA7, 0x6F, 0x84, 0x17, 0x90, 0x87, 0x8A,
+			0xE3, 0xF8, 0x64, 0x78, 0xF8, 0x6B, 0x9B, 0xBF, 0x71, 0x35, 0xFF, 0xA9, 0x65, 0x59, 0x07, 0x0F, 0x1E, 0x1C, 0x8F, 0xC7,
+			0x87, 0xC7, 0xDF, 0xE0, 0x71, 0xD1, 0xBE, 0x0F, 0x49, 0x06, 0x27, 0x41, 0x58, 0x46, 0x8F, 0x31, 0x9A, 0x68, 0xFB, 0x85,
+			0x85, 0x45, 0xAD, 0x93, 0xE4, 0x8F, 0x38, 0x00, 0x6F, 0xC7, 0x99, 0x02, 0x22, 0x23, 0xE9, 0xE4, 0x00, 0x8A, 0x9A, 0x37,
+			0x0F, 0x6A, 0x7F, 0xB7, 0x17, 0xDE, 0x42, 0xA2, 0x75, 0xBE, 0x02, 0xA6, 0x8A, 0x70, 0x97, 0x05, 0xC0, 0xE4, 0x6D, 0xE4,
+			0x25, 0xC2, 0xF6, 0x11, 0xE1, 0x29, 0x3E, 0x10, 0xE3, 0x9B, 0x1C, 0xCD, 0x5D, 0x6A, 0x38, 0x01, 0xEF, 0xCA, 0xCA, 0x0A,
+			0x4D, 0x3C, 0x18, 0x01, 0x30, 0x00, 0x41, 0x9F, 0x84, 0x62, 0xC4, 0xE1, 0xC2, 0xDE, 0x11, 0xCC, 0xB1, 0x19, 0x08, 0x87,
+			0x05, 0xF7, 0x47, 0x5D, 0x03, 0x32, 0x02, 0xC9, 0x3D, 0x8D, 0x4A, 0x2B, 0x2B, 0x2B, 0x24, 0x64, 0x68, 0x19, 0x80, 0xE7,
+			0x70, 0xE5, 0xB3, 0xD9, 0x0C, 0xE2, 0x8D, 0x10, 0xA9, 0xA5, 0x53, 0x12, 0xD9, 0x02, 0xB2, 0x4F, 0x62, 0x73, 0x98, 0x5A,
+			0x80, 0x1F, 0x92, 0x03, 0x41, 0x13, 0x4A, 0x27, 0x2F, 0x80, 0x6B, 0x92, 0x33, 0x00, 0xC9, 0xC1, 0x60, 0x00, 0x00, 0x0B,
+			0x87, 0x8A, 0xA2, 0xB4, 0xF0, 0xFD, 0xB0, 0xEC, 0x08, 0x09, 0x0F, 0x93, 0x97, 0x30, 0x33, 0x25, 0x47, 0x07, 0x3F, 0xE7,
+			0x3A, 0x89, 0x87, 0xE8, 0x63, 0x80, 0xDD, 0xC4, 0xA2, 0x88, 0x2B, 0x82, 0x16, 0x81, 0x88, 0xA7, 0xB0, 0xB3, 0xB0, 0xBA,
+			0xC4, 0xC4, 0xA0, 0xC7, 0x6A, 0x39, 0x57, 0xFF, 0xE4, 0x4F, 0xA4, 0x54, 0x08, 0xCE, 0xC5, 0x32, 0x2B, 0xDB, 0x91, 0xE4,
+			0x44, 0x00, 0xA3, 0x2B, 0xC9, 0x05, 0x1D, 0x48, 0x1C, 0x54, 0x6E, 0x5F, 0xDD, 0x49, 0xDA, 0x77, 0xB9, 0x25, 0xDA, 0x5F,
+			0x81, 0x56, 0x89, 0xAF, 0x6B, 0xFF, 0xE9, 0x3F, 0xA9, 0x9E, 0xB7, 0xBD, 0x18, 0x24, 0x0C, 0xC3, 0x8D, 0x3D, 0xBD, 0x6C,
+			0xFE, 0x0A, 0xF7, 0xE3, 0x26, 0x71, 0x9C, 0x9B, 0xBC, 0x2D, 0x9D, 0x78, 0x60, 0x2A, 0x72, 0x38, 0xB4, 0xF2, 0xBE, 0x3F,
+			0xAF, 0x24, 0x31, 0x32, 0xDB, 0x6E, 0xF9, 0x7F, 0xFD, 0xDF, 0x74, 0xBE, 0x71, 0x9D, 0xE4, 0x0A, 0x90, 0xC6, 0x00, 0x85,
+			0x91, 0xB6, 0x26, 0x70, 0xE6, 0x71, 0xC0, 0x55, 0x25, 0xFC, 0x07, 0xDE, 0x91, 0x32, 0x23, 0x1F, 0x22, 0x23, 0x07, 0xC8,
+			0x10, 0xC7, 0xE3, 0x71, 0xA3, 0xD1, 0xC0, 0x39, 0xD3, 0x73, 0xA8, 0x72, 0xE3, 0xA4, 0xD3, 0x0D, 0x89, 0x08, 0xF5, 0xA0,
+			0x8A, 0xC5, 0xAD, 0xAD, 0x2D, 0x4E, 0x29, 0x5B, 0x87, 0xD8, 0x8D, 0x16, 0x36, 0xFA, 0xB0, 0x11, 0xA8, 0x01, 0x2C, 0x06,
+			0x9E, 0x66, 0x4F, 0x58, 0x56, 0x35, 0xD0, 0xE2, 0xD1, 0x6C, 0xB1, 0xCB, 0xC1, 0x88, 0x92, 0x4D, 0x13, 0xEA, 0x51, 0xA8,
+			0x87, 0xA1, 0x17, 0xEB, 0x8A, 0x27, 0x93, 0xA4, 0x5E, 0x96, 0x16, 0xEF, 0x22, 0xF2, 0x8B, 0x50, 0xD1, 0x2B, 0x4D, 0xD3,
+			0xD0, 0x34, 0xD3, 0xB6, 0x77, 0xC9, 0x1B, 0x8B, 0xD9, 0xC2, 0xB6, 0xD3, 0x91, 0xA1, 0xCE, 0x8F, 0xA6, 0xA9, 0x5B, 0xF0,
+			0x96, 0xBB, 0xE5, 0xB8, 0xDD, 0xBA, 0x6E, 0xA0, 0xE9, 0xFA, 0xEE, 0xC1, 0xF3, 0xD4, 0x56, 0x0C, 0x97, 0x71, 0xA4, 0x69,
+			0x2A, 0xAC, 0x36, 0x4D, 0x25, 0x98, 0xA5, 0x99, 0xCE, 0x6E, 0x09, 0x3A, 0x9F, 0x9E, 0x4F, 0x3C, 0x4D, 0xDB, 0xE5, 0x75,
+			0x2C, 0x97, 0x51, 0xB2, 0x56, 0xBB, 0x94, 0xD0, 0x20, 0xD0, 0x62, 0xF5, 0xED, 0x66, 0x10, 0x28, 0x24, 0x8A, 0x86, 0x06,
+			0xD7, 0x75, 0x69, 0x7B, 0xF1, 0x93, 0x97, 0x69, 0x9A, 0xA2, 0x80, 0xC8, 0xF1, 0x9B, 0x4C, 0x26, 0x6B, 0x6B, 0x6B, 0x3B,
+			0x3B, 0x3B, 0x14, 0x81, 0x1A, 0x8D, 0x06, 0x7D, 0xA4, 0x30, 0xE1, 0x34, 0x4D, 0xCB, 0xE7, 0xF3, 0xD0, 0xB7, 0xD4, 0x12,
+			0x57, 0x2A, 0x82, 0x03, 0x90, 0x65, 0xC2, 0x56, 0x80, 0x80, 0x0B, 0x0D, 0x03, 0x4C, 0x09, 0xF9, 0x0D, 0xF8, 0xD1, 0xF4,
+			0x64, 0x06, 0x41, 0xB0, 0xB6, 0xB6, 0x86, 0x28, 0x2E, 0xE8, 0x0D, 0x7D, 0x3A, 0x9C, 0x4F, 0xA9, 0x55, 0x02, 0x37, 0x01,
+			0x08, 0xB4, 0x5A, 0xAD, 0x27, 0xEC, 0xA3, 0xB9, 0x5C, 0xEE, 0x8D, 0xCC, 0x85, 0x23, 0xD3, 0xBB, 0xE9, 0x01, 0x84, 0x72,
+			0x83, 0xD4, 0xF0, 0xD5, 0xFC, 0xA7, 0xCB, 0xE5, 0xF2, 0xE1, 0xF4, 0x3D, 0xA5, 0x74, 0xE9, 0xD1, 0xE5, 0xA1, 0xB9, 0x3F,
+			0x3F, 0x63, 0x1E, 0x37, 0x1D, 0x25, 0xBE, 0xA8, 0x69, 0xDA, 0x51, 0xEF, 0x3E, 0x67, 0x55, 0x3D, 0xBA, 0xFF, 0x6F, 0xFB,
+			0xAF, 0x74, 0x5D, 0xFF, 0xCB, 0xFE, 0xAB, 0x9A, 0xA6, 0x3D, 0x1F, 0x3D, 0x1E, 0x1B, 0xF1, 0xD6, 0xD6, 0x56, 0x5F, 0xEF,
+			0x53, 0x7F, 0x36, 0x0C, 0xE3, 0x69, 0xFB, 0x44, 0xB2, 0x9A, 0xC6, 0x19, 0xFD, 0xF8, 0xB4, 0x37, 0x55, 0x34, 0x87, 0xB2,
+			0x3A, 0x45, 0x17, 0x9D, 0xEB, 0x91, 0x1D, 0x7D, 0xA7, 0xF0, 0x54, 0xF2, 0x78, 0x83, 0x77, 0xCD, 0x6B, 0xCF, 0xE5, 0x4F,
+			0xC7, 0x71, 0xFC, 0xFD, 0xDE, 0x2B, 0xB6, 0x6D, 0x3F, 0x1A, 0x1F, 0xC2, 0xCA, 0x3F, 0x6A, 0x1C, 0xEA, 0x8C, 0x3A, 0x0E,
+			0xAF, 0xC8, 0xF9, 0xE1, 0xE8, 0xF5, 0xDF, 0xD4, 0xBF, 0x2E, 0xA1, 0xBD, 0x98, 0x7E, 0x30, 0x3A, 0x00, 0x0D, 0x4C, 0xB0,
+			0x08, 0x3A, 0x21, 0xB2, 0x24, 0x08, 0xA1, 0xC4, 0xFE, 0x22, 0x1C, 0x24, 0x0A, 0x6E, 0x52, 0x01, 0x16, 0xB9, 0x7C, 0x31,
+			0xAF, 0xEC, 0x37, 0x41, 0xF3, 0xC5, 0x40, 0xB3, 0x0A, 0x34, 0x87, 0xCB, 0x27, 0xAB, 0xA8, 0x67, 0xAF, 0xBB, 0x45, 0x5A,
+			0x49, 0x44, 0x86, 0x6B, 0xB1, 0x58, 0x7C, 0xD2, 0xBD, 0xAC, 0x3E, 0x6A, 0xB1, 0x0B, 0x16, 0xF9, 0x13, 0x65, 0x3A, 0x14,
+			0x3A, 0xE1, 0x29, 0x87, 0x11, 0xF6, 0x94, 0x45, 0x33, 0x2D, 0xD3, 0xF7, 0x7C, 0x75, 0x47, 0x1D, 0xC5, 0x62, 0x82, 0x5E,
+			0x8C, 0xEF, 0xC9, 0xCE, 0xB3, 0xD8, 0xBE, 0xDD, 0x71, 0x1D, 0xBE, 0x11, 0x8E, 0x76, 0xBD, 0x20, 0x56, 0xE2, 0x93, 0xEE,
+			0xE5, 0x5D, 0x63, 0x97, 0x38, 0xB9, 0xC4, 0xBC, 0xA4, 0x23, 0x2D, 0x8A, 0xBD, 0xD8, 0x1F, 0xED, 0x9A, 0xA9, 0xD0, 0xDF,
+			0xE5, 0x47, 0xDD, 0x1A, 0xA9, 0xBB, 0x36, 0x02, 0x95, 0xE3, 0x5A, 0x9E, 0x2A, 0xA7, 0xFD, 0xCD, 0xB6, 0x72, 0xF9, 0xA9,
+			0x30, 0x15, 0x8E, 0xD5, 0x95, 0x6C, 0xCD, 0x74, 0x6D, 0xA6, 0x12, 0xA3, 0x64, 0xFF, 0x07, 0xBF, 0x99, 0x4A, 0xFD, 0xF0,
+			0xF5, 0xBF, 0x07, 0xD2, 0x00, 0x8F, 0x22, 0xE4, 0x27, 0x35, 0xDF, 0x4F, 0x8B, 0x22, 0xF7, 0x95, 0xA2, 0x0E, 0x29, 0x8E,
+			0xC8, 0xB2, 0x4A, 0x29, 0x8E, 0x0F, 0x91, 0xA9, 0x06, 0x61, 0x18, 0xBE, 0x76, 0x7E, 0xDB, 0x75, 0x55, 0xC7, 0x99, 0xEF,
+			0xB7, 0x20, 0xAA, 0x81, 0x19, 0xEC, 0x27, 0xEC, 0x26, 0x47, 0x78, 0x57, 0x03, 0x9C, 0xA5, 0x4C, 0xCE, 0xC2, 0xAF, 0xC0,
+			0x54, 0x6C, 0x5B, 0x89, 0xB0, 0x11, 0x49, 0xC7, 0x71, 0x5F, 0x4E, 0xA5, 0xB2, 0x21, 0xB7, 0xFF, 0xD5, 0xBF, 0x52, 0x06,
+			0x3D, 0xE9, 0x4D, 0x85, 0x15, 0x4B, 0x97, 0x13, 0x2C, 0x69, 0x02, 0x73, 0x29, 0x2F, 0xA8, 0x43, 0xFE, 0x1F, 0xFE, 0xC3,
+			0xF8, 0x4F, 0xFE, 0x44, 0x08, 0x67, 0x74, 0x45, 0x23, 0x7E, 0xC2, 0x39, 0x1F, 0xEF, 0x05, 0xDA, 0x9D, 0x3D, 0x92, 0x38,
+			0x65, 0x1C, 0x81, 0xFF, 0x60, 0x98, 0x88, 0xFC, 0x05, 0x72, 0x31, 0x64, 0xEE, 0x0A, 0x08, 0xFB, 0xE3, 0x3F, 0x6E, 0xFE,
+			0xDB, 0x7F, 0xAB, 0x44, 0xAF, 0x12, 0xB0, 0x2F, 0xD8, 0xCB, 0xA9, 0xC9, 0xE3, 0xE8, 0x2E, 0x26, 0x57, 0x40, 0x92, 0x21,
+			0x9D, 0xAB, 0x43, 0x28, 0xA4, 0x5A, 0x20, 0x8A, 0x7D, 0xFB, 0xA5, 0x31, 0x61, 0x2B, 0x8D, 0x46, 0x23, 0xA8, 0x14, 0xCB,
+			0xE5, 0x72, 0x75, 0x75, 0x95, 0x26, 0x5E, 0xC8, 0xD7, 0x62, 0x86, 0x6A, 0xB5, 0xDA, 0xCE, 0xCE, 0x0E, 0x4F, 0x0D, 0x58,
+			0xD6, 0xF3, 0xBC, 0x46, 0xA3, 0x01, 0xD1, 0x08, 0x09, 0x7B, 0x56, 0x31, 0x97, 0xCB, 0x6D, 0x6D, 0x6D, 0x01, 0x7D, 0x52,
+			0xB3, 0x2D, 0x95, 0x4A, 0xCB, 0xE5, 0x12, 0x15, 0x0D, 0x3C, 0x28, 0xE5, 0x62, 0x10, 0x58, 0xA8, 0x8D, 0x14, 0x21, 0x68,
+			0x21, 0xA6, 0x19, 0x98, 0x4F, 0x0B, 0x35, 0x55, 0xAC, 0x8B, 0x53, 0x46, 0x10, 0xCC, 0x2D, 0xCB, 0x0D, 0x96, 0xCA, 0xB7,
+			0x05, 0x41, 0xAC, 0xA7, 0x95, 0xF3, 0x53, 0x02, 0x99, 0x33, 0x5F, 0xD7, 0x53, 0xDE, 0x5C, 0xC5, 0x56, 0xE0, 0x27, 0x6A,
+			0x9B, 0x1A, 0xBA, 0x16, 0xED, 0x36, 0x6A, 0xB1, 0x63, 0xA6, 0x8B, 0xE5, 0x32, 0xD0, 0xAC, 0x9C, 0x15, 0x2C, 0x63, 0x4D,
+			0x53, 0x0F, 0x7C, 0xAF, 0x66, 0xA0, 0x36, 0x87, 0xEF, 0x07, 0xCB, 0xA5, 0x66, 0xDB, 0xE9, 0xF9, 0x52, 0xF1, 0x8B, 0x74,
+			0x3D, 0xD0, 0xE2, 0xDD, 0x86, 0x9D, 0x30, 0xE6, 0x7F, 0xE3, 0x3D, 0x67, 0xA9, 0x1C, 0x8E, 0x16, 0xA7, 0xB4, 0x45, 0x28,
+			0xB8, 0x90, 0x66, 0x9A, 0x8B, 0xD0, 0x4F, 0xA7, 0xED, 0xC8, 0x88, 0xC7, 0xF3, 0x65, 0x18, 0xEA, 0xBE, 0xBF, 0xBB, 0xA6,
+			0xD2, 0x62, 0xCD, 0x99, 0x04, 0x21, 0x15, 0xFC, 0x87, 0xF6, 0x4B, 0xF6, 0x12, 0x51, 0x4C, 0xA1, 0x50, 0x80, 0x0F, 0x9A,
+			0x6C, 0x6B, 0x65, 0x9D, 0x49, 0xAA, 0xB0, 0xE9, 0xEB, 0xEB, 0xEB, 0xA2, 0x52, 0xA7, 0xA2, 0xA9, 0x04, 0xB0, 0x86, 0x47,
+			0xB0, 0x9F, 0xA4, 0xC4, 0x6F, 0x69, 0x0B, 0x47, 0x8C, 0x16, 0xF1, 0x41, 0xA4, 0xE5, 0xD6, 0xD7, 0xD7, 0x51, 0x28, 0xDA,
+			0x43, 0xAD, 0xFE, 0x57, 0x5F, 0x05, 0x75, 0x66, 0x1A, 0x4D, 0x32, 0xB1, 0xAA, 0x51, 0xEB, 0xBA, 0xFE, 0xEA, 0xE2, 0x5C,
+			0x26, 0x56, 0xF4, 0x9E, 0x97, 0xE3, 0xB3, 0xC7, 0x8C, 0xFB, 0xAF, 0xE4, 0x6E, 0xA2, 0x0F, 0xF1, 0x66, 0x74, 0x51, 0xA5,
+			0xD8, 0xBE, 0xF5, 0xED, 0xFC, 0x93, 0x9D, 0x69, 0x27, 0x5D, 0x48, 0x8F, 0x5B, 0x4A, 0xA3, 0x11, 0xF4, 0xE9, 0x7A, 0xE5,
+			0x67, 0xBA, 0xA6, 0x9D, 0x4B, 0x5D, 0x3D, 0x3E, 0x7D, 0xC0, 0xB2, 0xAC, 0x3F, 0xA8, 0x3D, 0xCF, 0xBD, 0x9C, 0x4B, 0x5D,
+			0x8D, 0x26, 0x4A, 0x85, 0xF4, 0xF7, 0xAD, 0x6F, 0xD2, 0x20, 0x1D, 0x04, 0xC1, 0xC3, 0xB3, 0x7B, 0x2E, 0xB9, 0x37, 0x9E,
+			0x4F, 0x3F, 0x2E, 0xA5, 0x78, 0xCA, 0xD1, 0xD8, 0xCD, 0x7F, 0x5E, 0xFF, 0xD6, 0xE6, 0xE6, 0x26, 0x4D, 0x3D, 0x27, 0x82,
+			0x07, 0x6F, 0xDF, 0xBE, 0xFD, 0x41, 0xF1, 0xF3, 0x6F, 0xE5, 0x9E, 0x08, 0x82, 0xE0, 0x95, 0xF8, 0x9D, 0xC7, 0xDD, 0x47,
+			0x08, 0x24, 0x1D, 0xC7, 0xC9, 0xE5, 0x72, 0xC7, 0xBA, 0xF7, 0x57, 0xD7, 0xAA, 0xBF, 0x37, 0x7F, 0xEE, 0xC3, 0x5F, 0xFD,
+			0xBD, 0x14, 0x6F, 0x89, 0xDF, 0x65, 0x2A, 0xC0, 0xFE, 0x7F, 0x4B, 0x8D, 0x94, 0xFA, 0x8A, 0xA4, 0x05, 0xD2, 0x7F, 0x2E,
+			0xA8, 0xB7, 0x34, 0x0C, 0x22, 0xC8, 0x2A, 0x2A, 0xB3, 0xC2, 0x55, 0x13, 0xCA, 0xC0, 0x7E, 0x1E, 0x01, 0x41, 0x34, 0x4D,
+			0x36, 0x12, 0xE6, 0x8B, 0x63, 0x10, 0x31, 0x35, 0xB1, 0x03, 0x20, 0x51, 0xB3, 0xD9, 0xEC, 0x9E, 0xEA, 0xC3, 0x34, 0xD0,
+			0x60, 0x7F, 0xB3, 0xD9, 0x2C, 0x8E, 0x79, 0x38, 0x1C, 0x8A, 0xC8, 0x2B, 0x50, 0x7E, 0x2A, 0xA3, 0xC0, 0x9F, 0xBF, 0x99,
+			0x7F, 0x72, 0x47, 0xF1, 0x10, 0x92, 0x12, 0x44, 0x51, 0x0A, 0x32, 0xCA, 0x67, 0x00, 0x8B, 0x76, 0xAF, 0x39, 0xAB, 0xF6,
+			0x0C, 0x70, 0xA8, 0x6E, 0xA8, 0xF2, 0x9E, 0xEA, 0x40, 0xCC, 0xAB, 0x02, 0x95, 0x61, 0x1B, 0xC5, 0x62, 0x91, 0x3F, 0xCF,
+			0xE7, 0xF3, 0xED, 0x76, 0xDB, 0xD0, 0x8C, 0x6A, 0xBD, 0xAA, 0xE4, 0xC1, 0xB3, 0x4A, 0x0E, 0xFA, 0xEF, 0x8D, 0x9F, 0x7E,
+			0xC9, 0xBE, 0x53, 0xED, 0x64, 0x4D, 0x1D, 0x28, 0x85, 0x2F, 0xD5, 0xB3, 0xC0, 0xB0, 0x38, 0x1E, 0xB4, 0x4C, 0x4A, 0xD5,
+			0x12, 0x25, 0xB4, 0xA3, 0xC7, 0xFF, 0x56, 0xE0, 0x59, 0xE2, 0xFD, 0x7E, 0xBF, 0x8F, 0x22, 0x32, 0x40, 0x05, 0x0F, 0x8A,
+			0xDE, 0x14, 0x4D, 0xD3, 0xFE, 0xEC, 0x47, 0x5F, 0x3C, 0xF5, 0xE8, 0x1A, 0xAD, 0xBC, 0x08, 0x7F, 0x52, 0x50, 0x94, 0x26,
+			0x80, 0xFD, 0x30, 0x14, 0x39, 0x31, 0x18, 0x03, 0xED, 0xEB, 0x88, 0xE8, 0x88, 0xEE, 0x8B, 0x0C, 0x00, 0xA0, 0x02, 0x6A,
+			0x9A, 0xE6, 0x5F, 0xBE, 0xFA, 0x77, 0x8F, 0x3F, 0x52, 0x03, 0xD1, 0xC5, 0x82, 0xF1, 0xFC, 0x81, 0x86, 0x44, 0x4A, 0x1A,
+			0x60, 0x46, 0x55, 0x52, 0xA1, 0xA9, 0x0D, 0x06, 0x03, 0x9A, 0x7E, 0x21, 0xD8, 0xF6, 0x7A, 0x3D, 0x99, 0x1D, 0x21, 0x71,
+			0x04, 0xA0, 0x1B, 0xB7, 0x8D, 0x82, 0xB6, 0xA4, 0x4E, 0xD2, 0x5B, 0x48, 0x9B, 0x03, 0x1F, 0x02, 0x36, 0x4D, 0x6B, 0x3E,
+			0xED, 0x70, 0x84, 0x12, 0x34, 0x3E, 0x70, 0x89, 0x7C, 0x14, 0xC5, 0x1F, 0x01, 0xFD, 0xD9, 0x7C, 0xA2, 0x69, 0x23, 0xC2,
+			0xCE, 0xD4, 0x6A, 0x64, 0x2F, 0x42, 0x19, 0x06, 0xB2, 0x87, 0x4E, 0x5E, 0x4F, 0x5E, 0x94, 0xB9, 0x80, 0xB0, 0x98, 0x01,
+			0x42, 0xE3, 0x22, 0x89, 0x02, 0x82, 0xF2, 0x04, 0x20, 0xC3, 0xE1, 0x90, 0x2F, 0x85, 0x17, 0x8C, 0xEA, 0x67, 0x12, 0x27,
+			0xA5, 0xF8, 0xAB, 0xDD, 0xF9, 0x0F, 0x89, 0xDA, 0x73, 0xBB, 0xDD, 0x5E, 0x2C, 0x16, 0x1C, 0x21, 0xC9, 0x78, 0x00, 0x94,
+			0x78, 0x26, 0xC2, 0x80, 0x82, 0x93, 0x50, 0xAF, 0xD7, 0x21, 0x00, 0x48, 0x13, 0x0D, 0x4D, 0x64, 0x48, 0x57, 0x36, 0x9B,
+			0x4D, 0xB6, 0x0B, 0x0E, 0x5F, 0xDA, 0x38, 0xF1, 0x25, 0x89, 0xB1, 0xDE, 0x4D, 0xAE, 0x45, 0xEB, 0x9F, 0x33, 0xBC, 0x9F,
+			0x06, 0x2A, 0x42, 0xF0, 0xA2, 0xE2, 0x20, 0xAA, 0x00, 0xA2, 0xCD, 0x8B, 0x1A, 0x04, 0xC1, 0x11, 0x6C, 0xE8, 0x5D, 0x8B,
+			0xBF, 0x97, 0x2A, 0x8A, 0x63, 0x16, 0xA5, 0x07, 0x61, 0xC5, 0xE1, 0xB9, 0xF7, 0x37, 0xEE, 0xC3, 0x37, 0x10, 0x97, 0x80,
+			0x1D, 0xA4, 0x5C, 0xC4, 0x9C, 0x1C, 0xFA, 0x77, 0xE8, 0x9B, 0x13, 0x20, 0x92, 0x76, 0x4D, 0xDC, 0x2D, 0xE5, 0xC4, 0x56,
+			0xAB, 0xC5, 0x34, 0x21, 0xD5, 0xD6, 0xE0, 0x38, 0x70, 0xD4, 0x6E, 0xDF, 0xBE, 0x5D, 0x4C, 0x5E, 0x20, 0x69, 0xF8, 0x06,
+			0x8A, 0x66, 0x18, 0x1D, 0xF2, 0x83, 0x5A, 0xAD, 0x06, 0x0F, 0x6D, 0x32, 0x99, 0x30, 0x48, 0x80, 0xE7, 0x8F, 0x62, 0x6B,
+			0xB1, 0x58, 0x04, 0xE7, 0x41, 0xBB, 0x11, 0x53, 0x0B, 0xE1, 0x58, 0x14, 0x46, 0x91, 0x69, 0x42, 0x29, 0x45, 0xD7, 0xF5,
+			0xCD, 0xCD, 0xCD, 0x28, 0x8A, 0x9E, 0x30, 0x8E, 0x3E, 0x32, 0xBF, 0x97, 0x6C, 0x03, 0x44, 0x85, 0xE6, 0x98, 0x67, 0x9D,
+			0x53, 0x8D, 0x46, 0xE3, 0x9F, 0xD7, 0xBF, 0xD5, 0xE9, 0x74, 0xEA, 0x75, 0x35, 0x7E, 0xEB, 0x4A, 0xEE, 0x26, 0xE2, 0x2A,
+			0x1F, 0x96, 0x7E, 0x7A, 0x6F, 0xF7, 0x77, 0x0C, 0xC3, 0x78, 0xC2, 0x38, 0xEA, 0x38, 0xCE, 0x5B, 0xF1, 0x25, 0x1E, 0x3B,
+			0x20, 0xEA, 0x64, 0x32, 0x01, 0xF3, 0x14, 0x91, 0x99, 0x9B, 0xF5, 0xBF, 0x3D, 0xEE, 0x3F, 0x00, 0x9E, 0xF0, 0x13, 0xFF,
+			0x5D, 0xD1, 0xC1, 0x87, 0x30, 0xD6, 0x6A, 0xB5, 0x2E, 0xA4, 0x3F, 0xBA, 0x9A, 0xFF, 0x14, 0x34, 0xA0, 0x5E, 0xAF, 0xBF,
+			0x50, 0x38, 0x83, 0x82, 0xE1, 0x37, 0x33, 0x8F, 0x89, 0x4C, 0x0B, 0x45, 0x08, 0x4D, 0xD3, 0xFE, 0xFB, 0xD6, 0x0F, 0xDE,
+			0x31, 0xAE, 0x10, 0x8A, 0xCA, 0x2C, 0x2D, 0x1E, 0xD4, 0x7E, 0x44, 0x5E, 0xD0, 0x18, 0x1A, 0x9A, 0x84, 0xC1, 0x21, 0xA3,
+			0xD9, 0x44, 0x00, 0x5C, 0x05, 0xC2, 0x7B, 0xCA, 0xB5, 0x84, 0x47, 0xC2, 0x3A, 0x13, 0xAA, 0x02, 0xA0, 0x8D, 0xE8, 0xCA,
+			0xC9, 0x4F, 0x84, 0x81, 0x2A, 0xD8, 0x9A, 0x54, 0x86, 0xA5, 0x08, 0x21, 0xE4, 0x1F, 0xE2, 0x65, 0xE0, 0x62, 0x29, 0x54,
+			0x90, 0xB5, 0x8B, 0x4C, 0x13, 0xCD, 0xE4, 0xA4, 0x08, 0x22, 0xCF, 0x8E, 0x4D, 0x40, 0x1E, 0x8A, 0xD2, 0x05, 0xF6, 0x91,
+			0x0E, 0x0F, 0x38, 0x26, 0xF0, 0xFD, 0x28, 0x6D, 0xD2, 0x9D, 0x23, 0x47, 0x12, 0x64, 0x06, 0x4B, 0xC2, 0x0D, 0xAA, 0xD9,
+			0x6A, 0x49, 0x6F, 0x0A, 0x4D, 0x2A, 0x94, 0xA9, 0x81, 0x1C, 0xB1, 0x9B, 0x94, 0x1F, 0x04, 0xCE, 0x92, 0x34, 0x88, 0x81,
+			0x04, 0x70, 0x52, 0xA5, 0xCF, 0x1F, 0x0B, 0x53, 0xAB, 0xD5, 0xD0, 0x7B, 0xA0, 0xE0, 0x2F, 0xBC, 0x23, 0xEA, 0x4F, 0x40,
+			0x5E, 0x42, 0xB1, 0x83, 0x48, 0x8A, 0x2A, 0x5C, 0xA9, 0x54, 0x62, 0xAB, 0x14, 0x0A, 0x85, 0x5C, 0x2E, 0x07, 0xDC, 0x8D,
+			0xF5, 0x47, 0x40, 0x01, 0xDC, 0x18, 0x17, 0xCB, 0xA1, 0x58, 0x2C, 0x16, 0xB0, 0x37, 0x91, 0x25, 0xEE, 0x74, 0x3A, 0xF3,
+			0xF9, 0x1C, 0xF6, 0xA7, 0xB4, 0x34, 0xCA, 0x54, 0x00, 0x8A, 0x46, 0x84, 0xA4, 0xC8, 0x77, 0x2B, 0x1A, 0x18, 0x7C, 0x03,
+			0x46, 0x35, 0xC9, 0xD9, 0x96, 0x51, 0x2D, 0x54, 0xE4, 0xE8, 0x89, 0xED, 0x74, 0x3A, 0x30, 0xB8, 0xA9, 0xB9, 0x73, 0x65,
+			0x00, 0x70, 0x02, 0x75, 0x51, 0xE3, 0x42, 0xCD, 0x83, 0x15, 0xA2, 0xBE, 0x0F, 0x73, 0x99, 0x9F, 0xD0, 0x7E, 0xC5, 0x3C,
+			0x0D, 0x6A, 0xCB, 0x08, 0xE3, 0x61, 0xA4, 0xC8, 0x71, 0xC8, 0xAC, 0xA9, 0x60, 0x48, 0xA9, 0x87, 0x30, 0x10, 0x06, 0x15,
+			0x9E, 0xA3, 0xDB, 0xED, 0xEE, 0x17, 0xCC, 0x5B, 0x2C, 0x16, 0xAD, 0x56, 0x8B, 0xC0, 0x0A, 0x50, 0x6B, 0x7B, 0x7B, 0x9B,
+			0xB2, 0x4F, 0x2E, 0x97, 0xA3, 0xC5, 0x17, 0xFD, 0xBC, 0xC9, 0x64, 0xB2, 0xB5, 0xB5, 0xA5, 0x82, 0x5C, 0xB5, 0xC3, 0x55,
+			0x6D, 0x16, 0xB7, 0x84, 0x21, 0xA3, 0xE1, 0x00, 0xAA, 0x8C, 0x78, 0x1D, 0x94, 0xCB, 0x28, 0x5C, 0x13, 0xA6, 0x29, 0x5C,
+			0x2B, 0x29, 0x87, 0x50, 0x08, 0x42, 0xBE, 0x94, 0x46, 0x1E, 0xAA, 0xA6, 0xD0, 0xD4, 0x38, 0xF0, 0xC8, 0x57, 0xE0, 0x81,
+			0xA9, 0x03, 0xC7, 0x71, 0x4C, 0x00, 0x28, 0xCA, 0x53, 0x52, 0xAC, 0x83, 0xAE, 0xC3, 0xD9, 0xE0, 0xF3, 0x25, 0xC6, 0x97,
+			0x78, 0x5F, 0x58, 0x3D, 0xF2, 0x09, 0xE2, 0x86, 0x85, 0x03, 0xCE, 0xC3, 0x01, 0x12, 0xDD, 0x1F, 0x35, 0x08, 0x5E, 0x0C,
+			0x95, 0x96, 0xB4, 0x54, 0x46, 0x0B, 0x20, 0xA3, 0xB6, 0xFF, 0x00, 0x27, 0xE9, 0x88, 0xA2, 0x63, 0x8B, 0xCA, 0x34, 0x97,
+			0xB1, 0x5F, 0xF2, 0x77, 0x3F, 0x0F, 0x04, 0x84, 0x54, 0xDA, 0x4D, 0x09, 0x8B, 0xA0, 0x2A, 0x71, 0x8A, 0x1C, 0xC7, 0x69,
+			0x34, 0x1A, 0xB7, 0x6E, 0xDD, 0x12, 0xE5, 0x22, 0x58, 0xB6, 0x4C, 0x56, 0xC2, 0x06, 0xE1, 0xBA, 0x88, 0x04, 0xEB, 0xF5,
+			0x3A, 0x94, 0x47, 0x91, 0x97, 0x61, 0x83, 0x41, 0x1A, 0x66, 0xA3, 0x52, 0xB6, 0xA1, 0xFE, 0x8F, 0xB9, 0xE4, 0x46, 0x86,
+			0xC3, 0x21, 0x28, 0x30, 0x07, 0x06, 0x91, 0x51, 0xB4, 0x2B, 0x14, 0xAA, 0x7B, 0xBF, 0xEA, 0x7A, 0xB1, 0x6D, 0xFB, 0x92,
+			0x7B, 0x03, 0x8D, 0x01, 0x42, 0xAD, 0x33, 0xE6, 0xF1, 0x1F, 0x0C, 0x7E, 0x02, 0x51, 0xF8, 0x44, 0xF0, 0xE0, 0x6C, 0x36,
+			0xBB, 0xB1, 0xF2, 0xF3, 0x0B, 0xE9, 0x8F, 0xD0, 0x0A, 0x35, 0x4D, 0xF3, 0xAC, 0x7E, 0xF9, 0xD0, 0xF0, 0x8E, 0x4B, 0xEE,
+			0x8D, 0xFE, 0xFD, 0xAA, 0x2F, 0xC4, 0xB6, 0xED, 0x07, 0x06, 0x5F, 0x47, 0x82, 0xB8, 0x54, 0x2A, 0x1D, 0x1E, 0x7F, 0x23,
+			0x9B, 0xCD, 0xDE, 0x58, 0xF9, 0x39, 0x4C, 0xAA, 0xC1, 0x60, 0xF0, 0x8E, 0x71, 0xE5, 0xA8, 0x77, 0x5F, 0x3A, 0x9D, 0xFE,
+			0x89, 0xFF, 0xEE, 0x8F, 0xA7, 0x6F, 0x61, 0x9E, 0x40, 0xBA, 0x47, 0x0F, 0xCE, 0xE7, 0xF3, 0xF9, 0xE7, 0x6B, 0xFF, 0xF3,
+			0xBB, 0xA5, 0xA7, 0x4F, 0x45, 0x0F, 0xD9, 0xB6, 0x7D, 0xD1, 0xB9, 0xFE, 0xAE, 0x79, 0x6D, 0x38, 0x1C, 0x42, 0x1D, 0xA1,
+			0xC7, 0xE2, 0xC6, 0xCA, 0xCF, 0xAB, 0xD5, 0xEA, 0xBF, 0x38, 0xF0, 0xBB, 0xE8, 0xE0, 0xFE, 0xDE, 0xCA, 0x73, 0x32, 0xB0,
+			0x05, 0x03, 0xBD, 0x7F, 0x18, 0x80, 0x0C, 0xD5, 0x61, 0x3F, 0x70, 0xE0, 0x79, 0x83, 0xF2, 0x15, 0xFB, 0x22, 0x74, 0x99,
+			0x1B, 0x01, 0xDA, 0x06, 0x84, 0x4B, 0x50, 0x29, 0x7D, 0x24, 0xFC, 0x56, 0xF4, 0xA0, 0xD4, 0x8E, 0x0C, 0xC3, 0xFD, 0x5C,
+			0x20, 0x41, 0xF3, 0xA0, 0xE2, 0xE0, 0xD7, 0x45, 0xBD, 0x5C, 0x48, 0xC3, 0xC2, 0x50, 0x82, 0xB4, 0xCE, 0x19, 0x87, 0x9E,
+			0x4B, 0xEF, 0x8E, 0x14, 0x20, 0xD9, 0xCC, 0xC4, 0xC8, 0x3C, 0x55, 0xD2, 0x59, 0xA1, 0xE8, 0x50, 0x9E, 0x45, 0xC5, 0x12,
+			0x14, 0x91, 0x71, 0x55, 0x28, 0x4B, 0x63, 0xF7, 0x31, 0xD0, 0x08, 0x72, 0xE0, 0x78, 0x64, 0x90, 0x89, 0x8C, 0x9E, 0x75,
+			0x5D, 0x97, 0x76, 0x22, 0xF8, 0x8D, 0xA4, 0x95, 0xA8, 0xBC, 0x40, 0xAC, 0x20, 0x7D, 0xA1, 0x9F, 0x11, 0x5C, 0x44, 0xB9,
+			0xB8, 0xA4, 0x4D, 0x01, 0x3D, 0x02, 0xC0, 0x61, 0x99, 0x35, 0xC4, 0xAA, 0xD1, 0x6D, 0x8E, 0x98, 0xA0, 0xF0, 0xB0, 0xB1,
+			0x96, 0x14, 0x26, 0x19, 0x61, 0x26, 0x2D, 0xC1, 0x3C, 0x1C, 0x2A, 0xAC, 0x32, 0x49, 0x94, 0xE3, 0x00, 0xB0, 0x0C, 0x7A,
+			0x01, 0xDC, 0x8A, 0x94, 0x00, 0xF6, 0x87, 0x63, 0x2B, 0x88, 0x1F, 0x85, 0x16, 0xE4, 0x4F, 0x40, 0x2C, 0xB8, 0x23, 0xD5,
+			0xE0, 0x99, 0x0C, 0x41, 0x13, 0x05, 0x69, 0x80, 0x1F, 0x43, 0xC4, 0xD1, 0xE8, 0xE3, 0xA5, 0x44, 0x86, 0xEE, 0x0D, 0x53,
+			0x3B, 0x10, 0x5F, 0x44, 0xE9, 0x37, 0x39, 0x7A, 0xBB, 0xDD, 0x13, 0xF0, 0x40, 0x68, 0x83, 0x86, 0x6B, 0x25, 0x12, 0x40,
+			0xD0, 0x3C, 0x64, 0x42, 0x2F, 0x08, 0x38, 0x64, 0x0C, 0x04, 0x37, 0x72, 0xB9, 0x1C, 0xF1, 0x08, 0x7C, 0x0C, 0x41, 0x75,
+			0x04, 0x1C, 0x44, 0x18, 0x0B, 0x62, 0x8C, 0xE8, 0xD8, 0x60, 0x76, 0x19, 0x1D, 0x03, 0x75, 0x15, 0xAE, 0x15, 0xE0, 0x3B,
+			0xB3, 0x47, 0xD6, 0xD6, 0xD6, 0xE8, 0xB0, 0xDF, 0xD8, 0xD8, 0xC0, 0x8B, 0x50, 0xFB, 0x86, 0x34, 0x42, 0xAB, 0x97, 0xC0,
+			0x64, 0x68, 0x0A, 0x0A, 0x29, 0x8A, 0xA6, 0x1B, 0xE1, 0xEC, 0x33, 0xFD, 0x07, 0xF6, 0x2E, 0x4D, 0xAA, 0x44, 0xD0, 0xD4,
+			0x15, 0xA9, 0x47, 0xB5, 0xDB, 0x6D, 0x96, 0xDF, 0xF7, 0xFD, 0x56, 0x4B, 0x21, 0x74, 0x9C, 0x16, 0x94, 0xCA, 0x69, 0x46,
+			0xAB, 0xD7, 0xEB, 0xCC, 0x2A, 0x2A, 0x25, 0xAF, 0x9D, 0x9D, 0x9D, 0xF9, 0x7C, 0x3E, 0x1C, 0x0E, 0x9B, 0xCD, 0x66, 0xA5,
+			0xA2, 0xCA, 0x19, 0xC3, 0xD0, 0x2D, 0xBA, 0xA9, 0x6A, 0xC1, 0x71, 0x8C, 0x00, 0xCD, 0x67, 0x5B, 0xF3, 0x0B, 0x8E, 0x91,
+			0x31, 0x55, 0xD7, 0x2E, 0xBF, 0x42, 0x0E, 0xBA, 0x39, 0x51, 0x39, 0xB5, 0x9B, 0x52, 0x55, 0xDF, 0xDB, 0x03, 0x25, 0xD4,
+			0x9C, 0xB5, 0x62, 0xC7, 0x08, 0x3A, 0x9E, 0xB9, 0x39, 0x0C, 0x1D, 0x23, 0x70, 0x53, 0xA1, 0x9B, 0x0A, 0x5B, 0x33, 0x23,
+			0x9F, 0xD6, 0x0B, 0x8E, 0x51, 0x70, 0x54, 0x91, 0x76, 0x7B, 0x1C, 0x17, 0xDD, 0x54, 0xC1, 0x31, 0x4A, 0x19, 0xB3, 0x39,
+			0x51, 0xCA, 0xD2, 0x45, 0x37, 0x95, 0x4F, 0xEB, 0x9B, 0xC3, 0x70, 0x18, 0xBA, 0xD5, 0x82, 0x53, 0x74, 0x53, 0x69, 0x7D,
+			0xC9, 0x77, 0xA9, 0x06, 0x7A, 0x47, 0xC9, 0x44, 0xB7, 0xE7, 0xAA, 0x2E, 0x5D, 0x74, 0x53, 0x56, 0xBC, 0xD8, 0x1E, 0x2B,
+			0x95, 0x69, 0x2E, 0xA3, 0x35, 0x33, 0xB6, 0x46, 0x4A, 0x50, 0x9A, 0xAF, 0xA6, 0x35, 0x14, 0x61, 0x25, 0x64, 0x55, 0xA8,
+			0x04, 0x90, 0x1A, 0xD2, 0xB9, 0x83, 0x3A, 0x1E, 0x1B, 0x0E, 0x49, 0x0C, 0x58, 0x22, 0xE4, 0xFB, 0x60, 0x32, 0xB4, 0x5E,
+			0x5B, 0x96, 0xD5, 0x6C, 0x36, 0x31, 0xFA, 0x94, 0xD1, 0x98, 0xC5, 0x46, 0x20, 0x42, 0xAE, 0xA9, 0xB0, 0xB2, 0x04, 0x05,
+			0x12, 0xFE, 0x89, 0x74, 0x96, 0x4A, 0x7B, 0x39, 0xFA, 0x4B, 0x09, 0x0C, 0xEA, 0xED, 0x89, 0xA1, 0xED, 0xC2, 0xA3, 0xAA,
+			0x1D, 0xB7, 0x5E, 0x5F, 0x5D, 0x5D, 0x65, 0x0F, 0x90, 0x2B, 0xF8, 0xBE, 0x7F, 0x77, 0xFB, 0xCB, 0xD8, 0xD9, 0xE7, 0xB3,
+			0x8F, 0x23, 0xB2, 0xF8, 0xCD, 0xCC, 0x63, 0xD3, 0xE9, 0x74, 0x6D, 0x6D, 0xCD, 0xB6, 0xED, 0x5E, 0xAF, 0xF7, 0xE0, 0xE8,
+			0xCE, 0xA3, 0xDE, 0x7D, 0x28, 0x94, 0xA5, 0x52, 0xA9, 0x07, 0x06, 0x5F, 0xD7, 0x34, 0xED, 0x39, 0xF7, 0xF4, 0xEA, 0xA7,
+			0x15, 0xF8, 0x57, 0x6A, 0x29, 0x93, 0x9C, 0x72, 0x6B, 0x6B, 0x6B, 0x7D, 0x7D, 0x5D, 0xD7, 0xF5, 0x63, 0x8B, 0xFB, 0x13,
+			0x8B, 0x6A, 0x57, 0xAB, 0xD5, 0x07, 0x06, 0x5F, 0xE7, 0x82, 0x83, 0x20, 0xF8, 0x17, 0x07, 0x7E, 0x97, 0xD9, 0x5E, 0xB8,
+			0xEA, 0xEA, 0xC7, 0x4A, 0xB4, 0x8E, 0x4D, 0x02, 0xB2, 0xD4, 0xEF, 0xF7, 0x4F, 0xC7, 0x47, 0x28, 0x5D, 0x9C, 0x4B, 0x5D,
+			0xD5, 0x34, 0xED, 0x8D, 0xF0, 0xC2, 0x43, 0x93, 0xBB, 0xFE, 0x47, 0xFB, 0xC7, 0xEC, 0x64, 0xD8, 0xB4, 0xCC, 0xA4, 0x14,
+			0xFC, 0x47, 0x68, 0x9A, 0x32, 0x0C, 0x99, 0xAA, 0x1E, 0xA9, 0x8F, 0x44, 0x60, 0xD2, 0x14, 0x22, 0x64, 0x36, 0xA9, 0xAE,
+			0x81, 0xE7, 0x50, 0x84, 0x07, 0xFC, 0x11, 0x46, 0x90, 0xC0, 0xCD, 0xD2, 0x1D, 0x22, 0x19, 0x2A, 0xCD, 0x92, 0xD2, 0x44,
+			0x26, 0x3C, 0x6F, 0xCE, 0x5A, 0x6A, 0xAF, 0x62, 0x87, 0x7D, 0x00, 0x2E, 0x16, 0xE9, 0x11, 0x5A, 0xB7, 0xF6, 0xC7, 0x7F,
+			0x95, 0x4A, 0x45, 0xA9, 0x1C, 0x24, 0xD4, 0x2F, 0x89, 0x72, 0x90, 0x0E, 0x04, 0x90, 0x90, 0x1E, 0x4F, 0x7E, 0x2B, 0xFA,
+			0x74, 0x0A, 0xA9, 0x4B, 0x38, 0x91, 0xD0, 0x96, 0xE8, 0x95, 0x11, 0x01, 0x5D, 0x2A, 0xCC, 0xCC, 0x6B, 0xA4, 0x45, 0x8B,
+			0x4B, 0x25, 0x31, 0xC5, 0x58, 0x91, 0xCD, 0x33, 0xC9, 0x83, 0xCD, 0xC6, 0x47, 0x89, 0x9E, 0x1D, 0x48, 0x8E, 0xE3, 0x38,
+			0x40, 0x8E, 0x48, 0xD1, 0x01, 0xB0, 0x48, 0x68, 0x8F, 0xBF, 0xA1, 0xA5, 0x89, 0x8D, 0x24, 0x2C, 0x3B, 0x68, 0x6C, 0x50,
+			0x0A, 0x69, 0x16, 0x23, 0x26, 0xDE, 0xD9, 0xD9, 0x01, 0x3D, 0xC6, 0xEC, 0xB0, 0xDF, 0xE0, 0xCE, 0x00, 0x7D, 0xD3, 0x0D,
+			0x4A, 0x0F, 0x13, 0xA3, 0xF7, 0x30, 0x5F, 0xC2, 0xA0, 0x93, 0x59, 0x34, 0xFC, 0x2D, 0x16, 0x46, 0x64, 0x22, 0x99, 0x88,
+			0xD0, 0xEB, 0xF5, 0x20, 0xC8, 0xA1, 0x86, 0xB0, 0xCB, 0xC2, 0xE0, 0x20, 0x51, 0x22, 0x97, 0x81, 0x0C, 0xF0, 0xF1, 0xC1,
+			0xBC, 0x68, 0xDA, 0x1A, 0x8D, 0x46, 0x34, 0x14, 0x10, 0xE3, 0xEF, 0x4E, 0xA8, 0x4A, 0xA5, 0x72, 0xB9, 0x5C, 0xAB, 0xD5,
+			0x02, 0x41, 0x12, 0xCC, 0x11, 0x58, 0x09, 0x35, 0x34, 0x91, 0xD4, 0x10, 0x94, 0x1C, 0x16, 0xBC, 0x48, 0x69, 0xD2, 0x0C,
+			0x8C, 0x4E, 0xA4, 0x02, 0xF5, 0x92, 0x95, 0x16, 0x61, 0x51, 0xF6, 0x3A, 0xF6, 0x02, 0xD1, 0x21, 0x06, 0x0A, 0x82, 0x7C,
+			0x11, 0x0F, 0x7E, 0xFE, 0xF9, 0xE7, 0xC2, 0x26, 0x92, 0x02, 0xAC, 0x64, 0x8B, 0xB6, 0x6D, 0x7F, 0xF2, 0xC9, 0x27, 0xCA,
+			0x00, 0x24, 0x9A, 0x53, 0xBC, 0x41, 0x5A, 0x4B, 0x38, 0x0C, 0x1F, 0x7F, 0xFC, 0xF1, 0xFE, 0x7E, 0x57, 0xE9, 0x94, 0x61,
+			0xDF, 0x90, 0x73, 0xC1, 0xC5, 0xA4, 0xBD, 0x59, 0x06, 0x6D, 0xEF, 0x0E, 0xE6, 0x4E, 0xA7, 0x3F, 0xFC, 0xF0, 0xC3, 0xFD,
+			0x53, 0x90, 0x00, 0x4F, 0xE4, 0x28, 0xCA, 0x70, 0x15, 0x69, 0x88, 0xE7, 0x51, 0x7F, 0xF6, 0xD9, 0x67, 0xC1, 0x5E, 0x86,
+			0xEB, 0x25, 0xC1, 0xA0, 0x17, 0xA8, 0x84, 0x3D, 0x8A, 0xFC, 0x50, 0x53, 0x25, 0xA3, 0xA5, 0x17, 0x44, 0x51, 0x18, 0xC4,
+			0x81, 0xAE, 0xA7, 0x74, 0x7D, 0x57, 0x16, 0x54, 0x79, 0xFB, 0x48, 0xC1, 0x8E, 0xBA, 0xAE, 0x6A, 0x41, 0xBA, 0xAE, 0x82,
+			0xE8, 0xA4, 0x1A, 0x6C, 0x07, 0xFE, 0x6E, 0xF3, 0x0E, 0x51, 0xC6, 0x72, 0xB9, 0x3B, 0xBC, 0xDE, 0xB2, 0xEC, 0x50, 0xD7,
+			0x42, 0xCF, 0xD3, 0x0D, 0x95, 0x55, 0x0C, 0x92, 0x99, 0xF1, 0x7B, 0x49, 0xC3, 0x6E, 0x5D, 0x2E, 0x0E, 0x14, 0xB8, 0x04,
+			0xA5, 0x52, 0x37, 0x94, 0xE9, 0xD0, 0xF5, 0x70, 0xB1, 0xCB, 0xBE, 0xD5, 0x6D, 0xDB, 0xE2, 0xAF, 0x16, 0x3E, 0x93, 0xA4,
+			0x0C, 0x6F, 0xAE, 0x60, 0xB4, 0x54, 0x2A, 0xB5, 0xF9, 0x77, 0xAF, 0x2A, 0x82, 0x6F, 0x1C, 0x77, 0xF6, 0x9E, 0xB9, 0xB4,
+			0x32, 0x28, 0xBE, 0xC1, 0xD6, 0xEE, 0xD0, 0xF0, 0x38, 0x8E, 0x5B, 0xFF, 0xB8, 0x6B, 0x85, 0x2D, 0xCB, 0xFA, 0x9B, 0xA6,
+			0x27, 0xA0, 0xFC, 0x70, 0xFB, 0xBC, 0x5A, 0xE5, 0xE6, 0x6E, 0x97, 0xF8, 0xAE, 0xF2, 0xE0, 0x2F, 0x77, 0x47, 0x9D, 0xFC,
+			0xBC, 0x75, 0x51, 0xB4, 0x07, 0xA6, 0xED, 0xDD, 0x8F, 0x12, 0x7C, 0x92, 0x5F, 0xCD, 0x3A, 0xEF, 0xFF, 0x62, 0x7B, 0xD7,
+			0xA9, 0x03, 0x65, 0xCC, 0x3A, 0xBB, 0x44, 0x06, 0xC5, 0x99, 0xDB, 0xD9, 0x6D, 0x19, 0x21, 0x56, 0x1A, 0x6E, 0xEF, 0xD2,
+			0xBD, 0x4D, 0xD3, 0x3C, 0xB8, 0x19, 0x5D, 0x39, 0x74, 0x33, 0x77, 0x29, 0x2D, 0xA3, 0xC6, 0x5B, 0x77, 0xF5, 0xEB, 0x37,
+			0xCB, 0xE1, 0x49, 0x55, 0x0F, 0xCB, 0x5D, 0x57, 0x07, 0x6F, 0x7C, 0xD8, 0x2B, 0xFE, 0x5C, 0x59, 0xA8, 0xCE, 0x3D, 0xC3,
+			0xD2, 0x8D, 0xEC, 0xE2, 0x58, 0x94, 0xBE, 0xBA, 0xCB, 0x48, 0x06, 0xC1, 0xFB, 0xEC, 0xB3, 0x9F, 0x8F, 0x1E, 0x9C, 0x97,
+			0x7E, 0xAA, 0x72, 0xF6, 0x4F, 0x6A, 0x7F, 0xA3, 0xD5, 0xB5, 0x1B, 0xFE, 0xCF, 0xED, 0x6B, 0x7A, 0xEF, 0xBE, 0xF1, 0xCA,
+			0xB5, 0x82, 0x90, 0x9E, 0x93, 0x95, 0x8D, 0x1A, 0x8F, 0x36, 0xFE, 0x9F, 0x5B, 0x7F, 0xF1, 0x4F, 0xFE, 0x7A, 0x4D, 0x8D,
+			0x09, 0x5B, 0x5E, 0xA5, 0x88, 0x87, 0x0F, 0xB8, 0xFC, 0xE0, 0x27, 0xE1, 0x48, 0x0D, 0xA3, 0xCF, 0x3E, 0x94, 0xBD, 0x70,
+			0xE1, 0x8A, 0x77, 0x34, 0xCC, 0xBC, 0x6F, 0xF9, 0x77, 0xF9, 0x6F, 0x5E, 0x38, 0x37, 0xB9, 0x67, 0x72, 0xED, 0x93, 0x1B,
+			0xEB, 0x76, 0xED, 0x2D, 0xFF, 0x5D, 0x21, 0xEA, 0x2C, 0x1F, 0xD5, 0x32, 0xBF, 0xA8, 0x0A, 0xAB, 0x52, 0x1A, 0xF4, 0x64,
+			0x76, 0x1B, 0x5A, 0x95, 0x90, 0xEB, 0xD5, 0xFB, 0x93, 0x40, 0x47, 0x38, 0x39, 0xE2, 0x0C, 0xE8, 0x03, 0xE0, 0xE1, 0x08,
+			0xE7, 0x47, 0x86, 0x95, 0x03, 0x02, 0x8B, 0x60, 0xB8, 0x74, 0xFF, 0x26, 0x91, 0xAB, 0xEA, 0x6B, 0x15, 0xC5, 0x50, 0x88,
+			0xBF, 0xE4, 0x67, 0xF8, 0x57, 0x99, 0xB6, 0x24, 0xD9, 0x06, 0xF3, 0xF8, 0x64, 0x26, 0x41, 0x64, 0xEC, 0xCE, 0x82, 0x55,
+			0x3B, 0x30, 0x81, 0x73, 0xBB, 0xDD, 0x2E, 0x72, 0xA7, 0x4A, 0xC5, 0x24, 0x51, 0x7A, 0x90, 0xC9, 0xF5, 0x88, 0x3B, 0x41,
+			0x8B, 0x04, 0xA6, 0x4E, 0xF6, 0x82, 0x62, 0xDF, 0x83, 0xFE, 0xC3, 0xCE, 0x14, 0x66, 0x11, 0xFB, 0x19, 0x95, 0x7F, 0xC2,
+			0x5C, 0x0A, 0x3F, 0x6A, 0x4B, 0x24, 0x6C, 0x3D, 0x20, 0x26, 0x89, 0x02, 0x19, 0xBD, 0x8B, 0x16, 0x72, 0xB9, 0x5C, 0xC6,
+			0xEC, 0xD0, 0x0A, 0x50, 0x2C, 0x16, 0x41, 0x74, 0x21, 0xDD, 0x0B, 0x59, 0x86, 0x1C, 0x42, 0x64, 0x1B, 0x80, 0x9B, 0xC8,
+			0x9F, 0xA4, 0x32, 0x0F, 0xAE, 0x45, 0x53, 0x1E, 0x81, 0x23, 0x5E, 0x5C, 0xD5, 0xF6, 0x92, 0xE0, 0x89, 0x9A, 0x81, 0xA8,
+			0x0F, 0xA0, 0xE5, 0x49, 0xCD, 0x95, 0x56, 0x5C, 0x3C, 0xE5, 0x6C, 0x36, 0x03, 0x23, 0x52, 0x51, 0x60, 0xF2, 0xA5, 0x6A,
+			0x6A, 0x71, 0x22, 0x6C, 0x03, 0x51, 0x0D, 0x5D, 0x64, 0x74, 0x0D, 0x78, 0xE0, 0xE0, 0xA2, 0x20, 0x25, 0xC2, 0x29, 0x27,
+			0xB3, 0x29, 0x95, 0x4A, 0x0A, 0xA2, 0x04, 0x21, 0x95, 0x69, 0x0C, 0x52, 0xCA, 0xA7, 0x53, 0x06, 0x21, 0x1D, 0xC9, 0xDC,
+			0xD1, 0xD7, 0x9C, 0x27, 0xA4, 0x7E, 0x46, 0xC7, 0x50, 0xB5, 0x10, 0xC2, 0x0F, 0xF4, 0x52, 0x26, 0x1D, 0x22, 0xED, 0x3D,
+			0x1C, 0x0E, 0x99, 0xA5, 0xCE, 0xFF, 0xD2, 0x94, 0x8F, 0x0F, 0xC8, 0x64, 0x32, 0x28, 0xC8, 0x8B, 0x36, 0x29, 0x4B, 0x02,
+			0x4D, 0x8D, 0x02, 0x29, 0xAD, 0x1F, 0xC0, 0x61, 0xF0, 0xB8, 0xA1, 0xDC, 0x30, 0x0C, 0x64, 0x3E, 0x9F, 0x3F, 0x72, 0xE6,
+			0x11, 0x22, 0xC7, 0x5B, 0xB7, 0x6E, 0xA1, 0x3E, 0xCF, 0xD9, 0x46, 0x00, 0x76, 0xB1, 0x58, 0x9C, 0x3F, 0x7F, 0x9E, 0xA9,
+			0xF1, 0xF0, 0x3D, 0xB8, 0x35, 0xFC, 0x90, 0x3A, 0xD2, 0x89, 0x68, 0x22, 0x07, 0x00, 0x65, 0x79, 0x36, 0x5C, 0xBF, 0xDF,
+			0x97, 0x51, 0xC9, 0x9F, 0x7E, 0xFA, 0xE9, 0x3D, 0xF7, 0xDC, 0xC3, 0x4A, 0x43, 0xC5, 0xA5, 0xF4, 0x0D, 0x9C, 0x2D, 0xD3,
+			0x22, 0x21, 0x32, 0x32, 0xAF, 0x4E, 0x7A, 0x20, 0x11, 0x4A, 0xBA, 0x76, 0xED, 0xDA, 0x63, 0x8F, 0x3D, 0xB6, 0xBD, 0xBD,
+			0x0D, 0xBA, 0x07, 0x3D, 0x86, 0x74, 0xF2, 0x66, 0xF3, 0xA7, 0xEA, 0x62, 0xF2, 0xE9, 0x65, 0x1C, 0x2C, 0x55, 0xCD, 0xD9,
+			0x8F, 0x35, 0x15, 0xCF, 0x86, 0xCB, 0xD0, 0x4B, 0x82, 0x24, 0x45, 0x60, 0x98, 0x2F, 0x93, 0x5A, 0xAF, 0x21, 0xD5, 0x11,
+			0x95, 0x88, 0x05, 0x9A, 0x5D, 0x72, 0xBC, 0xC0, 0xD7, 0xD5, 0x36, 0xD3, 0xCD, 0x94, 0x89, 0x87, 0x88, 0xE3, 0x30, 0x08,
+			0x42, 0xD3, 0xB4, 0x96, 0xCB, 0x58, 0x66, 0x3F, 0x25, 0x16, 0x53, 0xF9, 0x8C, 0xD1, 0x6C, 0xA1, 0xEB, 0xAA, 0xB0, 0x11,
+			0x2A, 0x46, 0x90, 0x1E, 0x06, 0xA1, 0xE7, 0x2D, 0x4D, 0xD3, 0x89, 0x77, 0xB3, 0x4E, 0x75, 0xC8, 0xD5, 0x49, 0xF3, 0xFC,
+			0x38, 0x95, 0x56, 0x09, 0xBB, 0x91, 0x8A, 0x0C, 0x3D, 0x21, 0x0B, 0xA9, 0x7A, 0x7E, 0xAC, 0xA5, 0xA2, 0x28, 0x88, 0x74,
+			0x75, 0x9D, 0x51, 0xA4, 0x2F, 0x97, 0xE1, 0xFA, 0x97, 0x9E, 0x06, 0x78, 0x21, 0x3C, 0x61, 0x7D, 0x49, 0xEA, 0x71, 0xD8,
+			0x7F, 0xF7, 0xE9, 0x5F, 0x36, 0x7E, 0xF3, 0x0C, 0xFD, 0x19, 0x94, 0xDF, 0x49, 0xF0, 0xEB, 0x49, 0xA8, 0x3E, 0x1C, 0x0E,
+			0x65, 0x24, 0x16, 0xAB, 0x2C, 0xB9, 0x36, 0xA3, 0x01, 0xD5, 0x72, 0xB4, 0x2F, 0x6D, 0xFC, 0x96, 0x12, 0x4F, 0xD6, 0x75,
+			0xBD, 0xD3, 0xE9, 0xAC, 0xAD, 0xAD, 0x61, 0x23, 0x48, 0xB7, 0x81, 0xB3, 0x15, 0x6B, 0x20, 0xA9, 0xAF, 0xD0, 0x5A, 0xC5,
+			0xA4, 0x14, 0xFA, 0x5A, 0x54, 0x1B, 0xED, 0xCE, 0x85, 0xC6, 0x6F, 0x9E, 0xE1, 0xB4, 0x83, 0x0B, 0x55, 0xAB, 0xD5, 0x76,
+			0xBB, 0x5D, 0x2A, 0x95, 0x8E, 0x7C, 0xF5, 0xA6, 0x52, 0x92, 0x79, 0xBC, 0xB6, 0xB9, 0xB9, 0xF9, 0x51, 0xF9, 0x8B, 0x47,
+			0x97, 0x87, 0x54, 0xE0, 0xF9, 0xD5, 0xD8, 0xEA, 0xA8, 0x58, 0xF2, 0x95, 0x07, 0xDE, 0x51, 0xE0, 0x6C, 0xB6, 0x74, 0xFA,
+			0xF4, 0xB1, 0xD9, 0x6C, 0xF6, 0xDA, 0xF2, 0xFC, 0xDD, 0x77, 0x7F, 0xFD, 0xF5, 0xDE, 0x7B, 0xDF, 0x7D, 0xE4, 0x69, 0x70,
+			0x8C, 0x0F, 0x8A, 0x9F, 0x1F, 0xD7, 0xEF, 0x1F, 0x0C, 0x06, 0xD5, 0x4C, 0x35, 0x7C, 0x44, 0x01, 0x1D, 0x81, 0xAF, 0x2A,
+			0x58, 0xE7, 0x52, 0x57, 0xEF, 0xB8, 0xE3, 0xCB, 0xD1, 0x3C, 0x5A, 0x3F, 0xB5, 0xFE, 0xD6, 0x5B, 0xEF, 0x1E, 0x3A, 0x74,
+			0xCF, 0x5F, 0xF6, 0x5F, 0xCD, 0x66, 0xB3, 0xCF, 0x67, 0x1F, 0x0F, 0x96, 0xC1, 0xC8, 0x1E, 0x7D, 0x74, 0xEC, 0x8B, 0x7B,
+			0x3A, 0xBF, 0xCD, 0x79, 0x06, 0x53, 0x55, 0x70, 0x50, 0xA0, 0x10, 0xE7, 0xE8, 0x2B, 0xD1, 0xE5, 0xC5, 0x27, 0xFA, 0x11,
+			0xFD, 0x81, 0xEE, 0xD7, 0xD3, 0x77, 0xA9, 0x67, 0x78, 0xDE, 0x79, 0xFF, 0x74, 0xFC, 0x75, 0xF7, 0xB0, 0x5B, 0x2A, 0x95,
+			0xB6, 0xB6, 0xB6, 0xDE, 0x31, 0xAE, 0x3C, 0x99, 0x3A, 0x76, 0x56, 0xBF, 0xAC, 0x0F, 0xF4, 0x8C, 0x56, 0x15, 0xA2, 0xE4,
+			0xFE, 0x88, 0x58, 0x24, 0x74, 0xF6, 0xFF, 0x56, 0x46, 0x4C, 0x4B, 0x03, 0x81, 0x74, 0x69, 0x09, 0x4D, 0x50, 0x66, 0xA7,
+			0xC8, 0x68, 0x2D, 0x8A, 0x6A, 0x32, 0x6B, 0x5E, 0x82, 0x7D, 0xA5, 0x83, 0x94, 0x34, 0xE5, 0x49, 0x03, 0x81, 0xDA, 0x21,
+			0x49, 0x80, 0x28, 0x72, 0x1D, 0xC4, 0x13, 0xB0, 0x53, 0xB0, 0xFE, 0x72, 0x79, 0x58, 0x4F, 0x48, 0x07, 0x98, 0x3C, 0x8A,
+			0xC0, 0x20, 0x51, 0xA8, 0x09, 0x10, 0xC5, 0xA3, 0x5C, 0x46, 0xDB, 0x97, 0x91, 0x24, 0x2D, 0x8C, 0x86, 0x95, 0x16, 0x68,
+			0x60, 0x61, 0x10, 0x15, 0x60, 0x13, 0xA1, 0x8D, 0x22, 0xF3, 0x05, 0xAB, 0x12, 0xB0, 0x54, 0x5A, 0x9A, 0x65, 0x86, 0x3B,
+			0xE5, 0x62, 0x46, 0x24, 0xEA, 0x3D, 0x15, 0xF2, 0x73, 0xF6, 0x69, 0x23, 0x00, 0x96, 0x60, 0x6F, 0x73, 0x23, 0x08, 0x97,
+			0x22, 0x6A, 0x4B, 0x87, 0xAD, 0x8C, 0x69, 0x94, 0x5E, 0x6B, 0x2A, 0x94, 0xC2, 0xCF, 0xC1, 0x94, 0x81, 0x4F, 0x4A, 0xCB,
+			0x0E, 0x43, 0xC4, 0xEA, 0xF5, 0x7A, 0xA7, 0xD3, 0xC1, 0xC1, 0x48, 0x3D, 0x99, 0xDE, 0x49, 0x22, 0x77, 0x19, 0x2A, 0x00,
+			0x28, 0x84, 0x32, 0x12, 0x73, 0x67, 0x61, 0x76, 0x0A, 0x85, 0x87, 0x43, 0xC4, 0x13, 0xA3, 0xA9, 0xA2, 0x58, 0x2C, 0xE2,
+			0x71, 0xE9, 0x3F, 0xE0, 0x39, 0x60, 0x97, 0x76, 0x21, 0x23, 0x99, 0xEA, 0x47, 0x77, 0x00, 0x32, 0x38, 0xAC, 0xB1, 0xA8,
+			0x0C, 0x02, 0xD8, 0xB1, 0x03, 0x24, 0x2F, 0x13, 0xA1, 0x09, 0x51, 0xAC, 0x66, 0x5A, 0x0D, 0xF3, 0xFC, 0x00, 0xFD, 0x51,
+			0x89, 0x68, 0xB7, 0xDB, 0x02, 0x60, 0xA9, 0x6D, 0x98, 0x4C, 0x5A, 0xE0, 0x9C, 0x0F, 0x06, 0x03, 0xB4, 0x5B, 0x09, 0xD5,
+			0x29, 0xBA, 0xE2, 0xC0, 0xF1, 0x87, 0xF4, 0x1F, 0x52, 0xCA, 0x80, 0x02, 0xC8, 0x6E, 0x20, 0x6D, 0xA7, 0x33, 0x60, 0xB9,
+			0x5C, 0x32, 0x6B, 0x09, 0xD0, 0x00, 0x45, 0x39, 0x91, 0x27, 0xA3, 0x0F, 0x82, 0xA4, 0xAF, 0x54, 0x2A, 0x21, 0x6B, 0x41,
+			0xE1, 0x88, 0x56, 0x64, 0xAA, 0x3D, 0xB8, 0x37, 0x58, 0x25, 0x90, 0xCC, 0x70, 0xE3, 0xAC, 0x3A, 0xA2, 0x8C, 0xE8, 0x0A,
+			0x28, 0x46, 0x41, 0xE2, 0x48, 0x71, 0xC8, 0xDB, 0xDB, 0xDB, 0x24, 0x68, 0x24, 0x2E, 0x02, 0xDE, 0x09, 0x02, 0x06, 0x84,
+			0xD5, 0xE9, 0x74, 0x20, 0xB7, 0x6C, 0x6E, 0x6E, 0xC2, 0xD2, 0x05, 0xF4, 0x4F, 0x82, 0x77, 0xC5, 0x2A, 0x93, 0x3A, 0xAD,
+			0xD0, 0xB9, 0xE4, 0xC8, 0xC9, 0xB4, 0x48, 0x21, 0xFC, 0x71, 0x7E, 0xC8, 0xC9, 0x24, 0xAF, 0x57, 0xD6, 0x67, 0x8F, 0xFA,
+			0x2D, 0x3C, 0x0D, 0xF9, 0x40, 0x0C, 0x9F, 0xB8, 0x6A, 0x91, 0x7B, 0x54, 0x69, 0xC7, 0x1E, 0x35, 0x4D, 0x60, 0x62, 0xA1,
+			0xA0, 0xF1, 0x69, 0xA2, 0x0C, 0x21, 0x2C, 0x11, 0xC1, 0x7F, 0xE9, 0x16, 0x44, 0xAB, 0x87, 0x1D, 0x89, 0x6B, 0x47, 0x20,
+			0x16, 0x93, 0x44, 0x48, 0x0E, 0x49, 0x0C, 0x12, 0x14, 0x2A, 0x2E, 0xB4, 0xEA, 0x30, 0x04, 0x9C, 0x64, 0x6E, 0x3A, 0x9D,
+			0x6E, 0x6E, 0x6E, 0x4E, 0xA7, 0xD3, 0x30, 0x0C, 0x49, 0xF5, 0xD6, 0xD7, 0xD7, 0x89, 0x6B, 0x80, 0x77, 0x19, 0x0C, 0x40,
+			0x61, 0x26, 0xDC, 0x7B, 0x21, 0x7E, 0xCB, 0x38, 0x39, 0x22, 0x92, 0xE9, 0x74, 0x0A, 0x23, 0x48, 0xF4, 0x68, 0x7B, 0xBD,
+			0x1E, 0xE5, 0x32, 0xE0, 0x4A, 0x62, 0x11, 0xDC, 0xD2, 0x6B, 0xCB, 0xF3, 0xB7, 0x6E, 0xDD, 0xCA, 0x66, 0xB3, 0x4F, 0xA6,
+			0x8E, 0xD1, 0x04, 0x7E, 0x21, 0xFD, 0xD1, 0x45, 0xE7, 0x7A, 0x26, 0x93, 0xF9, 0x6E, 0xE9, 0xE9, 0xCA, 0xF5, 0xFC, 0x37,
+			0x76, 0x7E, 0xAB, 0xDB, 0xED, 0xBE, 0xBA, 0x38, 0x77, 0xDC, 0x7F, 0xE0, 0x6D, 0xED, 0xFD, 0x53, 0xD1, 0x43, 0x92, 0xE6,
+			0x9E, 0x8A, 0x1E, 0x9A, 0xCF, 0x55, 0x2F, 0xF1, 0x6C, 0x36, 0xFB, 0xF3, 0xD6, 0x8B, 0x9D, 0x4E, 0xC7, 0xF7, 0xFD, 0x77,
+			0xCD, 0x6B, 0x27, 0x82, 0x07, 0x2F, 0x67, 0x3F, 0xC9, 0xE7, 0xF3, 0xAF, 0x07, 0xEF, 0x61, 0x68, 0x4E, 0xC7, 0x47, 0xBE,
+			0x5B, 0x7A, 0xFA, 0xB5, 0xE5, 0x79, 0x44, 0x2F, 0xEE, 0xEF, 0x7F, 0xAD, 0x50, 0x28, 0x20, 0x0D, 0xB4, 0xB6, 0xA6, 0xB2,
+			0x81, 0x77, 0xCD, 0x6B, 0x85, 0x42, 0x01, 0x48, 0xFA, 0x64, 0x78, 0x78, 0xB9, 0x5C, 0xDE, 0xAC, 0xFF, 0xED, 0x45, 0xE7,
+			0x7A, 0xA7, 0xD3, 0x61, 0xEB, 0x2E, 0x16, 0x8B, 0xCD, 0xCD, 0xCD, 0x5C, 0x2E, 0xF7, 0xAD, 0xDC, 0x13, 0x51, 0x14, 0x1D,
+			0x5B, 0xDC, 0x2F, 0xFD, 0xF0, 0x32, 0xE0, 0x45, 0xE8, 0xF6, 0x44, 0x4E, 0xD0, 0x0D, 0xA5, 0xE9, 0x4F, 0xF4, 0x38, 0x41,
+			0x87, 0x24, 0x43, 0xC2, 0x28, 0xEF, 0x92, 0x6D, 0x92, 0x7D, 0x28, 0xF2, 0x41, 0x94, 0x82, 0x04, 0xDF, 0x67, 0x4F, 0x62,
+			0x8C, 0x00, 0xCA, 0xA5, 0xAD, 0x57, 0x64, 0x6B, 0x85, 0xFE, 0x4F, 0xEA, 0xC3, 0xE7, 0xC3, 0xBD, 0xC6, 0x43, 0x48, 0x3E,
+			0xC1, 0x05, 0x23, 0x84, 0x80, 0xB8, 0x02, 0x68, 0x04, 0x28, 0x31, 0x17, 0xCC, 0x1A, 0x01, 0x50, 0x4B, 0x05, 0x08, 0x0E,
+			0x08, 0x65, 0x21, 0xAC, 0x13, 0x2C, 0x32, 0xEE, 0x48, 0x6A, 0xBC, 0x94, 0x0F, 0x51, 0x1A, 0x96, 0xF9, 0x2A, 0x9C, 0x2C,
+			0x95, 0x9B, 0x26, 0x85, 0x5C, 0xDA, 0x74, 0xD0, 0xFA, 0x05, 0xC0, 0x01, 0xD2, 0x21, 0x79, 0xE2, 0x76, 0xE8, 0x81, 0xA5,
+			0x14, 0x0A, 0x6E, 0x23, 0xFD, 0x16, 0x22, 0xB1, 0x20, 0x8D, 0xFA, 0xFB, 0x5D, 0x23, 0xB9, 0xBE, 0xE7, 0x79, 0x0C, 0x00,
+			0xE0, 0x31, 0x52, 0xAC, 0x16, 0x1D, 0x65, 0x4C, 0x39, 0xAA, 0x12, 0xD4, 0x1B, 0xD4, 0xDE, 0x4A, 0x3E, 0x41, 0x45, 0x03,
+			0x49, 0x60, 0xAA, 0xA2, 0xC0, 0x64, 0x46, 0x3A, 0x67, 0x93, 0x81, 0x54, 0xC4, 0xF2, 0xD4, 0x21, 0xFA, 0xFD, 0xBE, 0x68,
+			0x27, 0xAB, 0x38, 0x6F, 0x34, 0x92, 0x39, 0x2B, 0xC8, 0xDE, 0xF8, 0xBE, 0x8F, 0x27, 0x40, 0x55, 0x01, 0xDD, 0x2D, 0x65,
+			0xAC, 0xA8, 0xF7, 0x12, 0x1D, 0x77, 0x3A, 0x1D, 0x64, 0x58, 0x90, 0x58, 0x49, 0x0C, 0x82, 0xAA, 0x9B, 0x61, 0x46, 0xF7,
+			0x53, 0xE9, 0xF1, 0x0A, 0xDC, 0x1E, 0x25, 0x23, 0x40, 0x49, 0x3C, 0x24, 0x08, 0x3E, 0xD2, 0xC7, 0xB4, 0x35, 0x12, 0xBB,
+			0xA1, 0x9B, 0x46, 0x94, 0x4D, 0x7D, 0x46, 0x58, 0xC6, 0xF8, 0x6A, 0xC6, 0xD7, 0x51, 0x80, 0x05, 0xF9, 0x05, 0x02, 0x82,
+			0x9A, 0x22, 0xE3, 0x37, 0xB1, 0x20, 0x20, 0x33, 0x88, 0xC7, 0xC9, 0x8A, 0xEE, 0xF2, 0x7C, 0x93, 0x7A, 0xAC, 0xA8, 0x91,
+			0x80, 0x94, 0x11, 0xA2, 0xA2, 0xC7, 0x8D, 0x3F, 0x64, 0x8E, 0x23, 0x05, 0x64, 0x15, 0xF6, 0x4E, 0x26, 0x71, 0x1C, 0x57,
+			0xAB, 0x55, 0x8A, 0xF2, 0xB0, 0xD1, 0xD9, 0x43, 0x94, 0xB2, 0x49, 0xF1, 0x28, 0x3F, 0x90, 0x39, 0x81, 0x5C, 0xE7, 0x72,
+			0xB9, 0x6A, 0xB5, 0x7A, 0xFB, 0xF6, 0x6D, 0x45, 0x1A, 0x4B, 0xD0, 0xB3, 0x03, 0x07, 0x0E, 0xC0, 0xAA, 0xA2, 0x27, 0x4E,
+			0xFA, 0x21, 0x65, 0x66, 0x0B, 0x66, 0x88, 0x98, 0x17, 0xCE, 0x86, 0x8C, 0xE4, 0x26, 0x61, 0x14, 0x25, 0x16, 0x19, 0xB7,
+			0x24, 0xEC, 0x0B, 0x19, 0x9A, 0x26, 0xD5, 0x60, 0x62, 0x2E, 0x11, 0x6A, 0x96, 0xD6, 0x6E, 0x11, 0xBB, 0x16, 0x4A, 0xB2,
+			0x0C, 0x00, 0x21, 0x34, 0x13, 0x10, 0x03, 0xBF, 0x4B, 0xD6, 0x2C, 0xDC, 0x41, 0x56, 0x07, 0xE6, 0x2E, 0x5B, 0x9C, 0xF5,
+			0xC5, 0x7F, 0x53, 0x11, 0xE1, 0xAA, 0xA8, 0xA2, 0x33, 0x01, 0xB1, 0x56, 0xAB, 0xE1, 0xAB, 0x5A, 0xAD, 0x96, 0x4C, 0x99,
+			0xE6, 0x1E, 0xA9, 0xE9, 0xD1, 0xFD, 0xC0, 0xF0, 0x0D, 0x24, 0xC1, 0xB9, 0x4D, 0x26, 0x61, 0xC9, 0x00, 0x38, 0x0E, 0x27,
+			0x95, 0x95, 0xE5, 0x72, 0x49, 0x3D, 0x8D, 0xCA, 0x1B, 0xE0, 0x15, 0x4A, 0x61, 0xAA, 0x73, 0x6D, 0x67, 0x47, 0xA4, 0x19,
+			0xB1, 0x4A, 0x90, 0x0B, 0xD0, 0x65, 0x02, 0x04, 0xA3, 0x9A, 0x25, 0x9D, 0xF7, 0x84, 0x78, 0x90, 0x88, 0x9E, 0xCF, 0x3E,
+			0x7E, 0xC9, 0xBD, 0xF1, 0xAE, 0x79, 0xED, 0x5D, 0xF3, 0xDA, 0xDB, 0xDA, 0xFB, 0xCB, 0xE5, 0x92, 0x61, 0x5E, 0x1C, 0x72,
+			0xB9, 0xBC, 0x67, 0x9D, 0x53, 0xAF, 0x2E, 0xCE, 0x51, 0x58, 0x7B, 0x79, 0x7E, 0x96, 0xCD, 0xC6, 0x34, 0xC7, 0xFF, 0x63,
+			0xED, 0x3B, 0x88, 0x91, 0xFD, 0x74, 0xFD, 0xEF, 0xD5, 0x99, 0x49, 0xCE, 0xC5, 0x71, 0xFF, 0x81, 0xF3, 0xD6, 0x07, 0x47,
+			0xBD, 0xFB, 0x50, 0xA7, 0x21, 0x04, 0xF1, 0x7D, 0xFF, 0xAD, 0xF8, 0x52, 0x3A, 0x9D, 0x3E, 0x6F, 0x7D, 0xC0, 0xC5, 0xF8,
+			0xBE, 0xFF, 0xA7, 0xCD, 0x1F, 0x5E, 0xCD, 0x7F, 0x4A, 0xC5, 0x0B, 0xFA, 0xE6, 0xF5, 0xCA, 0xCF, 0xCE, 0x98, 0xC7, 0x75,
+			0x5D, 0xBF, 0xB7, 0xFB, 0x3B, 0x8B, 0xC5, 0x62, 0xEB, 0xEB, 0x6D, 0xEC, 0x23, 0xE4, 0xC5, 0x37, 0xA3, 0x8B, 0x1F, 0x95,
+			0xBF, 0xA0, 0x41, 0x4C, 0x86, 0x2E, 0xE0, 0xC2, 0x59, 0x0E, 0x88, 0x18, 0x98, 0x3C, 0x01, 0x67, 0xF6, 0xF7, 0x7B, 0xB3,
+			0xB8, 0xD0, 0xC6, 0x54, 0x3A, 0x98, 0x00, 0xEB, 0x42, 0x10, 0x12, 0x69, 0x7B, 0x19, 0xCB, 0x2C, 0x74, 0x7E, 0xC0, 0x55,
+			0x82, 0x6E, 0x9E, 0x8C, 0x4C, 0xD7, 0x12, 0x11, 0xBA, 0xFD, 0x4C, 0x04, 0x36, 0x8F, 0x48, 0x63, 0x8A, 0x08, 0x9D, 0x5C,
+			0x12, 0x11, 0x09, 0x2C, 0xF0, 0xD9, 0x6C, 0xB6, 0xB3, 0xB3, 0x43, 0xD9, 0x1C, 0x9E, 0x98, 0x74, 0x14, 0xEF, 0x1F, 0x40,
+			0x02, 0x69, 0x85, 0xF9, 0xE1, 0xFB, 0xD5, 0xFF, 0xA5, 0xCB, 0x89, 0xA2, 0x23, 0xB1, 0x17, 0x07, 0x8A, 0xA0, 0x5E, 0x0A,
+			0xB0, 0x7C, 0x0E, 0x3C, 0x14, 0x5C, 0x08, 0x67, 0x47, 0x68, 0x75, 0xE8, 0xE4, 0x48, 0xCF, 0x2D, 0x8C, 0x35, 0x51, 0x2D,
+			0xC4, 0x67, 0x48, 0x29, 0x11, 0x94, 0x8C, 0x02, 0x18, 0xA1, 0x37, 0x32, 0x15, 0x18, 0x68, 0xB2, 0x01, 0xA4, 0x94, 0xF9,
+			0x7C, 0x22, 0x42, 0xD1, 0xB7, 0x60, 0xA5, 0xA4, 0xB2, 0x05, 0xA3, 0x9A, 0xA8, 0x1F, 0x1D, 0x7E, 0x7A, 0x51, 0x09, 0xDB,
+			0x21, 0xC8, 0x71, 0xBE, 0xB2, 0xD9, 0x6C, 0xAD, 0x56, 0x83, 0xF0, 0x5A, 0x2C, 0x16, 0x99, 0x64, 0x05, 0x2B, 0x92, 0x2B,
+			0x27, 0x56, 0x66, 0xB4, 0x86, 0x88, 0x0E, 0x20, 0x70, 0x44, 0xF8, 0xAF, 0x76, 0x0B, 0x57, 0x40, 0x88, 0x44, 0x30, 0x08,
+			0x16, 0x86, 0x41, 0x01, 0x28, 0x54, 0xFC, 0xD9, 0xA4, 0xA7, 0x11, 0xF6, 0x27, 0xDD, 0x68, 0xB8, 0x50, 0xE2, 0x74, 0x64,
+			0x54, 0xC9, 0xBB, 0x85, 0x7A, 0xC8, 0xB1, 0x14, 0x5E, 0x81, 0x54, 0xFC, 0xA5, 0x0D, 0x9A, 0x1D, 0xD6, 0xED, 0x76, 0xF7,
+			0x67, 0x85, 0xCC, 0xDD, 0x17, 0x74, 0x85, 0x8A, 0x13, 0x85, 0x5F, 0x4C, 0x39, 0xE5, 0x75, 0xE2, 0x59, 0x21, 0xF8, 0x63,
+			0x2C, 0xE8, 0x9A, 0x23, 0x2C, 0x22, 0x72, 0x67, 0x42, 0x24, 0x50, 0x00, 0x1D, 0x80, 0x18, 0xCD, 0xD5, 0xD5, 0x55, 0x89,
+			0x67, 0xC9, 0x3A, 0x51, 0xD9, 0x66, 0x16, 0x33, 0x7A, 0x03, 0xA8, 0x46, 0x8B, 0xDE, 0x1C, 0x3A, 0x13, 0x14, 0x85, 0x90,
+			0x93, 0xA3, 0x7E, 0xC5, 0x4C, 0xD1, 0x9D, 0x9D, 0x9D, 0x74, 0x3A, 0x4D, 0xED, 0x17, 0xD2, 0x21, 0x3B, 0x83, 0x4D, 0xC9,
+			0xED, 0xB3, 0x6C, 0x32, 0x57, 0xBD, 0xDB, 0xED, 0x92, 0xFF, 0x66, 0xCC, 0x88, 0xF1, 0xBC, 0x19, 0x73, 0xB7, 0xB8, 0x3A,
+			0x0C, 0x5D, 0x69, 0xD3, 0xED, 0x2E, 0xAC, 0xEE, 0xC2, 0xB2, 0x35, 0x9F, 0x79, 0xBF, 0x0A, 0x40, 0x4F, 0xBA, 0x7C, 0xCB,
+			0x59, 0xAB, 0x3D, 0x57, 0x4D, 0xBC, 0x66, 0xE4, 0xA5, 0xF5, 0x65, 0xC1, 0x31, 0x3A, 0x9E, 0x59, 0xC9, 0xD9, 0x54, 0x74,
+			0x7B, 0xBE, 0xDD, 0x9E, 0xAB, 0xFA, 0xAD, 0x6D, 0xDB, 0xA5, 0x8C, 0xD9, 0xF3, 0xED, 0x82, 0x63, 0xB8, 0xA9, 0x30, 0x63,
+			0x46, 0xFD, 0x65, 0xFA, 0x56, 0x7F, 0x49, 0xE7, 0xB0, 0x63, 0x04, 0xFD, 0x65, 0xDA, 0xD6, 0x7C, 0x33, 0xF2, 0x6C, 0xCD,
+			0xDF, 0x1A, 0x29, 0x14, 0x98, 0x2F, 0xCA, 0x5A, 0x71, 0x7F, 0x99, 0xB6, 0x62, 0x35, 0xAF, 0xD8, 0x4D, 0x85, 0xB7, 0xFA,
+			0xAA, 0x39, 0x99, 0xB2, 0x73, 0xC1, 0x51, 0x75, 0xE0, 0x72, 0xD6, 0x72, 0x0C, 0x55, 0xD0, 0x13, 0x02, 0x2E, 0x63, 0x1E,
+			0x7A, 0xBD, 0x1E, 0xFD, 0x96, 0xF0, 0xFD, 0x4D, 0xD3, 0xAC, 0xD7, 0xEB, 0x80, 0x9E, 0x88, 0xFE, 0xD3, 0x21, 0x0C, 0x99,
+			0xCF, 0x75, 0x5D, 0x44, 0xA1, 0x67, 0xB3, 0xD9, 0xE6, 0xE6, 0x26, 0x1B, 0xB4, 0x54, 0x2A, 0x6D, 0x6F, 0x6F, 0x23, 0x49,
+			0x42, 0xD1, 0x05, 0x02, 0x1F, 0x6C, 0x31, 0x38, 0x4B, 0x6C, 0x68, 0xE9, 0x8C, 0x87, 0xF8, 0x5F, 0xAD, 0x56, 0x57, 0x57,
+			0x57, 0x77, 0x76, 0x76, 0x88, 0x9A, 0x6F, 0xDF, 0xBE, 0x0D, 0xBA, 0x48, 0x4C, 0xD3, 0xED, 0x76, 0xAB, 0xD5, 0x6A, 0xAF,
+			0xD7, 0xC3, 0xC6, 0xA1, 0xAA, 0x48, 0x26, 0x47, 0x68, 0x42, 0x4C, 0xAD, 0xEB, 0xFA, 0xB9, 0xD4, 0xD5, 0x41, 0xF2, 0xDA,
+			0xDE, 0xDE, 0x86, 0x8F, 0xF8, 0x41, 0xF1, 0x73, 0x36, 0xCF, 0xEF, 0x57, 0xBF, 0x29, 0xD2, 0x3A, 0xAF, 0x2D, 0xCF, 0xBF,
+			0x6B, 0x5E, 0xFB, 0x6E, 0xE9, 0xE9, 0xCD, 0xCD, 0xCD, 0x7C, 0x3E, 0x7F, 0xD4, 0xBB, 0xEF, 0xC1, 0xD1, 0x9D, 0x2F, 0xCF,
+			0xCF, 0xDE, 0xD7, 0xFB, 0x2A, 0x4D, 0x85, 0x8F, 0x2E, 0x0F, 0x29, 0xBA, 0xF7, 0xD1, 0xF0, 0x2F, 0xBA, 0x2F, 0xC3, 0xC5,
+			0x7A, 0xA1, 0xA0, 0x90, 0xA8, 0x4E, 0xA7, 0xF3, 0x9C, 0x7B, 0x1A, 0x15, 0xA9, 0xDF, 0xAF, 0x7E, 0xF3, 0x99, 0xF4, 0xC9,
+			0x47, 0xE6, 0xF7, 0xD2, 0x80, 0x56, 0x2A, 0x95, 0xA2, 0x28, 0xBA, 0xA7, 0xF3, 0x95, 0x37, 0xA3, 0x8B, 0x0F, 0x0C, 0xBE,
+			0xBE, 0xBE, 0xBE, 0xDE, 0x68, 0x34, 0x56, 0x3F, 0x55, 0xB4, 0xD7, 0x62, 0xB1, 0x78, 0x39, 0xFB, 0xC9, 0x8F, 0xC6, 0x6F,
+			0x3C, 0xA6, 0x3D, 0x4C, 0x52, 0xF8, 0xB4, 0x7D, 0x02, 0x1B, 0x2A, 0x13, 0x46, 0xF9, 0x39, 0xDB, 0x4F, 0x5C, 0xB8, 0x54,
+			0x80, 0xF9, 0xA1, 0x40, 0x3A, 0xFB, 0xCB, 0x7E, 0x02, 0x52, 0x8B, 0xD2, 0xE7, 0x7E, 0xD9, 0x38, 0x99, 0x2A, 0xCC, 0x57,
+			0x40, 0xF3, 0x4B, 0x6A, 0x4B, 0x96, 0x50, 0xB3, 0xC4, 0xA6, 0x93, 0x8E, 0x88, 0xA1, 0x47, 0x35, 0x80, 0x0B, 0x93, 0xE9,
+			0xE4, 0x52, 0x30, 0x13, 0xA1, 0x7C, 0xE8, 0xFF, 0x24, 0xE2, 0x99, 0x4C, 0x06, 0x36, 0x30, 0x91, 0x96, 0x40, 0xED, 0x44,
+			0x2A, 0x98, 0x23, 0xF5, 0x60, 0x93, 0x87, 0xDC, 0xE9, 0x74, 0xD2, 0xC9, 0x4B, 0xF2, 0x6F, 0x91, 0x80, 0x15, 0x31, 0x4A,
+			0x66, 0x9B, 0x83, 0x1E, 0x33, 0x7D, 0x0C, 0x7E, 0xF0, 0x60, 0x30, 0xE0, 0x5E, 0x70, 0x2A, 0xD4, 0x05, 0xD9, 0x72, 0xA4,
+			0x5C, 0x48, 0xBC, 0x50, 0x9F, 0x87, 0x9B, 0x00, 0x1D, 0x11, 0xB6, 0x12, 0x31, 0x28, 0xC9, 0x16, 0x13, 0xB1, 0x00, 0x0F,
+			0x08, 0x47, 0x84, 0xB6, 0xC4, 0xE0, 0x30, 0x4A, 0xC7, 0xA4, 0xEF, 0x00, 0xC8, 0x48, 0xD6, 0x13, 0xC5, 0x22, 0x6D, 0x4D,
+			0x4E, 0x0F, 0xFB, 0x06, 0x1D, 0x1A, 0xBC, 0x0B, 0x2B, 0x45, 0x60, 0x4A, 0x24, 0x41, 0x7F, 0x22, 0x46, 0x89, 0x9A, 0x30,
+			0x9C, 0x17, 0xF2, 0x0F, 0xE9, 0x9A, 0x94, 0x49, 0x8B, 0x88, 0x49, 0xE0, 0xB4, 0x18, 0x71, 0xB3, 0xAB, 0xEB, 0xCC, 0xA0,
+			0x47, 0xB4, 0x97, 0xA5, 0x65, 0x49, 0xE4, 0x85, 0x69, 0x0A, 0x13, 0x01, 0x23, 0x44, 0xBB, 0xB0, 0xA4, 0xC2, 0xC1, 0x90,
+			0x51, 0xF4, 0x9C, 0x10, 0x24, 0xEB, 0x84, 0x5E, 0x86, 0x64, 0x1D, 0x6C, 0x1F, 0xE6, 0x7F, 0x91, 0x18, 0x7A, 0x9E, 0xD7,
+			0xEF, 0xF7, 0xC9, 0x79, 0xA9, 0x53, 0xCB, 0xB0, 0x5C, 0xDC, 0x1D, 0xD7, 0x0A, 0x11, 0x02, 0x7D, 0x63, 0xB2, 0x3C, 0x26,
+			0x44, 0x93, 0x34, 0xA1, 0x45, 0x2C, 0xFC, 0x3F, 0x21, 0x5F, 0x32, 0x47, 0x1F, 0xB7, 0x01, 0xB8, 0x24, 0xD3, 0xCD, 0x88,
+			0x82, 0xD1, 0x29, 0x03, 0x71, 0x83, 0x8B, 0xD2, 0x6C, 0x36, 0x19, 0xFB, 0x5E, 0xA9, 0x54, 0x10, 0x26, 0xEC, 0xF7, 0xFB,
+			0xD8, 0x20, 0x36, 0x25, 0x51, 0xAA, 0xE7, 0x79, 0xE0, 0xF8, 0x60, 0x82, 0x34, 0xD4, 0x11, 0x4D, 0x03, 0x80, 0xB0, 0x72,
+			0x32, 0xEA, 0x41, 0x32, 0x6E, 0xC2, 0x2B, 0xD7, 0x75, 0xAB, 0xD5, 0x2A, 0xF1, 0x45, 0x25, 0x79, 0x51, 0xB8, 0x16, 0xA7,
+			0x28, 0x67, 0x52, 0x1A, 0x32, 0x65, 0x96, 0x8E, 0x08, 0xE2, 0x0B, 0x71, 0x5B, 0x5A, 0x0A, 0x65, 0xC0, 0x8B, 0xC8, 0x20,
+			0x4B, 0x3C, 0x28, 0x86, 0x52, 0x2A, 0x84, 0x52, 0x7F, 0xE3, 0xB2, 0x45, 0x46, 0x4A, 0x0A, 0xD4, 0x52, 0x15, 0x24, 0xD2,
+			0x17, 0xA1, 0x37, 0x11, 0x19, 0xDE, 0x5F, 0xEE, 0x66, 0x33, 0x6D, 0x6F, 0x6F, 0x43, 0x04, 0x24, 0xCF, 0x83, 0x46, 0xC2,
+			0xA4, 0x07, 0xD2, 0x64, 0xD2, 0x76, 0x24, 0xF6, 0xA8, 0x1E, 0x11, 0xAA, 0xB0, 0xBF, 0x7D, 0xDF, 0x67, 0xF6, 0xD6, 0xDA,
+			0xDA, 0x5A, 0x52, 0xD6, 0xD6, 0x45, 0x66, 0x5C, 0x80, 0x32, 0x94, 0x01, 0xD1, 0x04, 0xC6, 0xAD, 0xB2, 0x1F, 0xD0, 0x83,
+			0x23, 0xC9, 0xDB, 0xDC, 0xDC, 0x64, 0xB6, 0x8C, 0xA4, 0xD8, 0xA8, 0x0D, 0xD3, 0xFC, 0xD1, 0x68, 0x34, 0x40, 0x26, 0x51,
+			0x08, 0x57, 0x15, 0x0E, 0xCB, 0xFA, 0xF1, 0xF4, 0xAD, 0x42, 0xA1, 0x70, 0xC6, 0x3C, 0x4E, 0x96, 0x09, 0xBF, 0xFB, 0x29,
+			0xEB, 0xD1, 0x33, 0xE6, 0x71, 0x0A, 0xBC, 0xB0, 0xB9, 0x9F, 0x30, 0x8E, 0xBE, 0xE2, 0xBD, 0xC3, 0x59, 0xC5, 0x94, 0x93,
+			0xC6, 0xDD, 0xD5, 0xFA, 0xED, 0x6C, 0x36, 0xBB, 0xB2, 0xB2, 0xE2, 0xFB, 0xFE, 0x67, 0x8D, 0x5F, 0x8C, 0xC7, 0xE3, 0x67,
+			0x9D, 0x53, 0x4C, 0xBA, 0x7E, 0x33, 0xBA, 0xF8, 0x7E, 0xE6, 0x63, 0x1A, 0xDA, 0xCF, 0x98, 0xC7, 0x6D, 0xDB, 0xFE, 0xE1,
+			0xE8, 0xF5, 0x17, 0x27, 0x6F, 0x9E, 0x8E, 0x8F, 0x5C, 0xCE, 0x7E, 0x72, 0xD1, 0xB9, 0x8E, 0xA1, 0x7C, 0x23, 0xBC, 0x60,
+			0xDB, 0xF6, 0x59, 0xFD, 0x32, 0x35, 0x24, 0x04, 0x16, 0x49, 0x7F, 0xDF, 0xB3, 0x3F, 0x84, 0xDC, 0xCD, 0x54, 0xDB, 0xC5,
+			0x62, 0xF1, 0xD0, 0xE4, 0xAE, 0xA3, 0xDE, 0x7D, 0x9E, 0xE7, 0x1D, 0xF5, 0xEE, 0x83, 0x1A, 0x28, 0x8C, 0x03, 0xA1, 0x12,
+			0x82, 0x6D, 0x8A, 0x6E, 0x2B, 0x99, 0xE2, 0x7E, 0x8A, 0x8E, 0x74, 0xEA, 0x12, 0x89, 0x03, 0x35, 0x88, 0x0A, 0xB4, 0x58,
+			0x10, 0x61, 0x0D, 0x89, 0x55, 0xE5, 0x89, 0xB1, 0xAB, 0x39, 0x56, 0x6C, 0x39, 0x36, 0xA7, 0x34, 0xA9, 0xE0, 0x24, 0xE4,
+			0xDF, 0x18, 0x26, 0x69, 0x86, 0xA2, 0x2C, 0x8C, 0x85, 0xB2, 0x2C, 0x6B, 0x3C, 0x1E, 0x67, 0xB3, 0x59, 0xB0, 0x5F, 0xB2,
+			0xAB, 0xC5, 0x62, 0xC1, 0x91, 0xAF, 0xD5, 0x6A, 0xA0, 0xAC, 0x32, 0x3A, 0x06, 0x94, 0x03, 0xD1, 0x40, 0x64, 0xA3, 0x98,
+			0x48, 0x88, 0x86, 0x5A, 0xB5, 0x5A, 0x15, 0x4D, 0x59, 0x2C, 0x03, 0x21, 0x23, 0x83, 0x37, 0xB8, 0x78, 0x80, 0x11, 0x26,
+			0x7C, 0x60, 0x67, 0x81, 0x5E, 0x21, 0xDA, 0xE3, 0x99, 0xE8, 0x18, 0x07, 0x1E, 0x90, 0x61, 0x06, 0xA4, 0x8C, 0xE4, 0x4C,
+			0xD5, 0x6A, 0x95, 0x2C, 0x99, 0x2C, 0x9F, 0x6C, 0x5E, 0x04, 0x1C, 0x39, 0xCB, 0x00, 0xC2, 0xF4, 0x99, 0xCA, 0xB8, 0x76,
+			0xB2, 0xF9, 0xC1, 0x60, 0x00, 0x37, 0x9D, 0x54, 0x58, 0x85, 0x68, 0x89, 0x99, 0xC5, 0x48, 0x52, 0x4E, 0x20, 0xD2, 0x67,
+			0x7D, 0xA1, 0x81, 0x72, 0x47, 0xC2, 0xF4, 0x05, 0xB7, 0xA8, 0x54, 0x2A, 0x7C, 0x2C, 0xE2, 0x92, 0xEC, 0x58, 0x38, 0xB8,
+			0xCC, 0x3B, 0x21, 0xBA, 0x65, 0x18, 0x06, 0x08, 0x04, 0xDA, 0xD1, 0x30, 0xD6, 0x94, 0x75, 0xA2, 0x41, 0x80, 0x44, 0x89,
+			0x64, 0x99, 0xC7, 0xC7, 0x81, 0x07, 0xF6, 0x62, 0xAF, 0x03, 0x65, 0x22, 0x95, 0x45, 0x64, 0xCD, 0xAC, 0x2E, 0xA9, 0xD4,
+			0x53, 0xF0, 0xA4, 0xB9, 0x97, 0xFD, 0xD1, 0x6A, 0xB5, 0x58, 0x72, 0x40, 0x21, 0xA1, 0x52, 0x31, 0x47, 0x4D, 0x1A, 0x08,
+			0x71, 0x18, 0x28, 0x49, 0x08, 0x6D, 0x00, 0x4C, 0xA6, 0x5C, 0x2E, 0x23, 0xFE, 0x25, 0x83, 0xDC, 0x68, 0xFC, 0x21, 0x93,
+			0x40, 0x60, 0x47, 0x66, 0x84, 0xB5, 0xDB, 0xED, 0xB5, 0xB5, 0x35, 0x0C, 0x37, 0x0D, 0x99, 0x88, 0xF8, 0xB3, 0xA5, 0x98,
+			0x05, 0x48, 0x7E, 0x53, 0xAF, 0xAB, 0xB9, 0x1C, 0x98, 0x7E, 0xA4, 0xBE, 0x98, 0xC6, 0x40, 0xED, 0x01, 0xF5, 0xB7, 0xC4,
+			0xD9, 0xAB, 0x8B, 0x89, 0xA2, 0xA8, 0x56, 0xAB, 0x6D, 0x6F, 0x6F, 0x0B, 0x1D, 0x88, 0x1C, 0x42, 0xC8, 0xD7, 0x18, 0x4D,
+			0x1E, 0x20, 0x99, 0x0D, 0xF3, 0x15, 0x38, 0x1E, 0xC2, 0x41, 0xE6, 0xCF, 0x19, 0x64, 0x01, 0x1B, 0x5D, 0x9A, 0xFD, 0x34,
+			0xD3, 0x18, 0x4E, 0x77, 0xA5, 0x20, 0x2C, 0x2B, 0x35, 0x53, 0x2D, 0xBB, 0xA1, 0x61, 0xA4, 0x0C, 0xCD, 0x58, 0x2E, 0x77,
+			0x81, 0x97, 0x45, 0xB8, 0xEB, 0x12, 0x12, 0xB4, 0x6D, 0x69, 0x18, 0x66, 0x10, 0xF8, 0x46, 0x2A, 0xAD, 0x69, 0xBB, 0xA9,
+			0x6B, 0x18, 0x6A, 0xA6, 0x69, 0xEB, 0x41, 0xB0, 0xF0, 0x16, 0xA1, 0xAE, 0x60, 0x56, 0x19, 0x33, 0x99, 0x9C, 0xE1, 0xD0,
+			0x30, 0xB4, 0xA9, 0x4A, 0xD7, 0xAC, 0x50, 0xFD, 0x78, 0x37, 0x36, 0x04, 0x8A, 0x1D, 0x8F, 0xE7, 0x41, 0xA8, 0xC7, 0xB1,
+			0xB1, 0x2B, 0x88, 0xB8, 0x08, 0x65, 0x40, 0x92, 0x4A, 0x4E, 0x53, 0x86, 0xEF, 0xED, 0x3A, 0xA1, 0xC5, 0x7C, 0x11, 0x45,
+			0x8A, 0x82, 0xB6, 0x5C, 0x2E, 0x82, 0x40, 0x09, 0xD1, 0x50, 0x1E, 0x97, 0x2D, 0xA4, 0x2A, 0x75, 0xC6, 0x2E, 0xEB, 0x86,
+			0x25, 0x66, 0x67, 0x83, 0xE1, 0x10, 0x31, 0x90, 0xF8, 0xE3, 0x5A, 0x38, 0x54, 0x22, 0xC3, 0x2B, 0x0A, 0x2A, 0x88, 0x1F,
+			0x10, 0xF4, 0x41, 0x2E, 0x62, 0x5E, 0x3C, 0xFD, 0x7A, 0xF4, 0x7E, 0x53, 0xBF, 0xA1, 0x27, 0x7C, 0x30, 0x18, 0xA0, 0x15,
+			0x8C, 0x10, 0xE9, 0xE6, 0xE6, 0x26, 0x78, 0x05, 0xD4, 0x6F, 0x2E, 0x0C, 0x5C, 0x82, 0x42, 0x3D, 0xDC, 0xCD, 0x67, 0x0E,
+			0x9C, 0xCC, 0x66, 0xB3, 0x7F, 0xDE, 0x7A, 0xF1, 0x71, 0xFD, 0x91, 0x9D, 0x60, 0xE7, 0x5B, 0xA5, 0x27, 0xF0, 0xB8, 0x57,
+			0xF3, 0x9F, 0xB6, 0x67, 0xED, 0x6F, 0x5B, 0x4F, 0xA2, 0xE8, 0x67, 0x59, 0xD6, 0x1F, 0xAE, 0x7E, 0x5B, 0x30, 0xB1, 0x6F,
+			0x66, 0x1E, 0xBB, 0x9C, 0xFD, 0xE4, 0x85, 0xE0, 0x4C, 0xAA, 0xAC, 0x84, 0x1E, 0x0B, 0x85, 0x42, 0xB7, 0xDB, 0x7D, 0xC4,
+			0xBD, 0xD7, 0xA8, 0x2A, 0xF2, 0x2B, 0xC1, 0xEF, 0x0B, 0x85, 0x33, 0x4A, 0xB7, 0x7C, 0x39, 0xAB, 0x7E, 0x56, 0x8C, 0xEE,
+			0x56, 0x53, 0xD2, 0x96, 0xB1, 0x42, 0x02, 0xF3, 0xF9, 0xFC, 0x09, 0xFF, 0xC1, 0xC9, 0xD6, 0x64, 0xEA, 0x2A, 0x59, 0x91,
+			0x87, 0xB2, 0x77, 0x99, 0x8E, 0xE9, 0x9A, 0xEE, 0xAB, 0xB3, 0x73, 0xCF, 0x3A, 0xA7, 0xAE, 0xE4, 0x6E, 0x1E, 0xF7, 0x1F,
+			0x98, 0xCF, 0xE7, 0xDF, 0xCE, 0x3F, 0x29, 0xFC, 0x7D, 0x68, 0xE6, 0xB9, 0x5C, 0xEE, 0x6D, 0xED, 0xFD, 0xE7, 0xB2, 0xA7,
+			0x39, 0x8F, 0x8B, 0xC5, 0xE2, 0xAC, 0x7E, 0x39, 0xAF, 0xAD, 0x8A, 0x55, 0x65, 0x54, 0xA1, 0x69, 0x9A, 0xFB, 0x79, 0x87,
+			0x32, 0xEF, 0x6F, 0x7F, 0x83, 0x88, 0xC4, 0x01, 0xA4, 0x4A, 0x02, 0xC3, 0x8A, 0xAE, 0xC0, 0xFE, 0x8C, 0x41, 0xA4, 0x9B,
+			0xE9, 0x5C, 0x91, 0x8F, 0x15, 0x29, 0xD9, 0xFD, 0x5D, 0xD6, 0x12, 0x7C, 0x08, 0x0D, 0x57, 0xDE, 0x29, 0x93, 0x24, 0x24,
+			0xDC, 0x81, 0x33, 0x83, 0xB9, 0x87, 0x00, 0xC2, 0x8E, 0xAD, 0x56, 0xAB, 0x08, 0xE8, 0x96, 0x4A, 0x25, 0x9A, 0x1E, 0x20,
+			0x2B, 0xAA, 0x45, 0xEC, 0x2C, 0x8A, 0xD5, 0xDD, 0x09, 0xEF, 0x04, 0x7C, 0x58, 0x46, 0xBE, 0x4E, 0x66, 0x81, 0x48, 0xC1,
+			0x9F, 0xF1, 0x8A, 0x89, 0x27, 0x52, 0x8C, 0x20, 0x44, 0xE0, 0x29, 0x3B, 0xD1, 0xEB, 0x4A, 0x94, 0x20, 0x5D, 0xBB, 0x29,
+			0x4B, 0x81, 0x27, 0xF4, 0x00, 0x92, 0x6B, 0xCE, 0xE7, 0x73, 0x84, 0xE2, 0x81, 0x25, 0x98, 0xAE, 0x4E, 0x97, 0x3B, 0xB5,
+			0x6E, 0xE2, 0x24, 0xC2, 0x11, 0x46, 0xC9, 0x4B, 0xB9, 0x45, 0x46, 0x2F, 0x10, 0x9E, 0x52, 0xDC, 0x22, 0xA7, 0x1C, 0x8D,
+			0x46, 0x44, 0x54, 0x44, 0x36, 0x58, 0x79, 0xC0, 0x03, 0xCA, 0xBF, 0x00, 0x38, 0x48, 0xD1, 0x89, 0xB8, 0x32, 0x75, 0x6C,
+			0xE6, 0xDD, 0xD3, 0x7E, 0x04, 0x60, 0x2E, 0x1D, 0x3F, 0x51, 0xD4, 0x91, 0xF2, 0x3E, 0x0D, 0xCF, 0xE0, 0xF3, 0xB8, 0x58,
+			0x69, 0xC5, 0xEF, 0x74, 0x3A, 0x94, 0xA9, 0xFF, 0xD7, 0xC3, 0x42, 0x83, 0x13, 0x8B, 0x8F, 0x7F, 0xA3, 0xD3, 0x52, 0x0A,
+			0x3B, 0x3C, 0x3B, 0x6A, 0x2C, 0xC0, 0x1D, 0x04, 0x4D, 0x34, 0x50, 0xEC, 0xE7, 0x38, 0x86, 0x7B, 0x0A, 0x42, 0x95, 0x4A,
+			0xA5, 0x58, 0x2C, 0x92, 0xA1, 0xD0, 0xF0, 0xCD, 0xDA, 0x13, 0xC7, 0x01, 0xC6, 0x61, 0x04, 0xAB, 0xD5, 0xAA, 0x30, 0x3A,
+			0x30, 0xAC, 0x4C, 0x89, 0x43, 0xD8, 0x4B, 0xEA, 0xC0, 0x10, 0x6C, 0xA0, 0x6A, 0xD1, 0x6D, 0x44, 0xEB, 0x16, 0x78, 0x28,
+			0x8B, 0x1D, 0x86, 0x61, 0xB3, 0xD9, 0x64, 0x6E, 0x3B, 0x8D, 0x7F, 0xD4, 0x12, 0x39, 0x1E, 0xC4, 0xE0, 0x92, 0xAF, 0x15,
+			0x8B, 0xC5, 0x03, 0x07, 0x0E, 0x40, 0xC5, 0x95, 0x91, 0x46, 0xC0, 0x1A, 0xAC, 0x3A, 0x69, 0x17, 0x77, 0x2D, 0x88, 0x3F,
+			0xC8, 0x0F, 0xB3, 0xE6, 0xC9, 0x7F, 0x11, 0xAD, 0xA4, 0x45, 0x90, 0xA1, 0x69, 0x64, 0xB5, 0x38, 0x36, 0x41, 0xDB, 0x81,
+			0x83, 0xF9, 0x34, 0x00, 0x13, 0x19, 0x9D, 0x21, 0xF8, 0xBB, 0x40, 0xB4, 0xE0, 0xF5, 0x6C, 0x74, 0x65, 0xB0, 0xF7, 0xEA,
+			0x6F, 0x32, 0xAB, 0x4F, 0xC4, 0xAA, 0x64, 0x4E, 0xAF, 0xD4, 0x97, 0xA4, 0xE4, 0xC0, 0x4D, 0xB1, 0xA0, 0x24, 0xF8, 0x22,
+			0x25, 0x2D, 0xDA, 0x90, 0x68, 0x94, 0x0B, 0x4B, 0x52, 0x0A, 0x5C, 0xC4, 0x83, 0x82, 0x78, 0xEC, 0x1F, 0xD6, 0x26, 0x24,
+			0xEE, 0x52, 0xA9, 0xD4, 0x68, 0x34, 0xA8, 0x65, 0xA1, 0xF9, 0x4C, 0xD7, 0x37, 0x93, 0x95, 0x64, 0xDC, 0x0D, 0x62, 0xCE,
+			0x44, 0x70, 0xDC, 0x20, 0x69, 0x16, 0x3D, 0x38, 0xA2, 0x2E, 0x40, 0x55, 0x9F, 0x59, 0x43, 0x3C, 0x22, 0x00, 0xBD, 0xFD,
+			0x99, 0x78, 0xB3, 0xD9, 0x94, 0xC9, 0x18, 0x00, 0x05, 0x34, 0x70, 0x09, 0x14, 0x3E, 0x1E, 0x8F, 0xA9, 0x2D, 0x03, 0xFA,
+			0x93, 0x5F, 0x4B, 0x6A, 0x5F, 0xAB, 0xD5, 0xB8, 0x05, 0x68, 0x66, 0x09, 0xA5, 0x6B, 0xF1, 0x84, 0x71, 0x14, 0x1D, 0x50,
+			0x00, 0x7A, 0xCA, 0x15, 0xCF, 0xB9, 0xA7, 0x69, 0xEA, 0x56, 0x01, 0x51, 0x72, 0x1C, 0xB0, 0x50, 0xAD, 0x56, 0x2B, 0x93,
+			0xC9, 0xDC, 0xDF, 0xFF, 0x9A, 0x61, 0x18, 0x7F, 0x35, 0x7C, 0xED, 0xA2, 0x73, 0x7D, 0x36, 0x9B, 0x35, 0x1A, 0x0D, 0x48,
+			0x87, 0xCC, 0xA4, 0xFB, 0x74, 0xF5, 0xEF, 0xBE, 0xD7, 0x79, 0x49, 0xF2, 0xA7, 0x37, 0xA3, 0x8B, 0x6B, 0x6B, 0x6B, 0x40,
+			0x3D, 0xD2, 0x4D, 0x0D, 0x97, 0xDC, 0xB2, 0xAC, 0xEF, 0x75, 0x5E, 0x7A, 0xC2, 0x38, 0x7A, 0x64, 0x7A, 0x77, 0x1C, 0xC7,
+			0xCC, 0x24, 0x80, 0x60, 0xF3, 0x67, 0x3B, 0x3F, 0x9A, 0xCD, 0x66, 0x07, 0x0E, 0x1C, 0xA0, 0x37, 0xF3, 0x07, 0x83, 0x9F,
+			0x7C, 0xA7, 0xF8, 0x14, 0x71, 0xD2, 0x6B, 0xCB, 0xF3, 0xFB, 0x09, 0xD9, 0x00, 0x9B, 0xCF, 0x9C, 0x3C, 0x29, 0xE1, 0xA1,
+			0xAC, 0x9A, 0x34, 0xD6, 0xCA, 0xCC, 0x25, 0x0E, 0x29, 0x64, 0x47, 0x29, 0xB4, 0x4A, 0x0F, 0x57, 0x14, 0x45, 0xFF, 0xEC,
+			0x99, 0x67, 0x04, 0xF9, 0xE1, 0x57, 0x6C, 0x89, 0x67, 0x4F, 0x9D, 0x92, 0x5D, 0x24, 0x93, 0x3C, 0x24, 0x93, 0x10, 0xA6,
+			0xBF, 0x7C, 0x23, 0x13, 0x99, 0x44, 0x9A, 0x46, 0x52, 0xDB, 0xEF, 0x3C, 0xF5, 0x94, 0x88, 0xCB, 0xCA, 0xA0, 0x15, 0xE6,
+			0x1E, 0x83, 0x74, 0x53, 0x83, 0xA5, 0x3F, 0x7F, 0x65, 0x65, 0x05, 0x5A, 0x87, 0xCC, 0xE8, 0x86, 0xC5, 0x04, 0x47, 0x00,
+			0xA3, 0x4C, 0xA3, 0x25, 0x16, 0x43, 0xE6, 0x8C, 0xD2, 0x5B, 0x40, 0x65, 0x9B, 0x13, 0xB7, 0x3F, 0x6F, 0x90, 0xA4, 0x84,
+			0x49, 0x53, 0x08, 0xD2, 0x21, 0xC3, 0x80, 0xA0, 0x2F, 0x5A, 0x52, 0xE0, 0x36, 0x4C, 0x06, 0xE6, 0x3D, 0xFD, 0x7E, 0x1F,
+			0xC5, 0x0B, 0x9A, 0x90, 0xE0, 0xB9, 0x70, 0x12, 0xF7, 0x64, 0x57, 0x34, 0xD1, 0x51, 0xE6, 0x64, 0xD1, 0x02, 0x4D, 0x35,
+			0x14, 0x14, 0x94, 0xC3, 0x95, 0xC9, 0x64, 0xE8, 0x3F, 0x15, 0x25, 0x09, 0xF2, 0x06, 0x64, 0xE4, 0xA1, 0xF7, 0xC0, 0x38,
+			0x52, 0x8C, 0xEE, 0x84, 0x16, 0xC5, 0xB4, 0x2E, 0xCA, 0x84, 0x00, 0x59, 0xEC, 0x31, 0x2A, 0x13, 0xD4, 0x45, 0x98, 0xD9,
+			0x89, 0x18, 0x1C, 0x3A, 0x13, 0x8C, 0x2A, 0x83, 0x09, 0x42, 0x45, 0x04, 0xFA, 0x99, 0xF2, 0xF4, 0x20, 0x65, 0x48, 0x25,
+			0x53, 0xAD, 0x26, 0xC2, 0x15, 0x6B, 0xCE, 0x5C, 0x0E, 0x92, 0x0B, 0xC0, 0x2F, 0xAC, 0x3F, 0xAA, 0xFA, 0x8C, 0x71, 0x27,
+			0x3C, 0xC7, 0x3E, 0x82, 0xAB, 0x60, 0xDC, 0xC1, 0x5B, 0x01, 0x85, 0x84, 0xCD, 0x49, 0x4F, 0x1A, 0x5D, 0x57, 0x4C, 0xD7,
+			0x92, 0x4E, 0x68, 0x1A, 0x38, 0xF7, 0x0B, 0xB4, 0xD1, 0x0E, 0x06, 0xAB, 0x44, 0x44, 0x92, 0x69, 0x4A, 0x40, 0x7A, 0x41,
+			0xB1, 0xDA, 0x93, 0x85, 0x47, 0xAE, 0x9D, 0x09, 0x3B, 0x90, 0x94, 0x48, 0x44, 0x78, 0x0A, 0x28, 0xC6, 0xC0, 0x0F, 0x91,
+			0xB0, 0x17, 0x22, 0x26, 0xCE, 0x1F, 0x9B, 0x82, 0x0D, 0xC2, 0xBD, 0x03, 0x5C, 0x60, 0x88, 0x05, 0x12, 0x15, 0x85, 0x6E,
+			0xE6, 0x41, 0xB2, 0xF3, 0x40, 0x72, 0x28, 0xC5, 0xA8, 0x20, 0x65, 0x6F, 0x3D, 0x36, 0x36, 0x36, 0x68, 0x38, 0x92, 0xE9,
+			0x48, 0xE4, 0x01, 0x07, 0x0E, 0x1C, 0x20, 0x20, 0x02, 0xAB, 0x65, 0x39, 0x45, 0xD3, 0x98, 0xC0, 0x4A, 0x4E, 0xB2, 0xF0,
+			0x34, 0xC8, 0x31, 0x05, 0xD2, 0xD9, 0xAF, 0xFB, 0x2F, 0xA3, 0xBD, 0xC9, 0x31, 0xE9, 0xB8, 0x91, 0x58, 0x4F, 0x80, 0x20,
+			0x69, 0x80, 0x4C, 0x52, 0xD5, 0xDD, 0x69, 0xBA, 0xD2, 0x14, 0x2A, 0xD0, 0xB0, 0xE8, 0x76, 0x91, 0x04, 0x70, 0xCB, 0x50,
+			0xBC, 0x45, 0x56, 0x4F, 0x84, 0x3C, 0x3D, 0xCF, 0xDB, 0xDA, 0xDA, 0x22, 0x03, 0xC5, 0x04, 0x10, 0xD7, 0x50, 0xE1, 0xA4,
+			0x0B, 0x84, 0xEC, 0x0A, 0x91, 0x74, 0xA2, 0x0A, 0x29, 0xF9, 0xEE, 0x1F, 0xE0, 0x47, 0x07, 0x0D, 0x8F, 0x14, 0x75, 0x6E,
+			0xC6, 0x6C, 0x21, 0x78, 0x59, 0xAD, 0x56, 0x29, 0x32, 0xE1, 0xB7, 0x88, 0x42, 0xD4, 0xB1, 0x48, 0xEC, 0x4B, 0xB5, 0x5A,
+			0x6D, 0x34, 0x1A, 0x74, 0xFA, 0x50, 0x18, 0xC0, 0xE5, 0x30, 0x4A, 0x90, 0x51, 0x56, 0xDD, 0x6E, 0x77, 0x63, 0x63, 0xE3,
+			0xD6, 0xAD, 0x5B, 0xC0, 0xB2, 0x0C, 0xEE, 0xA7, 0x43, 0x05, 0xD7, 0x45, 0xBE, 0x3C, 0x9F, 0xCF, 0x57, 0x57, 0x57, 0x0F,
+			0x8F, 0xBF, 0x71, 0xAD, 0xF0, 0x99, 0x50, 0x98, 0x80, 0xAA, 0xDA, 0xC9, 0x8B, 0xDE, 0x31, 0x68, 0xA0, 0x4F, 0x18, 0x47,
+			0x1F, 0xD3, 0x1E, 0x66, 0x90, 0xC6, 0x5F, 0x74, 0x5F, 0xC6, 0xC7, 0x37, 0x9B, 0xCD, 0x7B, 0xBB, 0xBF, 0xF3, 0x9C, 0x7B,
+			0x1A, 0x30, 0x33, 0x8A, 0xA2, 0xEF, 0x14, 0x9F, 0xA2, 0xCD, 0x65, 0x6D, 0x6D, 0x6D, 0xB9, 0x5C, 0xBE, 0x1E, 0xBC, 0x57,
+			0x28, 0x14, 0xBE, 0xD7, 0x79, 0x09, 0x3B, 0x7E, 0xC6, 0x3C, 0xFE, 0xA3, 0xF1, 0x1B, 0x8D, 0x46, 0x03, 0xC1, 0x00, 0x66,
+			0xB4, 0xBD, 0x19, 0x5D, 0x7C, 0xCA, 0x7A, 0xF4, 0x72, 0xF6, 0x93, 0x66, 0xB3, 0xB9, 0xF5, 0x75, 0x45, 0x8E, 0xF8, 0x83,
+			0xDA, 0xF3, 0x68, 0xAD, 0xCF, 0xE7, 0xF3, 0x47, 0xE6, 0xF7, 0x7A, 0x9E, 0x77, 0x77, 0xFB, 0xCB, 0x5C, 0x18, 0xE9, 0xF2,
+			0x9F, 0xBF, 0xF8, 0xA2, 0xF0, 0x6A, 0x24, 0xDE, 0xE7, 0xDF, 0x82, 0xD5, 0xE0, 0xFC, 0x6C, 0xDB, 0xA6, 0xBE, 0x25, 0x4D,
+			0xA7, 0xE2, 0xE7, 0x2C, 0xCB, 0x7A, 0xE9, 0xED, 0xB7, 0x09, 0x59, 0xF6, 0xC3, 0x7D, 0xAD, 0x56, 0xEB, 0xCF, 0x7E, 0xF4,
+			0x23, 0xC1, 0x91, 0xC4, 0x31, 0xC8, 0x14, 0x17, 0x22, 0x15, 0xFE, 0x93, 0x61, 0x3B, 0x1C, 0x58, 0xB6, 0x99, 0x6C, 0x3F,
+			0xCB, 0xB2, 0x5E, 0x3E, 0x7B, 0x56, 0x3E, 0x07, 0x47, 0x92, 0xE0, 0x28, 0x4A, 0x8C, 0x80, 0x69, 0xA9, 0xF8, 0x48, 0x8A,
+			0x9F, 0x74, 0xA7, 0x72, 0xBA, 0x61, 0x81, 0xC3, 0x13, 0x25, 0x3D, 0xE5, 0xEC, 0x70, 0xD9, 0xC2, 0x53, 0xE0, 0xE2, 0x21,
+			0x82, 0x53, 0xDD, 0xC4, 0xA1, 0x72, 0xDE, 0x81, 0x89, 0xE4, 0x9A, 0x39, 0xE3, 0xA2, 0xF4, 0x4E, 0x69, 0xC1, 0x75, 0x5D,
+			0x26, 0x2C, 0x92, 0xCF, 0x51, 0x02, 0x04, 0x57, 0x04, 0xA7, 0x85, 0x1B, 0xC2, 0x24, 0x00, 0x30, 0x67, 0x25, 0x5C, 0xB6,
+			0xC7, 0xC5, 0x64, 0x05, 0x09, 0xC2, 0x40, 0x5F, 0x55, 0xB9, 0x35, 0xF9, 0x76, 0xA2, 0x04, 0x44, 0x4C, 0xE1, 0x9A, 0xCB,
+			0x10, 0x53, 0xE4, 0x6F, 0x65, 0xB0, 0x1D, 0x17, 0x03, 0x40, 0xC2, 0xE9, 0xC3, 0xA0, 0x25, 0x76, 0x5B, 0x35, 0x3C, 0xD2,
+			0xF7, 0x00, 0x90, 0x95, 0xC9, 0x64, 0x20, 0x3B, 0x41, 0xAE, 0xE1, 0x63, 0x45, 0xDA, 0x52, 0x66, 0xF9, 0x49, 0xF3, 0x33,
+			0x8F, 0x42, 0x99, 0x08, 0x12, 0x04, 0x4E, 0x57, 0xA5, 0x52, 0xA1, 0x05, 0x8C, 0x2E, 0x2D, 0xD1, 0x40, 0x96, 0x86, 0x26,
+			0xE8, 0x37, 0x00, 0xE5, 0xB8, 0x41, 0x6A, 0xE8, 0xC5, 0x62, 0x91, 0x01, 0x61, 0x1C, 0x15, 0x88, 0x59, 0x44, 0x58, 0x8C,
+			0x13, 0xC0, 0x7B, 0x33, 0xA3, 0x52, 0x4A, 0xFC, 0xF0, 0x7C, 0xA5, 0xF2, 0x2E, 0xE5, 0x23, 0x8E, 0x34, 0x15, 0x12, 0xD1,
+			0xBE, 0xA0, 0x15, 0x10, 0xDB, 0xC4, 0x68, 0x49, 0x02, 0x19, 0xE6, 0x88, 0xC2, 0x6D, 0x22, 0x2A, 0x84, 0x4D, 0x81, 0xDF,
+			0xE2, 0xB9, 0xCB, 0xCC, 0x83, 0x7C, 0x3E, 0x8F, 0x0F, 0x44, 0xD5, 0x59, 0x06, 0x88, 0x13, 0xE8, 0x91, 0x08, 0xCB, 0x04,
+			0x12, 0x3A, 0x06, 0x80, 0xC2, 0xA9, 0x79, 0x00, 0x17, 0xC2, 0x0A, 0xB0, 0x6D, 0x5B, 0x5A, 0x5A, 0x3A, 0x9D, 0x0E, 0x95,
+			0x4C, 0x6A, 0x4A, 0x00, 0x5C, 0xF4, 0x9A, 0xB1, 0x48, 0x54, 0x9F, 0x50, 0x90, 0x6F, 0xB5, 0x5A, 0x48, 0xD1, 0x66, 0xB3,
+			0x59, 0x9A, 0x8A, 0x2B, 0x39, 0x3B, 0x6B, 0xC5, 0x39, 0x5B, 0xA3, 0xE1, 0xB6, 0x92, 0xB3, 0x73, 0xB6, 0xD6, 0x9E, 0xA7,
+			0x6E, 0x0F, 0x82, 0x8C, 0x19, 0xD1, 0x70, 0xBB, 0x35, 0x8A, 0x0A, 0x8E, 0xE1, 0x18, 0x2A, 0x0F, 0x68, 0x4E, 0xB4, 0x9E,
+			0x6F, 0x53, 0xD1, 0xCD, 0xA7, 0x95, 0xFE, 0x73, 0xCE, 0xD6, 0xA8, 0x1E, 0xB7, 0x66, 0x06, 0xBD, 0xBE, 0x19, 0x33, 0x2A,
+			0xBA, 0xA9, 0x5B, 0xFD, 0xA5, 0xCA, 0x52, 0x8D, 0x00, 0xF5, 0xE6, 0xAD, 0x51, 0x44, 0x01, 0xD9, 0x8A, 0x17, 0xCD, 0x89,
+			0xE6, 0x18, 0x81, 0x9B, 0x0A, 0x53, 0xE1, 0xBC, 0x35, 0x33, 0x36, 0x87, 0x61, 0x3E, 0xAD, 0x57, 0x0B, 0x0A, 0xBF, 0xDA,
+			0x1A, 0x45, 0xB6, 0xE6, 0xD3, 0x3C, 0x7C, 0x7B, 0x10, 0x6C, 0x8F, 0x77, 0xB5, 0xA0, 0xDD, 0x54, 0xD8, 0x5F, 0xA6, 0x1D,
+			0x23, 0xA0, 0x40, 0xFD, 0xAB, 0x9E, 0xDF, 0x9A, 0x19, 0xCC, 0x4A, 0x43, 0xAD, 0x81, 0xE7, 0x7C, 0xFB, 0xF6, 0x6D, 0x50,
+			0x11, 0x8C, 0x38, 0x1E, 0x48, 0x04, 0x88, 0xC0, 0xD3, 0xE8, 0x9C, 0x64, 0xF0, 0x9E, 0x64, 0x21, 0x42, 0x51, 0x80, 0xFE,
+			0x01, 0x19, 0xA3, 0x5A, 0xAD, 0x52, 0x93, 0x97, 0x4A, 0x1A, 0x83, 0x9F, 0x18, 0xCD, 0xCF, 0xCE, 0xCC, 0xE7, 0xF3, 0xBD,
+			0x5E, 0x8F, 0x74, 0x4D, 0x46, 0xE8, 0x4D, 0x26, 0x13, 0xE6, 0xF2, 0x4B, 0x6E, 0xA1, 0xEB, 0xFA, 0xD6, 0xD6, 0x16, 0xB2,
+			0x10, 0x1B, 0x1B, 0x1B, 0xF5, 0x7A, 0x1D, 0x72, 0x27, 0x28, 0xF9, 0x3D, 0x9D, 0xAF, 0x18, 0xC9, 0xEB, 0xC5, 0xC9, 0x9B,
+			0x3F, 0x1C, 0xBD, 0x6E, 0xDB, 0xF6, 0x1D, 0xDB, 0xFF, 0x54, 0x81, 0x06, 0xC7, 0x22, 0xF8, 0x5A, 0xB9, 0x5C, 0xEE, 0xA3,
+			0xF2, 0x17, 0x8E, 0xE3, 0xBC, 0x38, 0x79, 0x13, 0xF4, 0x40, 0xF2, 0x63, 0xE6, 0x61, 0x3C, 0xA6, 0x3D, 0x8C, 0xA4, 0x0A,
+			0x59, 0x36, 0x75, 0x35, 0xDC, 0x2A, 0x8D, 0xBE, 0x8E, 0xE3, 0xBC, 0xBA, 0x38, 0xC7, 0x95, 0xBC, 0xAD, 0xBD, 0x9F, 0xC9,
+			0x64, 0x9E, 0x75, 0x4E, 0x4D, 0x26, 0x93, 0xEB, 0x95, 0x9F, 0x3D, 0xEB, 0x9C, 0x12, 0x76, 0x00, 0x15, 0xAF, 0x27, 0x53,
+			0xC7, 0x48, 0xA4, 0xDE, 0x08, 0x2F, 0xD4, 0x6F, 0x96, 0x49, 0x9A, 0x2F, 0xB9, 0x37, 0x38, 0x02, 0xAE, 0xEB, 0x5E, 0x72,
+			0x6F, 0xAC, 0xAC, 0xAC, 0x60, 0xD3, 0x61, 0x52, 0xC8, 0x7C, 0x24, 0x11, 0xE7, 0xC0, 0xBB, 0x63, 0x88, 0xF7, 0x8B, 0x88,
+			0x49, 0x25, 0x5C, 0x04, 0xDD, 0x44, 0x4F, 0x42, 0x08, 0x42, 0xD2, 0xDE, 0x25, 0xBD, 0xC1, 0x22, 0xBF, 0x23, 0xAD, 0x03,
+			0x84, 0xAE, 0xFC, 0x5C, 0x21, 0x87, 0x49, 0x15, 0x90, 0x51, 0x51, 0xFC, 0x90, 0x24, 0x52, 0x46, 0x18, 0x49, 0x6D, 0x40,
+			0x72, 0x5C, 0xCF, 0xF3, 0xEA, 0xF5, 0x3A, 0xEB, 0x25, 0x48, 0x88, 0x4C, 0x12, 0x96, 0x18, 0x16, 0x61, 0x76, 0x60, 0x16,
+			0x5D, 0xD7, 0x95, 0x76, 0x5B, 0x12, 0x01, 0xE4, 0x72, 0x39, 0x01, 0x70, 0xC0, 0x87, 0x79, 0x14, 0x70, 0x49, 0xC8, 0x09,
+			0xD8, 0x3C, 0x44, 0x69, 0x52, 0x7F, 0x06, 0x78, 0xC4, 0x73, 0xC0, 0x55, 0x41, 0xC7, 0x4C, 0xE6, 0x9D, 0x09, 0xE0, 0x0E,
+			0x8E, 0x54, 0x2A, 0x95, 0x0A, 0x85, 0x02, 0x92, 0x8E, 0x62, 0x21, 0xB9, 0x53, 0x28, 0x9E, 0xC4, 0x8B, 0x64, 0x30, 0x68,
+			0x56, 0x8B, 0x6D, 0xC1, 0x28, 0xB3, 0x25, 0xB0, 0x5A, 0x64, 0xAE, 0x10, 0x97, 0x61, 0xFB, 0x88, 0x40, 0x1E, 0xD4, 0x00,
+			0x5D, 0xD7, 0xE9, 0x86, 0x23, 0x20, 0xA3, 0xC9, 0x19, 0x59, 0x2D, 0xC2, 0x3B, 0xE4, 0x09, 0x98, 0x07, 0x00, 0x1F, 0x87,
+			0xDF, 0xCA, 0xDC, 0x2D, 0xA4, 0x19, 0xA4, 0xB6, 0x41, 0x4F, 0x00, 0x22, 0x5A, 0xB4, 0x4D, 0x50, 0x7D, 0x54, 0x8E, 0x65,
+			0x36, 0x9B, 0x71, 0xEA, 0x86, 0xC3, 0x21, 0x9D, 0x5F, 0xCC, 0x5B, 0x67, 0x50, 0x17, 0x82, 0xDA, 0xC8, 0x64, 0x02, 0x7A,
+			0x30, 0x03, 0x84, 0xE6, 0x3A, 0xAA, 0xA0, 0xD2, 0xA6, 0x8F, 0x1B, 0x64, 0x2C, 0x9F, 0x4C, 0x0A, 0xA5, 0xE1, 0x9E, 0x58,
+			0x9E, 0x73, 0xCE, 0xFD, 0x23, 0x30, 0x47, 0x28, 0xC7, 0xC9, 0x81, 0xCF, 0x63, 0x9A, 0x26, 0xFD, 0x59, 0x5C, 0xDB, 0x78,
+			0x3C, 0x26, 0x2E, 0x00, 0x41, 0xA3, 0x82, 0x0A, 0xC0, 0x32, 0x99, 0x4C, 0x1A, 0x8D, 0x06, 0x12, 0xCD, 0x14, 0x37, 0xC6,
+			0xE3, 0x31, 0x5A, 0x0B, 0x24, 0xFE, 0x04, 0x08, 0x30, 0x97, 0x34, 0x4D, 0xBB, 0x75, 0xEB, 0x16, 0xD1, 0x3D, 0x81, 0x0F,
+			0xCE, 0x4F, 0x74, 0x2B, 0xB9, 0x36, 0x4A, 0xB8, 0xE5, 0x72, 0x99, 0x99, 0x41, 0xDC, 0x20, 0x25, 0x07, 0x68, 0x51, 0x07,
+			0x0E, 0x1C, 0x90, 0x20, 0x1A, 0xEB, 0x96, 0xC9, 0x64, 0x1A, 0x8D, 0x06, 0x56, 0x0F, 0x6B, 0x85, 0xF6, 0xDE, 0x60, 0x30,
+			0xA0, 0x52, 0x07, 0x09, 0x1A, 0x17, 0x4D, 0x2F, 0x22, 0x07, 0x8F, 0x08, 0x51, 0x4E, 0x88, 0x8C, 0xF7, 0xE2, 0x40, 0x72,
+			0x3E, 0x45, 0xC9, 0x5D, 0xF4, 0x79, 0x44, 0xA2, 0x47, 0x26, 0xFD, 0xEE, 0x97, 0x5D, 0xDC, 0x3F, 0xB2, 0x86, 0x5D, 0x2E,
+			0xB9, 0x3C, 0x4D, 0x1B, 0x52, 0x6D, 0x26, 0x18, 0xE1, 0x84, 0x2B, 0x4B, 0xA7, 0xB0, 0x90, 0x85, 0x84, 0xFF, 0x22, 0x24,
+			0xC0, 0x1B, 0xA8, 0x0F, 0x09, 0x25, 0x54, 0x90, 0x07, 0x91, 0x44, 0xE5, 0xFA, 0x21, 0xCC, 0xC1, 0x59, 0x86, 0x86, 0x28,
+			0xC0, 0x02, 0x39, 0x3E, 0xC8, 0x03, 0xC4, 0x62, 0xDC, 0x36, 0x08, 0x0F, 0x21, 0x18, 0x46, 0x9F, 0x0A, 0x21, 0x07, 0x06,
+			0x54, 0x57, 0x38, 0x45, 0xE4, 0xF2, 0xD2, 0x6C, 0x4C, 0x03, 0xB9, 0xF0, 0xBB, 0xB6, 0xB7, 0xB7, 0x61, 0x7F, 0x23, 0xE9,
+			0x4A, 0x83, 0x08, 0x59, 0x0B, 0xB4, 0x71, 0xA9, 0x82, 0x92, 0x11, 0xCE, 0x66, 0xB3, 0x27, 0x53, 0xC7, 0x08, 0xA6, 0x2E,
+			0x3A, 0xD7, 0x07, 0x83, 0xC1, 0x43, 0x93, 0xBB, 0x98, 0xEE, 0xF2, 0xAE, 0x79, 0xCD, 0xB6, 0xED, 0x57, 0x17, 0xE7, 0xD2,
+			0x17, 0x14, 0x1A, 0xFE, 0x17, 0xDD, 0x97, 0x5F, 0x9C, 0xBC, 0xF9, 0x94, 0xF5, 0x28, 0xF8, 0xC3, 0xDB, 0xDA, 0xFB, 0xB2,
+			0x04, 0x04, 0x7A, 0xE4, 0x37, 0xC0, 0x0E, 0x58, 0x70, 0x32, 0x51, 0xF6, 0x0C, 0xAD, 0x30, 0x51, 0x14, 0xDD, 0xDF, 0xFF,
+			0x9A, 0xEF, 0xFB, 0xCF, 0xB9, 0xA7, 0x27, 0x93, 0xC9, 0x93, 0xA9, 0x63, 0xF3, 0xF9, 0xFC, 0x7A, 0xE5, 0x67, 0x8F, 0x69,
+			0x0F, 0xBB, 0xAE, 0xFB, 0x17, 0xDD, 0x97, 0xD3, 0xE9, 0xF4, 0xB5, 0xC2, 0x67, 0x51, 0x14, 0xBD, 0x6B, 0x5E, 0x73, 0x5D,
+			0xF7, 0xCD, 0xE8, 0xE2, 0x43, 0x93, 0xBB, 0xFE, 0xA8, 0xF1, 0x02, 0x32, 0x32, 0x99, 0x4C, 0xE6, 0xEE, 0xF6, 0x97, 0x41,
+			0xC3, 0xCF, 0x5B, 0x1F, 0x1C, 0x5B, 0xDC, 0xCF, 0x0E, 0xA4, 0x2A, 0xCB, 0x79, 0x91, 0x49, 0x4D, 0xFC, 0xA7, 0x74, 0x05,
+			0x13, 0xFD, 0x89, 0xB2, 0xA1, 0x84, 0x87, 0xB8, 0x64, 0xC4, 0x5D, 0x84, 0xF0, 0x4A, 0x70, 0x2D, 0x02, 0x1B, 0x18, 0x11,
+			0xA1, 0xF4, 0x80, 0xFE, 0x09, 0x4F, 0x94, 0x8F, 0x12, 0xDD, 0x63, 0x30, 0x03, 0x51, 0x20, 0x97, 0x51, 0x5C, 0x72, 0x01,
+			0x82, 0x3D, 0x72, 0x6D, 0xF5, 0x7A, 0x1D, 0x5A, 0x9D, 0x4C, 0xB1, 0xA7, 0x93, 0x96, 0x22, 0x19, 0x0A, 0x5A, 0x24, 0x76,
+			0xAA, 0x25, 0x7B, 0x3C, 0x26, 0xAA, 0xA0, 0x30, 0x13, 0x45, 0x11, 0xEF, 0x21, 0xD4, 0x45, 0xF9, 0x03, 0x7A, 0x25, 0x67,
+			0x8D, 0xEB, 0x21, 0x35, 0x81, 0xED, 0x82, 0xA9, 0x75, 0x5D, 0x97, 0xF2, 0x21, 0xC1, 0x1C, 0x85, 0x19, 0x11, 0xF8, 0xA2,
+			0xE1, 0x14, 0xB0, 0x5A, 0xC6, 0x85, 0x4A, 0x35, 0x5E, 0xE6, 0x78, 0x4B, 0xAD, 0x1B, 0xFE, 0x25, 0x41, 0x2A, 0xC2, 0x5F,
+			0xB3, 0xD9, 0xAC, 0xD5, 0x6A, 0x81, 0x2C, 0xA9, 0x43, 0x95, 0x08, 0x98, 0x4B, 0x4B, 0xB3, 0xE8, 0x37, 0x00, 0x34, 0x61,
+			0x91, 0x00, 0xFD, 0x29, 0x62, 0xD1, 0xD6, 0xDB, 0xEF, 0xF7, 0x11, 0x2E, 0x13, 0x66, 0x2D, 0x20, 0x0D, 0x53, 0x2E, 0xF0,
+			0x9A, 0x90, 0xE0, 0x39, 0xB3, 0x68, 0x72, 0x30, 0x7A, 0x8B, 0x9F, 0xA0, 0x67, 0x4E, 0xB8, 0x59, 0x2E, 0x97, 0x3B, 0x9D,
+			0x0E, 0x8D, 0x78, 0xC2, 0x6B, 0x57, 0x25, 0xE5, 0xD2, 0x1F, 0xFF, 0x71, 0x2E, 0xC9, 0x5C, 0x80, 0x9F, 0x76, 0x75, 0x81,
+			0xF6, 0xB2, 0xC5, 0xFD, 0x00, 0xC8, 0xFA, 0xDE, 0xFD, 0xAC, 0xFC, 0xC7, 0xFF, 0x88, 0x68, 0x65, 0x71, 0xAF, 0xDC, 0x24,
+			0x9C, 0x1F, 0xD5, 0x8A, 0x99, 0xEC, 0xBF, 0x62, 0xF2, 0xDC, 0x8B, 0x09, 0x8E, 0x44, 0x42, 0xC4, 0x6E, 0x73, 0x92, 0x0B,
+			0xC5, 0x85, 0x94, 0x93, 0x4A, 0x20, 0x15, 0x4E, 0xDF, 0xF7, 0x0F, 0xEC, 0x41, 0x84, 0x6B, 0xFF, 0xF9, 0x3F, 0x63, 0x01,
+			0x15, 0x92, 0x93, 0xE4, 0x0D, 0x02, 0x7C, 0x0B, 0xC7, 0xA0, 0x9C, 0xC4, 0x8C, 0x55, 0xCB, 0xBA, 0x78, 0xF1, 0x7A, 0xBD,
+			0x5E, 0x47, 0x5B, 0x58, 0x5A, 0xF2, 0x78, 0x4C, 0xC4, 0xEC, 0x44, 0x13, 0x64, 0x12, 0x2C, 0x27, 0xE8, 0x24, 0x1C, 0xA9,
+			0xE5, 0x72, 0xB9, 0xB3, 0xB3, 0x73, 0xF0, 0xE0, 0x41, 0xFE, 0x8A, 0x3D, 0xC4, 0x6E, 0x43, 0x0C, 0x84, 0x89, 0x98, 0x9C,
+			0x10, 0x14, 0xB5, 0x10, 0xA2, 0x20, 0x78, 0x87, 0x34, 0x42, 0xF5, 0x3B, 0x95, 0x4A, 0x6D, 0x6F, 0x6F, 0xE7, 0xF3, 0x79,
+			0x32, 0x24, 0xE9, 0x56, 0x13, 0x78, 0x81, 0xC3, 0x36, 0x9D, 0x4E, 0x0B, 0x85, 0xC2, 0xF6, 0xF6, 0x36, 0xCD, 0xA8, 0x7B,
+			0xB0, 0x92, 0xA6, 0x0A, 0x69, 0xDA, 0x6E, 0x97, 0x40, 0x18, 0xAA, 0xEE, 0x5C, 0xCF, 0x0B, 0x74, 0xDD, 0x88, 0xC2, 0xDD,
+			0xC6, 0xAE, 0x28, 0x52, 0xEC, 0x1D, 0x99, 0xD3, 0x94, 0x04, 0x3E, 0x8A, 0xF7, 0x16, 0xA9, 0xCB, 0x36, 0xB5, 0x44, 0xCF,
+			0x59, 0x0B, 0x77, 0x13, 0xED, 0xC0, 0xF3, 0xF4, 0x94, 0xBA, 0xD9, 0x5D, 0xF4, 0x5F, 0xD3, 0x47, 0x33, 0xF5, 0x93, 0xE5,
+			0x52, 0x71, 0x6C, 0xA2, 0x24, 0x4C, 0xB0, 0x8A, 0x8E, 0x19, 0xEA, 0x2A, 0x36, 0x0B, 0x54, 0x76, 0x95, 0x4A, 0x05, 0xE9,
+			0x94, 0xAA, 0x67, 0x24, 0xC6, 0x3A, 0x08, 0x42, 0x3D, 0xD6, 0x76, 0xF1, 0x04, 0xF5, 0x96, 0x04, 0x9A, 0x9B, 0x4D, 0xBC,
+			0x38, 0x56, 0x15, 0xBF, 0x20, 0x54, 0x88, 0x81, 0xBF, 0x54, 0xE4, 0x4E, 0x50, 0x17, 0x70, 0x42, 0x7A, 0x0E, 0x36, 0x36,
+			0x36, 0xA8, 0xB4, 0x6F, 0x6D, 0x6D, 0x61, 0x29, 0x40, 0x1A, 0x41, 0x0E, 0x91, 0x8A, 0xC4, 0x4A, 0xD2, 0x47, 0xCD, 0x63,
+			0x14, 0xF0, 0x8D, 0x4E, 0x16, 0x50, 0xF2, 0xE4, 0xC2, 0x52, 0x80, 0xA1, 0xA2, 0xB8, 0xD7, 0x68, 0x34, 0x18, 0x99, 0x9D,
+			0xC9, 0x64, 0xD6, 0xD6, 0xD6, 0x24, 0xCB, 0xC4, 0xD5, 0x4D, 0xA7, 0x53, 0xE0, 0x14, 0x32, 0x5A, 0x28, 0x1F, 0x3B, 0x3B,
+			0x3B, 0x74, 0x06, 0x6C, 0x6F, 0x6F, 0x4B, 0xDB, 0xC7, 0xFA, 0xFA, 0xFA, 0x68, 0xF4, 0xB1, 0x6D, 0xDB, 0x37, 0x56, 0x7E,
+			0xFE, 0x48, 0xFB, 0xDE, 0x40, 0x57, 0xF7, 0x38, 0x1A, 0x8D, 0xCE, 0x54, 0x8F, 0x47, 0x46, 0x34, 0x5D, 0x4E, 0x9F, 0x73,
+			0x4F, 0xBF, 0xAB, 0x5F, 0x22, 0x44, 0x78, 0x68, 0x72, 0x57, 0x3F, 0xE8, 0xAB, 0x59, 0x43, 0x83, 0xF8, 0x0F, 0x57, 0xBF,
+			0x0D, 0x39, 0x07, 0x2E, 0x1D, 0x00, 0xF1, 0x74, 0x3A, 0x7D, 0x6D, 0x79, 0xFE, 0xA4, 0x7E, 0xF8, 0x92, 0x7B, 0x23, 0x63,
+			0x66, 0xEE, 0xDA, 0xF9, 0x6D, 0x6A, 0x3C, 0x9D, 0x7B, 0x86, 0x19, 0x53, 0xA1, 0xBD, 0x95, 0x4A, 0x05, 0x2A, 0xE1, 0x85,
+			0xF4, 0x47, 0x7F, 0xB8, 0xFA, 0xED, 0xEF, 0xF7, 0x5E, 0x39, 0x1D, 0x1F, 0x39, 0x1C, 0x7D, 0x43, 0xCB, 0x2B, 0x86, 0xF4,
+			0xC9, 0xF0, 0x70, 0x7D, 0xAD, 0xFE, 0x60, 0xDF, 0xB2, 0x0A, 0xD6, 0xA1, 0xE1, 0x1D, 0xF9, 0x42, 0xFE, 0x78, 0xEF, 0x01,
+			0x2B, 0x6B, 0xFD, 0x79, 0xEB, 0xC5, 0x75, 0xA3, 0x36, 0x1E, 0x8F, 0x3F, 0x2A, 0x7F, 0x71, 0x77, 0xF8, 0x65, 0xC6, 0xD7,
+			0x3C, 0x3C, 0xBB, 0x67, 0xBE, 0x9C, 0xFF, 0x70, 0xF4, 0x7A, 0x2D, 0xFE, 0xB5, 0x1B, 0xD7, 0x2E, 0x09, 0x4E, 0xC5, 0xA5,
+			0xE2, 0x6B, 0xC1, 0x3F, 0x81, 0x8C, 0x05, 0xB1, 0x21, 0x4A, 0xE3, 0x41, 0x09, 0xC3, 0x87, 0x77, 0xEE, 0x8A, 0x7E, 0x27,
+			0xBB, 0x4B, 0xC0, 0x46, 0x5D, 0xD7, 0x3F, 0xF9, 0xF0, 0xB2, 0x44, 0xEE, 0xA2, 0xD9, 0x80, 0x0F, 0x96, 0x86, 0x61, 0x99,
+			0x2E, 0x20, 0x6E, 0x83, 0xDD, 0xBE, 0x5F, 0x75, 0x4E, 0x3A, 0x12, 0x48, 0xF7, 0x77, 0x43, 0xA8, 0xAE, 0xDA, 0x48, 0xF3,
+			0x40, 0xB5, 0x41, 0xFD, 0x63, 0x47, 0x99, 0xAD, 0x5F, 0xB5, 0x95, 0xCA, 0x82, 0xA3, 0x39, 0x5A, 0x4A, 0xFB, 0xC7, 0x9E,
+			0x32, 0x1A, 0x69, 0x3D, 0x1D, 0x77, 0x55, 0xB8, 0xCA, 0x87, 0xFF, 0xCF, 0xE5, 0xE7, 0x51, 0x4A, 0xA1, 0x05, 0xE6, 0xC2,
+			0x8C, 0x53, 0xB1, 0xE1, 0xA9, 0x4B, 0x55, 0xDD, 0xE9, 0x4B, 0x2F, 0x6D, 0xA4, 0x17, 0xC1, 0x42, 0x91, 0x3B, 0xA6, 0xB3,
+			0x74, 0xA0, 0xD8, 0x9C, 0x71, 0x1C, 0xFF, 0xDD, 0x66, 0x92, 0x46, 0x84, 0x8B, 0xA8, 0xA7, 0x2E, 0xD2, 0x71, 0x9C, 0x7F,
+			0xEC, 0x46, 0x51, 0x1C, 0x05, 0x63, 0xC5, 0x86, 0x32, 0x3C, 0xC3, 0x1F, 0xF9, 0xC2, 0x6A, 0x65, 0x7E, 0x80, 0x8A, 0xC9,
+			0x3C, 0xF5, 0xA0, 0xFE, 0x7E, 0x47, 0x01, 0x44, 0x8A, 0xB3, 0x30, 0x8D, 0x52, 0x76, 0xEA, 0x56, 0xF7, 0x6F, 0x76, 0x6F,
+			0x64, 0xBA, 0x1B, 0x35, 0x8E, 0x3E, 0x8C, 0x66, 0x33, 0x95, 0xFD, 0x8B, 0x96, 0x11, 0xF5, 0x00, 0x64, 0x44, 0xC9, 0xAB,
+			0x92, 0x7F, 0xEF, 0xCA, 0x27, 0xDB, 0xB6, 0xFD, 0x83, 0x9F, 0xFC, 0x82, 0xA0, 0x8A, 0x51, 0x62, 0x8B, 0xC5, 0x16, 0x40,
+			0x82, 0xE4, 0x5E, 0x71, 0xBC, 0xB9, 0x0F, 0x1C, 0xEB, 0x0B, 0x11, 0x5F, 0x5D, 0xAD, 0x0A, 0x29, 0x7E, 0x05, 0xC6, 0x20,
+			0xF4, 0x5C, 0xD6, 0x74, 0xB1, 0x50, 0x1D, 0xA9, 0x41, 0x10, 0x9C, 0xBB, 0xAA, 0x68, 0x5D, 0xD4, 0x57, 0xC8, 0xD2, 0x16,
+			0x8B, 0x96, 0x8C, 0x9D, 0x81, 0x65, 0x24, 0x3E, 0x58, 0x3D, 0x50, 0xEF, 0xBF, 0xFC, 0x17, 0xAA, 0xEA, 0x22, 0x08, 0x4C,
+			0x2D, 0x6E, 0x90, 0x00, 0xF4, 0xF0, 0x9F, 0x76, 0xD5, 0x8B, 0xF6, 0xFA, 0x2A, 0xD9, 0x4F, 0xA3, 0xD1, 0x08, 0x0D, 0x09,
+			0xFA, 0x75, 0xA5, 0x29, 0x49, 0xAD, 0x68, 0x92, 0x37, 0x99, 0xA6, 0x99, 0xF9, 0xD7, 0xFF, 0xBA, 0xFB, 0xEF, 0xFF, 0x3D,
+			0xCD, 0x75, 0xA0, 0x22, 0xA6, 0x69, 0xD2, 0x03, 0x39, 0xDF, 0x9B, 0x10, 0x49, 0xCB, 0x18, 0x3A, 0xC9, 0x22, 0x79, 0xCA,
+			0x67, 0x56, 0xAB, 0x55, 0x91, 0xEC, 0xA7, 0xCA, 0x01, 0x33, 0x55, 0xE0, 0xDD, 0xDC, 0xE9, 0xE7, 0xF8, 0x04, 0x10, 0x1B,
+			0x65, 0x10, 0x13, 0x53, 0x4E, 0x05, 0x09, 0x16, 0x17, 0xE3, 0x38, 0xFA, 0xFD, 0x7E, 0xB9, 0x5C, 0x16, 0x71, 0x53, 0xFC,
+			0x10, 0x80, 0x0F, 0xC6, 0x88, 0xED, 0x42, 0x87, 0x27, 0x15, 0x6F, 0x52, 0x6C, 0xB6, 0x32, 0xE4, 0x22, 0xE6, 0xB9, 0xCB,
+			0x4C, 0x18, 0x71, 0x6C, 0xE0, 0xCE, 0xEB, 0xEB, 0xEB, 0xA4, 0xAB, 0x74, 0xAB, 0xA1, 0xB6, 0x21, 0x42, 0x89, 0x96, 0x65,
+			0xC1, 0x27, 0x61, 0xA6, 0x18, 0x16, 0x2A, 0x09, 0xBA, 0x8B, 0xAE, 0x9B, 0xD6, 0x03, 0x2D, 0x5C, 0xC6, 0x8B, 0x85, 0xA7,
+			0x99, 0x8E, 0x7A, 0x5C, 0x73, 0x3F, 0x0C, 0xE3, 0xE5, 0x32, 0xD4, 0x0D, 0x55, 0x11, 0x09, 0x23, 0x15, 0x8F, 0xD8, 0xB6,
+			0xCA, 0xE2, 0x93, 0xB3, 0xE7, 0xFA, 0xBE, 0xA7, 0x25, 0xBF, 0x4A, 0x68, 0xCB, 0x8B, 0xC8, 0xB0, 0x83, 0x20, 0x9A, 0x7B,
+			0x41, 0xA0, 0xAC, 0xB6, 0x8A, 0xA0, 0xFD, 0xC8, 0xD0, 0x42, 0xE5, 0xC5, 0x15, 0x64, 0x98, 0xD8, 0xEB, 0xE9, 0x62, 0x61,
+			0xE5, 0x6D, 0x2F, 0x50, 0xF6, 0x17, 0xD5, 0x23, 0xF5, 0x9B, 0x50, 0x6D, 0xE5, 0xD1, 0xCC, 0x5B, 0x5A, 0xB1, 0x6E, 0xED,
+			0xCE, 0x10, 0x57, 0x47, 0x33, 0xD6, 0xA3, 0x20, 0x8A, 0x94, 0x67, 0x57, 0x70, 0x6D, 0x10, 0x84, 0x7E, 0xB8, 0xAB, 0xD5,
+			0x13, 0x04, 0x0B, 0x4D, 0xDF, 0x0D, 0xFA, 0x80, 0x44, 0x7B, 0xBD, 0x5E, 0xAD, 0x56, 0x93, 0x1E, 0x13, 0x52, 0xAE, 0x5B,
+			0xB7, 0x6E, 0x91, 0x37, 0x30, 0x37, 0x82, 0x18, 0x79, 0x3A, 0x9D, 0x96, 0xCB, 0x65, 0xA8, 0x8A, 0xF0, 0x82, 0x98, 0x1D,
+			0xA6, 0xBC, 0x4B, 0x82, 0x10, 0x12, 0x6F, 0xAE, 0xAD, 0xAD, 0xF1, 0x24, 0xF9, 0x5B, 0x98, 0x76, 0xF0, 0x76, 0xE8, 0x14,
+			0x95, 0xD2, 0xA5, 0x68, 0xF9, 0x0A, 0xB9, 0x02, 0xB2, 0xB2, 0xA8, 0x53, 0x00, 0x89, 0xC0, 0x83, 0xEE, 0x74, 0x3A, 0x0C,
+			0xA2, 0x11, 0xD1, 0x2A, 0x08, 0x7C, 0x77, 0x6C, 0xFF, 0xD3, 0x5C, 0x49, 0xA5, 0xCC, 0x97, 0xB3, 0x9F, 0x3C, 0x96, 0x7D,
+			0x38, 0x8A, 0xA2, 0x1F, 0x0C, 0x7E, 0x92, 0x32, 0x53, 0x4F, 0xFB, 0x27, 0xD2, 0xE9, 0xF4, 0x9F, 0xED, 0xFC, 0x88, 0x0D,
+			0x53, 0x2C, 0x16, 0x83, 0x20, 0x78, 0x4C, 0x7B, 0x78, 0x36, 0x9B, 0xBD, 0x9F, 0xF9, 0xF8, 0x84, 0xAD, 0x46, 0xB4, 0x53,
+			0xD8, 0x18, 0x0C, 0x06, 0xEF, 0x67, 0x3E, 0x3E, 0xD4, 0xBF, 0x23, 0xC8, 0x05, 0x8F, 0x2E, 0x0F, 0x05, 0xDD, 0xA0, 0x58,
+			0x51, 0x5D, 0xF8, 0xB5, 0x5A, 0xAD, 0x76, 0xB1, 0xA4, 0xDD, 0xAF, 0x9E, 0x9B, 0x0A, 0x8F, 0x0A, 0x85, 0x17, 0x27, 0x6F,
+			0xFE, 0x61, 0xF6, 0xDB, 0xBE, 0xEF, 0x7F, 0x33, 0xF3, 0x18, 0x01, 0x07, 0x78, 0x23, 0x68, 0x2A, 0x11, 0xA2, 0x32, 0x58,
+			0x49, 0xF7, 0x66, 0xA1, 0xA0, 0x26, 0x56, 0xFE, 0xCC, 0xFC, 0xBB, 0x54, 0x2A, 0xF5, 0x84, 0x71, 0xB4, 0x6F, 0xF7, 0x93,
+			0x6D, 0xB0, 0xAB, 0x60, 0x7C, 0x6C, 0x71, 0xFF, 0xCF, 0xB4, 0xD6, 0x3D, 0x87, 0x1E, 0xDE, 0xDF, 0x61, 0x2B, 0x2D, 0xE8,
+			0x22, 0xD1, 0x2A, 0x6B, 0x8A, 0xB8, 0xB4, 0x8C, 0xE4, 0x24, 0x3C, 0x17, 0xD6, 0x1F, 0x09, 0xA8, 0x8C, 0x16, 0x21, 0x44,
+			0xBD, 0x7E, 0xF5, 0xE2, 0xFD, 0x0F, 0x1D, 0x13, 0x10, 0x58, 0xD8, 0x44, 0x42, 0xD6, 0x90, 0xDE, 0x02, 0x14, 0xA7, 0xF7,
+			0x0B, 0x07, 0xC9, 0x0C, 0x32, 0x79, 0xBF, 0x08, 0xB7, 0xE1, 0x2A, 0xBA, 0xDD, 0xEE, 0xF5, 0x17, 0x2F, 0xAE, 0x07, 0x5F,
+			0x4A, 0x59, 0xA9, 0x4A, 0xA5, 0x82, 0x72, 0xA2, 0x2A, 0x7E, 0x56, 0x95, 0xD2, 0x97, 0xBA, 0x12, 0xDB, 0x14, 0x15, 0xFA,
+			0x20, 0xA5, 0xEC, 0xB5, 0xD2, 0xCB, 0x74, 0xD4, 0x40, 0xE9, 0x7A, 0xBD, 0xAE, 0xBA, 0xCA, 0x8B, 0x2A, 0x0E, 0x83, 0x6C,
+			0x9A, 0x2D, 0x65, 0xA1, 0x90, 0xA9, 0xEC, 0xDF, 0x5A, 0xAE, 0x94, 0x55, 0x34, 0xFD, 0x37, 0xF3, 0x4F, 0xBE, 0xEC, 0xAA,
+			0xF9, 0xFE, 0x5E, 0xE0, 0xD5, 0x1A, 0x0A, 0xC6, 0xC8, 0xDA, 0x8A, 0x5F, 0x5F, 0xAB, 0xD7, 0x44, 0x83, 0xD2, 0xD3, 0xBC,
+			0x4A, 0xA5, 0x42, 0x06, 0x0F, 0xE8, 0x9F, 0x90, 0xDC, 0x96, 0x2A, 0x8F, 0x4F, 0xE9, 0x88, 0x4B, 0x1B, 0xA6, 0xB2, 0xA7,
+			0xB9, 0xBC, 0x22, 0xA1, 0x50, 0xAA, 0x54, 0xA2, 0xF7, 0x8B, 0x9E, 0xAE, 0x7F, 0xF1, 0x7B, 0xCF, 0x7D, 0x59, 0x3A, 0xDE,
+			0x09, 0x76, 0x45, 0x58, 0x37, 0x39, 0xDD, 0x0A, 0xD9, 0x03, 0x8B, 0x06, 0x0A, 0x9B, 0xCF, 0xE7, 0xCC, 0x47, 0x62, 0x2D,
+			0x94, 0x91, 0x4C, 0xAA, 0xC1, 0xB9, 0x5C, 0x8E, 0xDD, 0x2B, 0xB3, 0x2E, 0x26, 0x93, 0xC9, 0x4B, 0x6F, 0xFF, 0xEA, 0x77,
+			0x9F, 0xFD, 0xED, 0xFD, 0x9D, 0x19, 0x8E, 0xE3, 0x30, 0xCD, 0xB1, 0x58, 0x2C, 0xA2, 0xB0, 0x29, 0x43, 0x3A, 0x21, 0x77,
+			0x72, 0xE8, 0xC8, 0xFF, 0x08, 0xB5, 0x21, 0xFE, 0x12, 0x9C, 0x61, 0x48, 0x21, 0x6D, 0x52, 0xC9, 0xD8, 0xC5, 0xBC, 0x30,
+			0xDC, 0xD4, 0x66, 0x99, 0xC2, 0x0A, 0x74, 0xC5, 0xD9, 0x03, 0x2E, 0x20, 0x3A, 0x03, 0x8A, 0x61, 0x51, 0x29, 0x63, 0xB2,
+			0x93, 0x88, 0xF2, 0x18, 0xB3, 0x0E, 0x25, 0x46, 0x64, 0x8D, 0x4D, 0xD3, 0xC4, 0x98, 0x52, 0xF1, 0x90, 0x3A, 0x4F, 0x26,
+			0x93, 0x91, 0xE9, 0x8F, 0xC2, 0xA0, 0x50, 0x29, 0xD3, 0x62, 0x51, 0xA9, 0x54, 0xC0, 0xFA, 0xC1, 0x4C, 0x40, 0xE8, 0xF0,
+			0x46, 0xF0, 0x88, 0xA4, 0x45, 0x62, 0x7F, 0x19, 0x7D, 0x6B, 0x6B, 0x2B, 0x97, 0xCB, 0x1D, 0x38, 0x70, 0x80, 0x18, 0x9F,
+			0x46, 0x6D, 0x36, 0x2B, 0x29, 0x0E, 0x4C, 0x21, 0xEA, 0x48, 0x38, 0x1B, 0xEA, 0x19, 0x8D, 0xE4, 0x45, 0x9F, 0xC8, 0x70,
+			0x38, 0x04, 0x43, 0x6C, 0xB7, 0xDB, 0xAD, 0x56, 0x8B, 0x5A, 0x68, 0xB9, 0x5C, 0xA6, 0xC8, 0xBE, 0xB9, 0xB9, 0x49, 0x87,
+			0x01, 0x29, 0x82, 0xA2, 0xFA, 0x25, 0x0D, 0xA5, 0xB6, 0x6D, 0xA3, 0xDD, 0x51, 0xAB, 0xD5, 0x20, 0xF0, 0x30, 0x58, 0x58,
+			0x0E, 0x09, 0xA6, 0x9F, 0x51, 0x94, 0xE4, 0x28, 0x1C, 0x03, 0x99, 0xE3, 0x2A, 0xBA, 0x3D, 0x22, 0xEA, 0x22, 0xAB, 0xCE,
+			0xC6, 0x12, 0x59, 0x5D, 0xE9, 0x0C, 0x60, 0x13, 0xD3, 0xD9, 0x2B, 0xFD, 0x01, 0xAA, 0x30, 0xBA, 0x27, 0xB1, 0x22, 0xF3,
+			0x00, 0x88, 0xAF, 0x77, 0x27, 0xBD, 0xEE, 0x55, 0xE4, 0xF8, 0x0A, 0xBE, 0x97, 0xE7, 0x2C, 0x36, 0x5D, 0xF2, 0x27, 0x1C,
+			0x00, 0xB3, 0x2D, 0x05, 0x55, 0x00, 0x10, 0xC0, 0xB8, 0x13, 0x8F, 0x70, 0xDA, 0x51, 0x45, 0x57, 0xC9, 0x41, 0x52, 0xD7,
+			0x92, 0xB2, 0x3C, 0xB4, 0x0D, 0xA6, 0xE5, 0xC0, 0x02, 0x90, 0x8E, 0xD3, 0x95, 0x95, 0x15, 0x58, 0x1F, 0x8E, 0xE3, 0xEC,
+			0xEC, 0xEC, 0xAC, 0xAE, 0xAE, 0xD2, 0x26, 0x23, 0x8D, 0xAC, 0x8C, 0x05, 0x85, 0xEE, 0x86, 0x88, 0x7F, 0xA9, 0x54, 0x62,
+			0xE4, 0xA9, 0xEC, 0x37, 0x9A, 0x62, 0xE8, 0xAD, 0x13, 0x04, 0x56, 0xC6, 0x1E, 0xD1, 0xB3, 0x82, 0x24, 0x16, 0x7A, 0x8A,
+			0x74, 0x1D, 0x73, 0x44, 0x4D, 0xD3, 0x3C, 0x70, 0xE0, 0x00, 0xAD, 0x40, 0xF7, 0xF5, 0xBE, 0xFA, 0x7A, 0xF0, 0xDE, 0xB9,
+			0xD4, 0xD5, 0x53, 0xD1, 0x43, 0xCF, 0xB9, 0xA7, 0x91, 0x2D, 0x7A, 0xC2, 0x38, 0xFA, 0x42, 0xE1, 0xCC, 0x25, 0xF7, 0x06,
+			0x62, 0x12, 0xCC, 0x6F, 0x39, 0x11, 0x3C, 0x18, 0x45, 0xD1, 0x9B, 0xD1, 0x45, 0x4A, 0x4A, 0x57, 0xF3, 0x9F, 0x3E, 0xEB,
+			0x9C, 0xC2, 0x82, 0x70, 0xBF, 0xE3, 0xF1, 0xF8, 0x7A, 0xE5, 0x67, 0x80, 0x90, 0xC4, 0x13, 0xA8, 0x72, 0x3E, 0x93, 0x3E,
+			0x29, 0x4F, 0x15, 0x56, 0x1F, 0xC3, 0x33, 0x32, 0x99, 0xCC, 0xDB, 0xDA, 0xFB, 0x22, 0x8C, 0x6C, 0x18, 0x06, 0xF0, 0x26,
+			0xC6, 0x17, 0x0B, 0x92, 0xCF, 0xE7, 0x39, 0xA1, 0xEC, 0xCF, 0x37, 0xA3, 0x8B, 0x52, 0xAD, 0x95, 0x29, 0x86, 0x42, 0x07,
+			0xC0, 0xE8, 0xCB, 0xC0, 0x29, 0x2E, 0x5E, 0xD3, 0xB4, 0xFF, 0x4D, 0xCA, 0x5B, 0x3A, 0x4E, 0x78, 0xA7, 0x4C, 0x88, 0xEC,
+			0xF5, 0x7A, 0x52, 0x96, 0x93, 0x08, 0x46, 0x14, 0xDF, 0x84, 0xBF, 0x4B, 0x21, 0x0D, 0x95, 0x5F, 0xAC, 0xBF, 0x8C, 0x1B,
+			0x53, 0xBB, 0x50, 0x05, 0x2B, 0xEA, 0xFF, 0x44, 0x3E, 0x5A, 0x5A, 0x0A, 0x90, 0xFC, 0x92, 0x26, 0x58, 0xE8, 0x7C, 0x98,
+			0x57, 0x1A, 0x80, 0x99, 0xEE, 0x40, 0xCC, 0x0E, 0xAB, 0x5B, 0xCA, 0x81, 0xE5, 0x72, 0x19, 0xFA, 0x00, 0x0D, 0xCC, 0x24,
+			0x07, 0x5E, 0xF2, 0xA2, 0x5D, 0x9F, 0x50, 0x4F, 0x34, 0x66, 0x60, 0x8A, 0x8B, 0x2A, 0x3B, 0xCE, 0x86, 0x92, 0x83, 0x4C,
+			0x99, 0x25, 0xA7, 0x97, 0xA7, 0x41, 0xCD, 0x46, 0xCA, 0x27, 0x40, 0x43, 0xE0, 0x6F, 0x1C, 0x37, 0x84, 0xD8, 0x64, 0x0A,
+			0x37, 0x11, 0x37, 0x50, 0xED, 0x70, 0x38, 0x64, 0xE0, 0x30, 0x1A, 0xA5, 0x42, 0x74, 0x86, 0x32, 0x24, 0xF3, 0x04, 0xA5,
+			0x9F, 0x51, 0xA6, 0x9E, 0x10, 0x0A, 0xF4, 0xFB, 0x7D, 0x91, 0xAB, 0x93, 0x6D, 0x8C, 0xBE, 0x16, 0x48, 0x14, 0xE4, 0x46,
+			0x7C, 0x86, 0x18, 0x52, 0x51, 0xC7, 0xC1, 0x26, 0xD0, 0x21, 0x4C, 0x15, 0x04, 0x91, 0x51, 0xD1, 0x31, 0x45, 0x59, 0x64,
+			0x3E, 0x9F, 0x2B, 0x94, 0x52, 0xAC, 0x03, 0x36, 0x11, 0xC0, 0x88, 0xE7, 0xC5, 0x6E, 0x46, 0x98, 0xB4, 0xDB, 0xED, 0x12,
+			0x88, 0x75, 0x3A, 0x1D, 0x51, 0x0F, 0x95, 0xC2, 0x0E, 0x12, 0x69, 0xC2, 0x00, 0x81, 0x46, 0x49, 0xF9, 0x1B, 0xB2, 0x1A,
+			0x75, 0x1B, 0xBA, 0xA8, 0xA0, 0x4F, 0x81, 0x7F, 0x81, 0xA9, 0x01, 0x9C, 0x01, 0x93, 0x51, 0xC2, 0x9D, 0xCF, 0xE7, 0x94,
+			0xF5, 0x60, 0xA9, 0x82, 0xED, 0x30, 0xE3, 0x45, 0x04, 0x0E, 0x0B, 0x85, 0x02, 0x82, 0x1B, 0xB7, 0x6E, 0xDD, 0xEA, 0xF7,
+			0xFB, 0x28, 0xD3, 0xD1, 0x83, 0xCA, 0xC9, 0x87, 0x26, 0x04, 0xE8, 0x09, 0x9D, 0x5C, 0xB4, 0xB0, 0x69, 0x60, 0x86, 0x57,
+			0x8A, 0x6D, 0x9D, 0xCF, 0xE7, 0x94, 0x19, 0xD8, 0x07, 0x88, 0x46, 0x30, 0x5C, 0x82, 0xF1, 0x26, 0xDD, 0x6E, 0xB7, 0x58,
+			0x2C, 0x16, 0x0A, 0x05, 0x24, 0xA2, 0x10, 0x12, 0x10, 0x42, 0x21, 0x8C, 0x20, 0x06, 0x53, 0x00, 0x67, 0x83, 0x63, 0x36,
+			0x1A, 0x0D, 0xA6, 0x88, 0xC8, 0xFA, 0x15, 0x92, 0x17, 0x30, 0x1C, 0x51, 0xC0, 0xED, 0x41, 0x40, 0x15, 0xB7, 0xE0, 0xA8,
+			0x12, 0xEE, 0x2F, 0xBB, 0x0B, 0xC7, 0x50, 0x3F, 0x29, 0x38, 0xAA, 0x3D, 0x38, 0x67, 0x6B, 0x54, 0x74, 0xB7, 0xC7, 0x71,
+			0xC7, 0x53, 0x98, 0x4C, 0xC1, 0x51, 0x6A, 0xCF, 0xF4, 0x00, 0x33, 0xAD, 0xF7, 0x56, 0x7F, 0x39, 0x08, 0x9C, 0x9C, 0xAD,
+			0x31, 0x40, 0xB8, 0xBB, 0xB0, 0xB2, 0x56, 0xEC, 0xA6, 0xC2, 0xA2, 0xAB, 0x8C, 0x72, 0x77, 0x61, 0xA5, 0xF5, 0x65, 0xD6,
+			0x8A, 0xAD, 0x78, 0x71, 0xAB, 0xBF, 0x2C, 0x65, 0xCC, 0x72, 0xD6, 0xB2, 0xE2, 0xC5, 0xD6, 0x28, 0xDA, 0x99, 0xAA, 0x72,
+			0x2E, 0x1F, 0xB2, 0x33, 0xD5, 0x6B, 0x45, 0x25, 0x31, 0x48, 0xB1, 0x77, 0x7B, 0x1C, 0xF3, 0xAB, 0x8C, 0x19, 0x75, 0x17,
+			0xAA, 0xE9, 0x97, 0x7E, 0x63, 0x46, 0x01, 0xA7, 0xF5, 0x65, 0x3E, 0xAD, 0xAB, 0x8B, 0x4C, 0x34, 0x97, 0x40, 0x42, 0x28,
+			0x93, 0xD0, 0xC9, 0x95, 0x64, 0x99, 0x2A, 0xD6, 0x00, 0xAE, 0x65, 0xCF, 0xC1, 0x9F, 0x69, 0x36, 0x9B, 0x18, 0x14, 0xCE,
+			0x1E, 0x9D, 0x9C, 0x58, 0x1C, 0xC3, 0x30, 0x76, 0x76, 0x76, 0x38, 0x7E, 0x4C, 0x08, 0x81, 0x25, 0x0D, 0xCD, 0x19, 0x2F,
+			0x02, 0x82, 0x04, 0xEC, 0xD3, 0xED, 0x76, 0xF9, 0x76, 0xA6, 0xAD, 0x61, 0x07, 0x7B, 0xBD, 0x5E, 0xB3, 0xD9, 0xC4, 0x9F,
+			0xF1, 0x15, 0x08, 0x2C, 0xD7, 0xEB, 0x75, 0x94, 0x76, 0xE0, 0xD7, 0xB3, 0x55, 0x64, 0x0C, 0x08, 0x26, 0xB2, 0x54, 0x2A,
+			0x3D, 0xA6, 0x3D, 0xFC, 0xB5, 0xCD, 0xDF, 0xC0, 0xFD, 0xA0, 0x0F, 0x48, 0xDC, 0xFA, 0x64, 0xEA, 0x58, 0xA5, 0x52, 0x41,
+			0x75, 0x0A, 0xC5, 0xE0, 0x4C, 0x26, 0x73, 0xDC, 0x7F, 0xC0, 0xB2, 0xAC, 0xD7, 0x83, 0xF7, 0x8E, 0x4C, 0xEF, 0x3E, 0x6F,
+			0x7D, 0x20, 0xF2, 0x5B, 0x84, 0x8D, 0xF7, 0x74, 0xBE, 0x42, 0x75, 0x0D, 0xC5, 0x88, 0xF7, 0xEC, 0x0F, 0x45, 0x99, 0x8B,
+			0x5E, 0x36, 0xA6, 0xDD, 0xC9, 0x1C, 0x47, 0xF8, 0xCD, 0x94, 0x31, 0xCE, 0xEA, 0x97, 0xDF, 0x8C, 0x94, 0x0C, 0x2A, 0xA4,
+			0xB8, 0xD7, 0x96, 0xE7, 0x7F, 0xE2, 0xBF, 0x0B, 0x0E, 0xCE, 0x49, 0x5C, 0x2C, 0x16, 0x4F, 0x59, 0x8F, 0x0A, 0x9F, 0x8D,
+			0x5A, 0x91, 0x08, 0x8A, 0x08, 0x9E, 0x43, 0x68, 0x8F, 0xD4, 0x0C, 0x9F, 0x2F, 0xCC, 0x1F, 0x7A, 0x56, 0xC0, 0x10, 0xA4,
+			0x08, 0x4C, 0x4C, 0x80, 0x5E, 0x21, 0xA7, 0x46, 0x04, 0xEB, 0x65, 0xAE, 0x0B, 0xD5, 0x17, 0x01, 0x79, 0x08, 0xC8, 0xB8,
+			0x06, 0x29, 0x2A, 0x80, 0x23, 0xC9, 0xC8, 0x5C, 0xFE, 0x41, 0xE8, 0x00, 0x97, 0x89, 0x46, 0x59, 0x32, 0x5A, 0xE8, 0x00,
+			0x8C, 0x3E, 0x06, 0x16, 0xCF, 0x66, 0xB3, 0xA4, 0x89, 0xB3, 0xD9, 0x0C, 0xC6, 0x30, 0xFC, 0x57, 0x58, 0x76, 0xAC, 0x08,
+			0xB2, 0xF8, 0x98, 0x75, 0xE0, 0x44, 0x2E, 0x06, 0x52, 0x78, 0x92, 0x28, 0x5B, 0x5C, 0x2D, 0xD6, 0x96, 0x0E, 0x3E, 0xBC,
+			0x91, 0x48, 0x90, 0x41, 0xC5, 0xC1, 0x0D, 0x63, 0x0A, 0x40, 0x02, 0x08, 0xA8, 0xA9, 0xC4, 0x0E, 0x87, 0x43, 0xD1, 0x23,
+			0x81, 0x48, 0x02, 0xA5, 0x90, 0xBC, 0x9F, 0xD0, 0x0D, 0x10, 0x1F, 0xBF, 0x0B, 0x1A, 0x29, 0xED, 0x93, 0x2A, 0x1D, 0xDF,
+			0x53, 0xC7, 0x93, 0xA1, 0x14, 0xC0, 0x9E, 0x24, 0xE2, 0xB4, 0xAA, 0xE0, 0x83, 0xF1, 0xD9, 0x52, 0x6E, 0x01, 0xAB, 0xA1,
+			0x21, 0x80, 0xA8, 0x9F, 0x4E, 0x55, 0xA6, 0x49, 0x73, 0xA9, 0xE8, 0xD9, 0x49, 0x1B, 0x36, 0x5D, 0x0E, 0xF8, 0x7B, 0xCC,
+			0x3D, 0x1B, 0x9B, 0x12, 0x88, 0x34, 0xF7, 0xC1, 0xB2, 0x51, 0x71, 0x1C, 0x4D, 0xBC, 0x98, 0x24, 0x42, 0x66, 0x2A, 0x2D,
+			0xFB, 0xFB, 0x6F, 0x21, 0x56, 0x43, 0xFA, 0x2C, 0x95, 0x4A, 0x20, 0x27, 0x14, 0xD0, 0xD9, 0x49, 0x82, 0xED, 0x22, 0xDE,
+			0x02, 0x5C, 0xC5, 0x4D, 0xC2, 0xDA, 0xA4, 0x39, 0x8D, 0x38, 0x05, 0x9A, 0x04, 0x5C, 0x25, 0x96, 0x44, 0xC6, 0x11, 0x73,
+			0xB4, 0xD0, 0x0F, 0xA0, 0x79, 0x81, 0x48, 0x8D, 0x28, 0x55, 0xE4, 0x38, 0x50, 0xFF, 0xC0, 0xFB, 0x1D, 0x3C, 0x78, 0x10,
+			0x54, 0x41, 0x88, 0x53, 0x40, 0x10, 0x78, 0x6F, 0x21, 0x9F, 0x4C, 0xA7, 0xD3, 0xAD, 0xAD, 0xAD, 0x56, 0xAB, 0x45, 0xEB,
+			0x2C, 0x01, 0xB2, 0xB4, 0xB6, 0x12, 0x56, 0x58, 0x96, 0x85, 0x02, 0x25, 0x24, 0x1F, 0x2A, 0x45, 0x0C, 0xD0, 0x61, 0xDC,
+			0x39, 0x79, 0x19, 0xC6, 0x48, 0x44, 0x3F, 0xA0, 0x70, 0x41, 0x57, 0x25, 0x22, 0xA0, 0x9F, 0x93, 0xC5, 0xA3, 0xAC, 0x22,
+			0xB1, 0xCC, 0x70, 0x38, 0xA4, 0x54, 0x48, 0x33, 0x9D, 0xD4, 0xD3, 0x84, 0x4C, 0x2D, 0x4A, 0xEE, 0x32, 0x17, 0x85, 0x14,
+			0x9E, 0x46, 0x0A, 0x99, 0x4F, 0x84, 0x85, 0x92, 0x32, 0x97, 0xC8, 0x2E, 0x4A, 0xC3, 0xBD, 0x68, 0xC3, 0x11, 0xA3, 0xC9,
+			0x3C, 0x16, 0xC2, 0x5E, 0x51, 0x6A, 0x04, 0x44, 0x92, 0x79, 0x29, 0x40, 0x3A, 0x38, 0x6F, 0xA2, 0x51, 0x11, 0xEA, 0x90,
+			0xA1, 0x51, 0x6C, 0x32, 0x42, 0x24, 0x3C, 0x25, 0x56, 0x92, 0xA6, 0x5F, 0x04, 0x08, 0x09, 0xF3, 0x39, 0xB1, 0x14, 0x30,
+			0x69, 0x88, 0x03, 0xC7, 0xC7, 0x01, 0x10, 0xB0, 0x33, 0x95, 0x41, 0x98, 0xAC, 0xD0, 0xC9, 0xE8, 0xF6, 0x9C, 0xCD, 0x66,
+			0x0C, 0xEF, 0x24, 0xAE, 0x89, 0xE3, 0x98, 0x9E, 0x5B, 0xA4, 0xAB, 0xA4, 0x55, 0x8D, 0xC4, 0x94, 0x4E, 0x8E, 0xD5, 0xD5,
+			0x55, 0x1E, 0x35, 0x69, 0xB2, 0xE0, 0xAA, 0x14, 0x7B, 0xC8, 0x8E, 0x61, 0x7F, 0x53, 0x6A, 0x4E, 0xA7, 0xD3, 0x1F, 0x95,
+			0xBF, 0x68, 0xB7, 0xDB, 0xAF, 0x07, 0xEF, 0xC5, 0x71, 0xFC, 0xE3, 0xE9, 0x5B, 0x60, 0x86, 0x5C, 0xC0, 0x79, 0xEB, 0x03,
+			0x24, 0x2A, 0xBF, 0xDF, 0x7B, 0x85, 0xF6, 0x19, 0x00, 0x9F, 0x7C, 0x3E, 0xFF, 0xDA, 0xF2, 0x3C, 0x65, 0xA4, 0x97, 0xE7,
+			0x67, 0xA9, 0xCD, 0x7E, 0xF5, 0xF6, 0xAF, 0xA3, 0x0F, 0x91, 0xC9, 0x64, 0xC0, 0xC4, 0x10, 0x2E, 0xA6, 0x0A, 0xF2, 0xAD,
+			0xDC, 0x13, 0xCF, 0xA4, 0x55, 0xD3, 0x19, 0x89, 0x8E, 0xEB, 0xBA, 0x3F, 0x9E, 0xBE, 0xC5, 0x9B, 0xDF, 0xD6, 0xDE, 0xBF,
+			0x56, 0xF8, 0x2C, 0x95, 0x4A, 0x1D, 0xF7, 0x1F, 0x40, 0xD0, 0x91, 0xF9, 0x91, 0x4F, 0x18, 0x47, 0x3D, 0xCF, 0x7B, 0x75,
+			0x71, 0x2E, 0x0C, 0xC3, 0x87, 0x26, 0x77, 0x3D, 0xBA, 0x3C, 0xD4, 0xEF, 0xF7, 0x7F, 0x34, 0x7E, 0x43, 0xC4, 0xEF, 0x50,
+			0x3F, 0x94, 0xF6, 0xDD, 0xEF, 0x3C, 0xF5, 0x14, 0x61, 0x99, 0x80, 0xD1, 0x6C, 0x3F, 0x46, 0xE4, 0x4B, 0x09, 0x4D, 0xA6,
+			0x76, 0xB3, 0xBE, 0x12, 0xC6, 0x09, 0x6B, 0xE3, 0xD9, 0x53, 0xA7, 0x78, 0xB3, 0xA8, 0x35, 0xEC, 0xB6, 0xB6, 0x9D, 0x39,
+			0x23, 0x9E, 0x43, 0x44, 0x09, 0x41, 0x7E, 0xA4, 0x8B, 0x45, 0x32, 0x0C, 0xA1, 0xD5, 0x49, 0x0D, 0x49, 0x78, 0xC6, 0xCF,
+			0x9D, 0x3E, 0x2D, 0xB8, 0x2B, 0x07, 0x1F, 0x3A, 0x38, 0x46, 0x06, 0x64, 0x1C, 0xBE, 0x3C, 0x3C, 0x7A, 0x76, 0x20, 0x91,
+			0x01, 0x12, 0xB3, 0xC8, 0xC1, 0x52, 0xF0, 0x14, 0x61, 0x76, 0xCF, 0xF3, 0xA0, 0x72, 0xE0, 0xE6, 0xE9, 0x18, 0x95, 0x3C,
+			0x55, 0xD8, 0xCC, 0x32, 0xB9, 0x44, 0x66, 0x1C, 0xC1, 0x4E, 0x04, 0x6F, 0x10, 0x58, 0x0C, 0xBE, 0xB8, 0xFC, 0x8A, 0xF3,
+			0x45, 0xDB, 0x2C, 0x27, 0x8B, 0x4E, 0x5D, 0x91, 0x76, 0x07, 0x35, 0x01, 0xF7, 0x66, 0x12, 0x38, 0x46, 0x06, 0xDE, 0x33,
+			0x53, 0x86, 0x10, 0x3F, 0xC6, 0x9E, 0x44, 0x51, 0x04, 0xEE, 0x44, 0x50, 0xC8, 0xC3, 0x61, 0x40, 0x13, 0x1F, 0xC5, 0x87,
+			0x8B, 0x5A, 0xB5, 0x0C, 0xEF, 0xA4, 0x98, 0xD4, 0xEB, 0xF5, 0x28, 0xA5, 0xE0, 0x11, 0x65, 0xF2, 0xA0, 0x34, 0xD9, 0x00,
+			0xF2, 0x24, 0x26, 0x41, 0xE9, 0x9E, 0x09, 0xA7, 0xDF, 0xB6, 0x6D, 0x04, 0x8B, 0x90, 0x69, 0x50, 0xF8, 0x0F, 0x3A, 0xA0,
+			0xFD, 0x7E, 0x9F, 0x8A, 0x33, 0xF9, 0x08, 0x3D, 0xB1, 0xD2, 0x48, 0x45, 0xC7, 0x36, 0x76, 0x81, 0xD4, 0x86, 0xC0, 0xAD,
+			0xDD, 0x6E, 0xA3, 0x80, 0x48, 0xF0, 0xCB, 0x5D, 0xF1, 0x65, 0x2C, 0x1E, 0x55, 0x4D, 0x58, 0xF3, 0x44, 0x0A, 0xF0, 0x3A,
+			0x64, 0x01, 0xD0, 0xF2, 0xA6, 0xD2, 0xCB, 0xD5, 0xCB, 0x47, 0x91, 0xD0, 0x49, 0xCB, 0x06, 0x21, 0x89, 0x28, 0x47, 0x02,
+			0x64, 0xC3, 0x38, 0xA6, 0x37, 0x07, 0x51, 0x59, 0x2C, 0x2C, 0xD7, 0x2C, 0x42, 0xE7, 0x3B, 0x3B, 0x3B, 0x84, 0x9C, 0xB2,
+			0x3B, 0x69, 0x34, 0x17, 0x26, 0x0F, 0x18, 0x99, 0x40, 0xC3, 0x78, 0x35, 0x9E, 0x14, 0xEC, 0x46, 0xB0, 0x32, 0x1A, 0xC4,
+			0x91, 0xF3, 0x24, 0x16, 0x20, 0x70, 0x80, 0xD0, 0x02, 0x2D, 0x17, 0xAA, 0x12, 0x43, 0xE7, 0x29, 0x39, 0x90, 0x9D, 0xD1,
+			0x45, 0x41, 0x0B, 0x71, 0xA7, 0xD3, 0x69, 0xB7, 0xDB, 0x0C, 0xB9, 0x4D, 0x2E, 0x70, 0xB7, 0x07, 0x52, 0x82, 0x38, 0x51,
+			0x07, 0x94, 0xF9, 0x4A, 0x72, 0xE5, 0xA4, 0xD8, 0x42, 0x5C, 0xC3, 0x15, 0x89, 0x9C, 0x3D, 0x7B, 0x85, 0x68, 0x42, 0x26,
+			0x72, 0xA0, 0x7C, 0x22, 0x82, 0x50, 0x54, 0x2F, 0x84, 0x8B, 0x2D, 0x22, 0x45, 0x52, 0x7B, 0x17, 0xC5, 0xC4, 0xFD, 0xFD,
+			0x87, 0xD2, 0xD9, 0x2B, 0x8C, 0x23, 0xA9, 0xCC, 0x93, 0xF3, 0xB1, 0x73, 0x9A, 0xCD, 0x26, 0xA9, 0xFD, 0xCE, 0xCE, 0x8E,
+			0x48, 0x73, 0x53, 0x13, 0x96, 0x86, 0x03, 0xCC, 0x1C, 0xDC, 0x30, 0xA6, 0x7A, 0x75, 0xBB, 0x5D, 0xFE, 0x53, 0x42, 0x48,
+			0x70, 0x58, 0x60, 0x13, 0x0E, 0x21, 0x51, 0xA4, 0x98, 0x75, 0x96, 0xA9, 0xD1, 0x68, 0xA0, 0x4D, 0xB6, 0xBA, 0xBA, 0x0A,
+			0x7D, 0x82, 0xC6, 0x63, 0xE9, 0x2C, 0xA5, 0x1A, 0x8F, 0x59, 0x27, 0x85, 0xEF, 0xF7, 0xFB, 0x40, 0xBD, 0xC4, 0xE3, 0x87,
+			0xC7, 0xDF, 0xA8, 0x56, 0xAB, 0x0F, 0x4D, 0xEE, 0x5A, 0x5B, 0x5B, 0x3B, 0x19, 0x1E, 0x26, 0x46, 0x63, 0x72, 0xC8, 0xA1,
+			0xE1, 0x1D, 0x38, 0x18, 0x8E, 0x10, 0x1A, 0x88, 0xE8, 0x42, 0xDF, 0xDF, 0xFF, 0x5A, 0xAD, 0x56, 0x0B, 0xC3, 0xF0, 0x31,
+			0xED, 0x61, 0x7A, 0xEE, 0x6F, 0xAC, 0xFC, 0x1C, 0x44, 0x88, 0x06, 0x43, 0x64, 0x0C, 0x14, 0x8E, 0xAC, 0xEB, 0x37, 0x56,
+			0x7E, 0xFE, 0xF2, 0x5C, 0x91, 0xDF, 0x31, 0x64, 0x6F, 0xC5, 0x97, 0x8A, 0xC5, 0xE2, 0xB7, 0xF3, 0x4F, 0x3E, 0xE7, 0x9E,
+			0x46, 0xA1, 0xE8, 0xD8, 0xE2, 0x7E, 0x84, 0xAD, 0x78, 0x08, 0xC4, 0x6B, 0x7F, 0xDE, 0x7A, 0xF1, 0x8D, 0xF0, 0xC2, 0x1F,
+			0xD4, 0x9E, 0xC7, 0x9E, 0xBE, 0x1E, 0xBC, 0x57, 0xA9, 0x54, 0xFE, 0x70, 0xF5, 0xDB, 0x44, 0x69, 0x70, 0x61, 0x65, 0x45,
+			0x82, 0x20, 0xF8, 0xE1, 0xEB, 0xAF, 0x8B, 0xA4, 0x0F, 0x8F, 0xD1, 0x34, 0x4D, 0x2C, 0x8E, 0xD4, 0x66, 0x05, 0x86, 0xE5,
+			0x87, 0x18, 0x6E, 0xA1, 0xF0, 0x03, 0xA6, 0x7F, 0xEF, 0xA5, 0x97, 0xF6, 0xCF, 0xF5, 0x95, 0x7C, 0x0E, 0xFE, 0x3E, 0x9B,
+			0x59, 0xB0, 0x0B, 0x69, 0x3E, 0x02, 0x45, 0x10, 0x3E, 0x08, 0x2B, 0x28, 0x4B, 0x26, 0x61, 0xC4, 0x6C, 0x36, 0xFB, 0xB3,
+			0x1F, 0xFD, 0x48, 0x48, 0x74, 0x8C, 0x09, 0x62, 0x7D, 0xC9, 0x92, 0x59, 0x2F, 0x42, 0x63, 0xBC, 0x94, 0xD0, 0xE1, 0xC8,
+			0xCE, 0xF9, 0x2B, 0xA4, 0x00, 0x69, 0x6D, 0x25, 0xC2, 0x25, 0x11, 0xC7, 0x43, 0xCB, 0xB4, 0x3B, 0x89, 0xD6, 0x69, 0x39,
+			0x24, 0x10, 0xE4, 0xA4, 0x8B, 0xAA, 0x20, 0x50, 0x38, 0xA2, 0xB3, 0xB8, 0x34, 0xA4, 0x1A, 0x48, 0x80, 0x84, 0x6E, 0x80,
+			0xBF, 0x11, 0xF9, 0x48, 0x2C, 0x0C, 0xD0, 0x10, 0x17, 0xC6, 0x8E, 0x9D, 0x26, 0x2F, 0x19, 0x5C, 0xC8, 0xF6, 0x5E, 0x59,
+			0x59, 0x61, 0x16, 0xB1, 0x4C, 0x51, 0x84, 0xFE, 0x80, 0x81, 0x15, 0x30, 0x40, 0xDC, 0x2D, 0x3B, 0x87, 0xF4, 0x97, 0x71,
+			0x31, 0x40, 0x2C, 0xED, 0x76, 0x9B, 0xF8, 0x86, 0x27, 0x4C, 0xEB, 0x8C, 0xEB, 0xBA, 0x12, 0x5F, 0x62, 0xB8, 0xE8, 0x36,
+			0x25, 0x82, 0x1C, 0xEE, 0xBD, 0x48, 0x1F, 0xC1, 0x6C, 0x21, 0xC2, 0xA8, 0x5A, 0x31, 0x93, 0x6B, 0xD8, 0x61, 0x24, 0x5F,
+			0x14, 0x84, 0x25, 0x8F, 0x13, 0x2F, 0x34, 0x1C, 0x0E, 0x49, 0xB2, 0x88, 0x3D, 0x4B, 0xA5, 0x12, 0x90, 0x0E, 0x52, 0x94,
+			0x7C, 0x02, 0x13, 0x10, 0x91, 0xD9, 0x42, 0xD6, 0x03, 0x8C, 0x0F, 0xCC, 0x0E, 0x32, 0xB5, 0xB0, 0x1E, 0xC9, 0x97, 0xA1,
+			0x42, 0x92, 0x4F, 0x01, 0xCA, 0x17, 0x0A, 0x05, 0x38, 0x9D, 0x44, 0x6A, 0x84, 0x15, 0x74, 0x73, 0x40, 0xA2, 0x07, 0x00,
+			0x75, 0x5D, 0x17, 0x5A, 0x2A, 0xBB, 0x87, 0x94, 0x85, 0x66, 0x1F, 0x66, 0x43, 0xD2, 0x23, 0x06, 0xF1, 0x1C, 0x05, 0x50,
+			0xD7, 0x75, 0x69, 0x5B, 0x15, 0x39, 0x43, 0xEA, 0x04, 0xFB, 0x8D, 0x02, 0x20, 0x06, 0x2D, 0x1B, 0x04, 0xA7, 0x32, 0x70,
+			0x43, 0x88, 0x92, 0x14, 0xF7, 0x40, 0x21, 0x61, 0xA4, 0x94, 0xCB, 0xE5, 0x5E, 0xAF, 0x77, 0xE0, 0xC0, 0x81, 0x6E, 0xB7,
+			0xBB, 0x1F, 0x3D, 0x27, 0xF8, 0x42, 0xC8, 0x5B, 0xE6, 0x60, 0x70, 0x9B, 0xC4, 0x89, 0xD2, 0xAE, 0x29, 0x9A, 0x8B, 0x92,
+			0xBF, 0x93, 0xE8, 0xE0, 0x45, 0x20, 0xC9, 0xC1, 0xC6, 0xC1, 0x55, 0x48, 0xC6, 0x20, 0x42, 0x14, 0xD2, 0x97, 0x2F, 0x0C,
+			0xEB, 0xBD, 0x59, 0x58, 0x91, 0x24, 0x19, 0x09, 0xAF, 0x60, 0x37, 0x2B, 0xE7, 0x13, 0x44, 0xA1, 0x05, 0x04, 0x4F, 0x12,
+			0x4F, 0xE9, 0x12, 0x92, 0x03, 0x2F, 0xA2, 0x7E, 0x52, 0x5D, 0xA4, 0x60, 0x00, 0xF1, 0x19, 0x13, 0x89, 0xBC, 0x36, 0xAD,
+			0xBF, 0x22, 0xCF, 0xC7, 0xBE, 0x52, 0x0F, 0x31, 0x01, 0x3A, 0x29, 0xD8, 0xDE, 0xBE, 0x7D, 0x5B, 0x30, 0x04, 0x68, 0xDA,
+			0x9D, 0x4E, 0x87, 0x61, 0x4F, 0x22, 0x2F, 0x28, 0x13, 0xF0, 0x99, 0x00, 0x2A, 0xF5, 0x49, 0x62, 0x08, 0xFA, 0xCB, 0x88,
+			0x95, 0x68, 0x1F, 0x15, 0xB9, 0x2E, 0x9E, 0x1E, 0xB7, 0x0C, 0x55, 0x9C, 0xCC, 0x49, 0x82, 0x23, 0x34, 0xD7, 0x70, 0xA2,
+			0x4C, 0xA8, 0xFF, 0xA8, 0xFC, 0xC5, 0x5B, 0xF1, 0xA5, 0x8F, 0xCA, 0x5F, 0xA0, 0x17, 0xD4, 0xED, 0x76, 0x11, 0xCA, 0x45,
+			0x80, 0xF3, 0xC1, 0xD1, 0x9D, 0xAF, 0x78, 0xEF, 0x70, 0xAA, 0xCF, 0xA5, 0xAE, 0x3E, 0xEB, 0x9C, 0xC2, 0xFA, 0x10, 0x46,
+			0x75, 0x3A, 0x9D, 0xB3, 0xFA, 0x65, 0x4A, 0x05, 0xF5, 0x7A, 0xFD, 0x92, 0x7B, 0x03, 0xD0, 0x12, 0x28, 0x35, 0x0C, 0xC3,
+			0x93, 0xE1, 0xE1, 0x13, 0xC1, 0x83, 0xD0, 0x39, 0x4A, 0xA5, 0xD2, 0x1F, 0x35, 0x5E, 0x88, 0xE3, 0xB8, 0xDB, 0xED, 0xB2,
+			0x3D, 0x48, 0x20, 0x30, 0x8E, 0xE4, 0xD0, 0xB4, 0xC8, 0x34, 0x1A, 0x8D, 0x3F, 0xA8, 0x3D, 0x4F, 0x66, 0x93, 0xCF, 0xE7,
+			0xBF, 0x53, 0x54, 0x01, 0xBE, 0xE7, 0x79, 0xA4, 0x29, 0x51, 0x14, 0xAD, 0xAE, 0xAE, 0x4A, 0x49, 0x76, 0xBF, 0xF0, 0x59,
+			0x12, 0xC3, 0xA8, 0xFF, 0x04, 0x23, 0x95, 0x3D, 0x26, 0x39, 0x1C, 0xEF, 0x97, 0xB2, 0xA4, 0xC4, 0x0D, 0x4C, 0x26, 0x10,
+			0x10, 0x5F, 0x28, 0xF3, 0x42, 0x03, 0x15, 0xC1, 0x64, 0x3E, 0x93, 0xB1, 0x2D, 0x58, 0x64, 0xE1, 0x19, 0x03, 0x26, 0x8B,
+			0xA8, 0x9C, 0xFC, 0x21, 0x20, 0x9B, 0x34, 0xA2, 0x0B, 0x31, 0x89, 0x36, 0x52, 0xBE, 0x05, 0x2A, 0x07, 0x76, 0x0D, 0xBA,
+			0xBD, 0xD4, 0x2D, 0xE1, 0x7F, 0x33, 0x13, 0xB1, 0x5C, 0x2E, 0x03, 0x45, 0xB0, 0xCA, 0xC4, 0xDA, 0x68, 0x13, 0x88, 0x04,
+			0xEF, 0xFE, 0x46, 0x7A, 0xE1, 0x89, 0x21, 0xBC, 0x03, 0x42, 0xC0, 0x54, 0x0F, 0x91, 0xA5, 0xA1, 0x36, 0x00, 0xF7, 0x8C,
+			0xC8, 0x4C, 0x14, 0x71, 0xE8, 0x4E, 0xCF, 0xE7, 0xF3, 0x58, 0x30, 0x94, 0xA9, 0xF8, 0x4F, 0xE1, 0x49, 0xE3, 0x45, 0x08,
+			0x3E, 0x68, 0x5B, 0x13, 0x4E, 0x30, 0x07, 0x93, 0x72, 0x29, 0xE5, 0x5F, 0x8E, 0x33, 0x52, 0xF6, 0xD2, 0x10, 0x20, 0xE9,
+			0x35, 0x0F, 0x0A, 0xFF, 0x44, 0xDD, 0x0B, 0x5E, 0xE2, 0x72, 0xB9, 0xA4, 0xB9, 0x1D, 0x59, 0x36, 0x96, 0xA9, 0x5A, 0xAD,
+			0xC2, 0xE1, 0x96, 0x70, 0x0D, 0xBC, 0x84, 0x6F, 0x87, 0xDE, 0x59, 0xAD, 0x56, 0x81, 0xBC, 0x40, 0x26, 0x99, 0x24, 0x83,
+			0x9C, 0x81, 0x01, 0x2C, 0x83, 0x2A, 0xAF, 0xD0, 0x16, 0xB1, 0xFB, 0xD0, 0xFB, 0x30, 0x7C, 0x04, 0x62, 0x08, 0x33, 0x08,
+			0xE1, 0x09, 0x2A, 0x0B, 0x96, 0x8B, 0x31, 0xF3, 0x5C, 0x3D, 0x89, 0x0F, 0x91, 0xA6, 0xE0, 0x59, 0xC2, 0xD5, 0x95, 0x0E,
+			0x11, 0x48, 0x1D, 0xC4, 0x8F, 0x14, 0x84, 0x79, 0x0A, 0xFB, 0xB3, 0x1E, 0x54, 0x01, 0xA4, 0xB0, 0x46, 0x58, 0xC7, 0x22,
+			0xC9, 0x00, 0x2F, 0x44, 0xDC, 0x56, 0x57, 0x57, 0x11, 0xF6, 0xA3, 0x9F, 0x90, 0x72, 0x31, 0x8B, 0x2D, 0xD6, 0x53, 0xD9,
+			0xEF, 0x24, 0xD8, 0xA4, 0x09, 0x99, 0xEC, 0x09, 0x47, 0x85, 0xCF, 0xA4, 0xB5, 0x84, 0x4D, 0x8C, 0x4D, 0x21, 0xE9, 0x61,
+			0xEB, 0x83, 0x39, 0xB2, 0xF9, 0x24, 0xB7, 0x90, 0x01, 0xC2, 0x71, 0x1C, 0x43, 0x03, 0x45, 0xFA, 0x15, 0x0D, 0x13, 0x49,
+			0x9B, 0xE8, 0x72, 0x42, 0x9E, 0xA8, 0xD9, 0x6C, 0x52, 0x72, 0xC4, 0x46, 0x8B, 0x8D, 0x83, 0xC8, 0x2F, 0xE3, 0x79, 0x29,
+			0x14, 0x27, 0x9E, 0x4E, 0x71, 0x58, 0x81, 0xC2, 0xA4, 0x1B, 0x8E, 0xC7, 0x4E, 0xCA, 0x42, 0x29, 0x02, 0xEE, 0x81, 0xC4,
+			0x68, 0x22, 0x41, 0x01, 0xAA, 0x28, 0xB5, 0x68, 0x39, 0xDE, 0x44, 0x7F, 0x54, 0x7D, 0xB0, 0xAD, 0x02, 0x37, 0x09, 0xE6,
+			0xCB, 0x29, 0x25, 0xB8, 0x4B, 0x6E, 0x37, 0x92, 0xD9, 0xC5, 0x70, 0x9F, 0xC0, 0x5B, 0x71, 0xA5, 0x6C, 0x29, 0xE0, 0x1A,
+			0x3C, 0x99, 0xA4, 0xE7, 0xC4, 0x1D, 0x58, 0x5E, 0x19, 0xA4, 0x47, 0xFC, 0x88, 0x6B, 0x81, 0x7B, 0x76, 0xF0, 0xE0, 0x41,
+			0x58, 0xD5, 0xCC, 0x70, 0x47, 0x39, 0x03, 0xF0, 0x8A, 0x1D, 0x42, 0xC3, 0x07, 0x4C, 0xB0, 0xD9, 0x6C, 0xB6, 0xBE, 0xBE,
+			0x2E, 0x62, 0x24, 0xB4, 0x65, 0x91, 0x08, 0x32, 0x31, 0x8A, 0x44, 0x8D, 0x58, 0x72, 0x75, 0x75, 0x95, 0x92, 0x35, 0x71,
+			0x6E, 0xB7, 0xDB, 0xA5, 0xAE, 0x48, 0x90, 0x78, 0xAD, 0xF0, 0xD9, 0xC9, 0xF0, 0xF0, 0x9D, 0xCD, 0x2F, 0x1D, 0x1E, 0x7F,
+			0xE3, 0x66, 0xFD, 0x6F, 0x25, 0x53, 0x84, 0x33, 0x87, 0xC5, 0x79, 0x74, 0x79, 0xE8, 0x8B, 0x8D, 0x7F, 0xA8, 0x56, 0xAB,
+			0x8F, 0x2E, 0x0F, 0x81, 0x57, 0xE0, 0xCC, 0x08, 0xCA, 0x1E, 0x1C, 0xDD, 0x49, 0x21, 0xF7, 0xC8, 0xF4, 0xEE, 0xC3, 0xE3,
+			0x6F, 0x68, 0x9A, 0xF6, 0xE2, 0xE4, 0x4D, 0x45, 0xB1, 0xF3, 0xBC, 0xF8, 0xB4, 0x09, 0x2E, 0x0A, 0xC7, 0xE3, 0x47, 0xE3,
+			0x37, 0x46, 0xA3, 0xD1, 0x1B, 0xE1, 0x85, 0x6C, 0x36, 0x7B, 0xF0, 0xE0, 0xC1, 0x37, 0xA3, 0x8B, 0xB5, 0x5A, 0x0D, 0x3D,
+			0x77, 0x94, 0x65, 0x55, 0xB8, 0x9B, 0x70, 0xA2, 0xEE, 0xE9, 0x7C, 0xC5, 0xF3, 0xBC, 0xD7, 0x83, 0xF7, 0xBA, 0xF7, 0x2A,
+			0xA6, 0x3F, 0x5A, 0x14, 0xD3, 0xE9, 0xF4, 0x09, 0xE3, 0x68, 0xA9, 0x54, 0xBA, 0x90, 0xFE, 0xE8, 0x1D, 0xE3, 0x0A, 0xA0,
+			0x9F, 0xB0, 0x75, 0x25, 0x02, 0xA0, 0x7B, 0x4B, 0x8A, 0xBA, 0xA2, 0x00, 0x28, 0x9B, 0x47, 0xE0, 0x41, 0x19, 0x0B, 0x23,
+			0xC4, 0x8A, 0xFD, 0xA3, 0xDB, 0x05, 0x50, 0x12, 0xDF, 0x2F, 0x23, 0xED, 0xD0, 0x54, 0x10, 0xFA, 0x9F, 0x74, 0x9C, 0x89,
+			0xDE, 0xF2, 0x1E, 0xA9, 0x71, 0xB7, 0x26, 0x41, 0x80, 0x25, 0xD1, 0x83, 0xB4, 0x7C, 0x8A, 0x6A, 0xA6, 0xB4, 0xA4, 0x40,
+			0x0D, 0x07, 0x26, 0xC2, 0x49, 0x0B, 0x67, 0xA1, 0xD3, 0xE9, 0xD0, 0x93, 0x44, 0xC7, 0x13, 0xE6, 0x0B, 0x70, 0x9F, 0xC2,
+			0xEF, 0xCE, 0xCE, 0x0E, 0x56, 0x8F, 0x2D, 0x87, 0xDE, 0x1C, 0xB1, 0xA0, 0xCC, 0x47, 0x54, 0xB4, 0xCE, 0xE4, 0x49, 0x8E,
+			0xC7, 0xE3, 0x6A, 0xB5, 0x4A, 0x8D, 0x97, 0x18, 0x8E, 0xA6, 0x22, 0xB0, 0x6B, 0x88, 0x24, 0x88, 0x1F, 0x97, 0x4A, 0x25,
+			0x32, 0x78, 0x00, 0x09, 0x84, 0x93, 0x3B, 0x9D, 0x8E, 0x74, 0xB0, 0x56, 0x2A, 0x15, 0xBA, 0x17, 0x31, 0xF1, 0x24, 0x1C,
+			0xD4, 0x1A, 0xA1, 0x2F, 0x72, 0x1C, 0x30, 0x6E, 0xE9, 0x74, 0x5A, 0xA6, 0x53, 0x60, 0xA9, 0xE1, 0x6E, 0x90, 0x4A, 0x22,
+			0x8A, 0x97, 0xB8, 0xA1, 0xAC, 0x1C, 0x52, 0x6A, 0x0C, 0xDC, 0x02, 0x13, 0x52, 0x65, 0xBE, 0x37, 0xE1, 0x02, 0x75, 0x63,
+			0x40, 0x3F, 0x84, 0xDE, 0x44, 0xD6, 0x01, 0x96, 0x1A, 0xC5, 0x70, 0xF2, 0x0F, 0x82, 0x78, 0x65, 0x4C, 0x14, 0x65, 0x2A,
+			0x59, 0x30, 0x19, 0xF8, 0x35, 0x1C, 0x0E, 0x29, 0x6E, 0x50, 0x03, 0xB0, 0x6D, 0x1B, 0x3E, 0xB5, 0x44, 0x85, 0xA4, 0x5D,
+			0xB4, 0x26, 0xD2, 0xEE, 0x4B, 0x02, 0x48, 0x8B, 0x5A, 0xB1, 0x58, 0xA4, 0x37, 0x8A, 0xDE, 0x5D, 0xC2, 0x0A, 0x1C, 0x1D,
+			0x1D, 0x6B, 0x94, 0x5E, 0x21, 0xE6, 0x43, 0x8C, 0x93, 0x16, 0x7F, 0x99, 0x64, 0x3F, 0x1C, 0x0E, 0x69, 0xE5, 0xCF, 0x27,
+			0x2F, 0x38, 0x36, 0x0C, 0xF7, 0x60, 0x7B, 0x21, 0x2C, 0x01, 0xAA, 0x83, 0x8D, 0x13, 0xE1, 0xA7, 0xD9, 0x6C, 0xB6, 0xB1,
+			0xB1, 0x01, 0x52, 0x86, 0x28, 0x1B, 0xBA, 0x1C, 0xE2, 0x24, 0xE9, 0x80, 0xE0, 0x8E, 0x30, 0xD6, 0xC4, 0x9E, 0x54, 0x93,
+			0xA4, 0xEF, 0xA9, 0xDF, 0xEF, 0x93, 0x97, 0x50, 0xDF, 0xA7, 0xF2, 0x46, 0x28, 0xC4, 0xB4, 0x5B, 0x99, 0x51, 0x23, 0x32,
+			0xE5, 0x90, 0x65, 0x39, 0x6F, 0x44, 0x22, 0xB4, 0x04, 0x93, 0x97, 0xA1, 0x8D, 0x53, 0xAD, 0x56, 0xE1, 0x7B, 0xD9, 0xB6,
+			0xDD, 0x68, 0x34, 0x54, 0xE2, 0xEF, 0xAA, 0xA6, 0xDF, 0xEE, 0xC2, 0xA2, 0xD7, 0xB7, 0xE8, 0xA6, 0xB6, 0xC7, 0xAA, 0x31,
+			0x98, 0xB1, 0xC0, 0xBF, 0xEC, 0x2E, 0x98, 0xF7, 0xCB, 0x50, 0x5F, 0x45, 0x29, 0xD3, 0x97, 0x95, 0x9C, 0x4D, 0x37, 0x2F,
+			0x62, 0xCE, 0x6E, 0x2A, 0x2C, 0x65, 0xCC, 0xAD, 0x91, 0x52, 0x93, 0xE6, 0xDF, 0x83, 0xC0, 0xD9, 0x1C, 0x86, 0x19, 0x53,
+			0x01, 0xB5, 0x19, 0x33, 0xFA, 0x87, 0xF6, 0xBC, 0x5A, 0x70, 0x6C, 0xCD, 0xAF, 0xE4, 0xEC, 0x5F, 0xF5, 0xFC, 0xE6, 0x44,
+			0xA3, 0x84, 0x5B, 0xCA, 0x98, 0xAD, 0x99, 0xFA, 0x52, 0xBA, 0x91, 0x87, 0xA1, 0xF2, 0x2E, 0xB5, 0xA2, 0x6B, 0x46, 0x5E,
+			0xD1, 0x55, 0xD3, 0x89, 0xB3, 0x56, 0x4C, 0x01, 0xF9, 0x57, 0x3D, 0xFF, 0xF6, 0x20, 0x48, 0xEB, 0x4B, 0x66, 0x08, 0x6F,
+			0x8F, 0x63, 0x4A, 0xD3, 0x14, 0x3F, 0x92, 0x73, 0x9D, 0x6A, 0x36, 0x9B, 0x34, 0xFD, 0x81, 0xF8, 0x8B, 0x0E, 0x17, 0xC0,
+			0x20, 0xFB, 0x87, 0xA7, 0x4A, 0x70, 0xC7, 0xE6, 0x9E, 0x25, 0x2F, 0xF8, 0x36, 0x7C, 0x02, 0x69, 0x3B, 0x41, 0x99, 0xE4,
+			0xFB, 0xE5, 0x72, 0x19, 0xB1, 0xF8, 0xF5, 0xF5, 0x75, 0x61, 0x49, 0xC1, 0x0D, 0xA3, 0x65, 0x8C, 0x7A, 0x29, 0xC1, 0x5D,
+			0xB7, 0xDB, 0x45, 0x4A, 0x08, 0x7B, 0x64, 0x9A, 0x66, 0xBB, 0xDD, 0xDE, 0xDE, 0xDE, 0x06, 0x5D, 0x31, 0x4D, 0x73, 0x7D,
+			0x7D, 0x1D, 0x8C, 0x95, 0xBE, 0x53, 0x86, 0xFD, 0xE2, 0x3C, 0x8E, 0x4C, 0xEF, 0x46, 0xA1, 0xC5, 0xF7, 0x7D, 0xA4, 0xEE,
+			0xF8, 0xF9, 0x45, 0xE7, 0xFA, 0xE9, 0xF8, 0x08, 0xA8, 0x11, 0x0D, 0x4A, 0x97, 0xB3, 0x9F, 0xBC, 0x38, 0x79, 0xB3, 0xD1,
+			0x68, 0x8C, 0x46, 0xA3, 0x17, 0x27, 0x6F, 0x52, 0xEB, 0x22, 0x31, 0x1A, 0x8F, 0xC7, 0x27, 0xC3, 0xC3, 0x3F, 0xF1, 0xDF,
+			0x7D, 0x23, 0xBC, 0x90, 0x3A, 0xA7, 0x74, 0x2F, 0x96, 0xCB, 0x25, 0x99, 0xF1, 0xA9, 0xE8, 0xA1, 0x20, 0x50, 0x54, 0x51,
+			0xC7, 0x71, 0xFE, 0xB4, 0xF9, 0xC3, 0x3F, 0xA8, 0x3D, 0x3F, 0x99, 0x4C, 0x36, 0x36, 0x36, 0x72, 0xB9, 0x1C, 0xF5, 0x24,
+			0x10, 0x7C, 0xF2, 0xF7, 0xC9, 0x64, 0xF2, 0x98, 0xF6, 0x30, 0xDA, 0xB7, 0x83, 0xC1, 0xE0, 0xD5, 0xC5, 0x39, 0xE6, 0x01,
+			0x34, 0x9B, 0xCD, 0xA7, 0xED, 0x13, 0x5F, 0xDB, 0xFC, 0x8D, 0xFF, 0xAD, 0x0F, 0x4B, 0x0C, 0x31, 0x8D, 0x29, 0x98, 0x72,
+			0x49, 0x31, 0x81, 0x65, 0xC8, 0x11, 0xB1, 0x7A, 0xC2, 0xEB, 0xA7, 0x0E, 0x29, 0x2A, 0x2C, 0xD2, 0xD9, 0x07, 0x89, 0x43,
+			0xC4, 0x30, 0x30, 0xC4, 0x4C, 0xF4, 0x93, 0x58, 0x9E, 0x94, 0x4E, 0x66, 0x0A, 0xA9, 0xD3, 0xB8, 0xD7, 0x84, 0x28, 0xA4,
+			0x4F, 0x4C, 0x92, 0xD0, 0x52, 0xA5, 0xBC, 0x09, 0x71, 0x80, 0xFF, 0x94, 0x91, 0xE8, 0x90, 0xCB, 0x51, 0xCD, 0x14, 0x0F,
+			0xD1, 0x6E, 0xB7, 0xB1, 0x8C, 0x6A, 0x4B, 0x27, 0x9C, 0x0B, 0x22, 0x59, 0xFA, 0xDA, 0xA0, 0xF3, 0x92, 0xE5, 0x03, 0xF4,
+			0x51, 0x16, 0xE6, 0x1B, 0x51, 0xEA, 0x87, 0x07, 0x28, 0x19, 0x0C, 0x7C, 0x13, 0xCA, 0xDD, 0x74, 0x84, 0x51, 0xEF, 0x71,
+			0x5D, 0x17, 0x41, 0x04, 0x80, 0x50, 0x4A, 0xD3, 0x34, 0xFA, 0x12, 0x1B, 0x29, 0xB2, 0x51, 0xC2, 0x90, 0x14, 0xAE, 0x39,
+			0x81, 0x42, 0xB9, 0x5C, 0x06, 0x0B, 0x22, 0x60, 0x07, 0xD0, 0x2F, 0x97, 0xCB, 0xA8, 0x35, 0x20, 0x3A, 0x22, 0xBC, 0x06,
+			0xF6, 0x27, 0xF6, 0x1A, 0xD3, 0x24, 0x93, 0xD4, 0x98, 0x77, 0xCB, 0xD3, 0x00, 0x0D, 0x06, 0x4A, 0x82, 0x26, 0x84, 0xB9,
+			0x07, 0x13, 0xA6, 0xE3, 0x92, 0xC2, 0x12, 0x0F, 0x1C, 0xF5, 0x65, 0x22, 0x7D, 0x98, 0x29, 0xB4, 0x2E, 0x72, 0x64, 0x64,
+			0x9A, 0x16, 0xA4, 0xB8, 0x30, 0x0C, 0x77, 0x07, 0x2E, 0x33, 0x8F, 0x45, 0xD2, 0x43, 0xB2, 0x27, 0x60, 0x13, 0x98, 0x2A,
+			0xF0, 0xAF, 0x57, 0x56, 0x56, 0x98, 0x63, 0x2E, 0x65, 0x25, 0x06, 0x10, 0x13, 0xD9, 0x09, 0x79, 0x16, 0x5B, 0x49, 0x92,
+			0x4B, 0xE4, 0x8B, 0xC8, 0x09, 0x61, 0x26, 0x3D, 0x69, 0x6A, 0xDF, 0x25, 0xEB, 0xCA, 0xDC, 0x70, 0x82, 0x44, 0x8C, 0x32,
+			0xBB, 0x16, 0xAE, 0x3D, 0x3A, 0x42, 0x3B, 0x3B, 0x3B, 0x84, 0xC3, 0x68, 0x2A, 0xC0, 0x1A, 0x22, 0xBC, 0x42, 0x50, 0x90,
+			0x5C, 0x98, 0x60, 0x56, 0x85, 0xA9, 0x51, 0xB4, 0xB1, 0xB1, 0xC1, 0x9D, 0x33, 0xBD, 0xA1, 0x5C, 0x2E, 0x0B, 0x77, 0x73,
+			0x34, 0x1A, 0x71, 0xD9, 0x9E, 0xE7, 0x6D, 0x6F, 0x6F, 0xB7, 0x5A, 0x2D, 0x50, 0x23, 0x1A, 0xFA, 0x0B, 0x85, 0x02, 0x58,
+			0x4D, 0x10, 0x04, 0xE8, 0xA5, 0xB0, 0xD5, 0x38, 0x63, 0x02, 0xD5, 0xE5, 0xF3, 0x79, 0x5D, 0xD7, 0x49, 0xAC, 0x10, 0x2F,
+			0x64, 0xE5, 0xA2, 0x28, 0xDA, 0xDA, 0xDA, 0x42, 0xB3, 0x1A, 0x5E, 0x13, 0x7E, 0x54, 0xA4, 0x98, 0x59, 0x6C, 0x38, 0xBC,
+			0x44, 0x01, 0xB4, 0xED, 0x24, 0x6F, 0xD9, 0x05, 0xF1, 0x59, 0x4B, 0x51, 0x1B, 0x15, 0xA8, 0x07, 0x16, 0x87, 0x10, 0x39,
+			0xE0, 0x00, 0x24, 0x6D, 0x26, 0xBB, 0x82, 0x7D, 0xD2, 0xF4, 0x4B, 0xBA, 0x20, 0xE8, 0x1C, 0x65, 0x00, 0x52, 0x37, 0x19,
+			0x2C, 0x23, 0xD1, 0x96, 0x08, 0x40, 0x0A, 0x8D, 0x87, 0x26, 0x17, 0x19, 0x1E, 0x22, 0xDC, 0x50, 0x11, 0x43, 0x15, 0x9A,
+			0x39, 0x23, 0xBC, 0x29, 0xDD, 0x4B, 0xFC, 0x42, 0x79, 0x06, 0xD9, 0x29, 0x1A, 0x17, 0x59, 0x65, 0x46, 0xAD, 0x76, 0xBB,
+			0x5D, 0xB4, 0x1E, 0x29, 0x9C, 0x80, 0x75, 0x54, 0x2A, 0x15, 0x5A, 0xF9, 0xB1, 0xE9, 0xD2, 0xA2, 0x09, 0x5C, 0x4B, 0xFC,
+			0x4E, 0xB6, 0xCB, 0xBE, 0x1A, 0x8D, 0x46, 0x22, 0xA3, 0x08, 0xBA, 0xB8, 0xBA, 0xBA, 0x8A, 0x84, 0x89, 0x65, 0x59, 0xB5,
+			0x5A, 0x4D, 0x54, 0x53, 0xB8, 0x53, 0xDA, 0xCE, 0xC1, 0x0A, 0x56, 0x57, 0x57, 0xD9, 0x06, 0xD9, 0x6C, 0x76, 0x6B, 0x6B,
+			0x8B, 0x96, 0x7A, 0xE8, 0x95, 0x1F, 0x96, 0x7E, 0x8A, 0x5B, 0x82, 0x83, 0x04, 0x03, 0xE2, 0xD5, 0xC5, 0x39, 0xAA, 0xB2,
+			0x6F, 0x6B, 0xEF, 0x13, 0xB9, 0xB7, 0x5A, 0x2D, 0x66, 0x72, 0xFD, 0x51, 0xE3, 0x85, 0xD1, 0x68, 0x54, 0xAB, 0xD5, 0x7E,
+			0xBF, 0xFA, 0xCD, 0xD7, 0x96, 0xE7, 0xA9, 0x3F, 0xB9, 0xAE, 0x0B, 0xC5, 0xE2, 0x85, 0xC2, 0x99, 0xEF, 0x14, 0x9F, 0x92,
+			0x56, 0x44, 0x6E, 0xAA, 0x5E, 0xAF, 0x7F, 0x50, 0xFC, 0xFC, 0x7B, 0x9D, 0x97, 0xDA, 0xED, 0xF6, 0xB7, 0xF3, 0x4F, 0x9E,
+			0x4B, 0x5D, 0x65, 0x4A, 0x0C, 0xA4, 0x09, 0x28, 0xAA, 0xA0, 0xBA, 0xD8, 0x47, 0x65, 0x3E, 0x2E, 0x18, 0xB5, 0x5A, 0xED,
+			0xB3, 0xC6, 0x2F, 0x1E, 0xD7, 0x1F, 0xB9, 0xE4, 0xDE, 0x38, 0xB6, 0xB8, 0xFF, 0xC0, 0x81, 0x03, 0xD3, 0xE9, 0x74, 0x7D,
+			0x7D, 0x5D, 0x2A, 0xB4, 0x52, 0x0A, 0xA2, 0x87, 0x56, 0x84, 0xC9, 0xA4, 0xEB, 0x0A, 0x34, 0x8F, 0x90, 0x4E, 0xD4, 0x81,
+			0x64, 0x72, 0xAC, 0x94, 0x10, 0x49, 0x11, 0xC8, 0x2C, 0x05, 0xF3, 0x11, 0x5D, 0x58, 0x3E, 0x07, 0xBF, 0x28, 0xC2, 0x67,
+			0xA4, 0x17, 0x32, 0xAE, 0x43, 0x6A, 0x06, 0xC4, 0x85, 0xD0, 0xC0, 0x70, 0x3F, 0x5C, 0x83, 0xB1, 0xEF, 0x25, 0x73, 0x32,
+			0x58, 0x77, 0xC4, 0x05, 0xE8, 0xBC, 0x85, 0x04, 0x29, 0xDB, 0x40, 0xF8, 0x4E, 0x34, 0xC4, 0x8A, 0xF8, 0x2E, 0xD4, 0x32,
+			0x69, 0x44, 0xE7, 0x14, 0x63, 0x2B, 0x09, 0xC2, 0xD8, 0xF0, 0xA0, 0xFF, 0x80, 0x16, 0x98, 0x57, 0x4E, 0x16, 0xE7, 0x1A,
+			0xB6, 0x34, 0x72, 0xE5, 0xF2, 0x18, 0xA1, 0x1E, 0xB1, 0xAB, 0x49, 0xAA, 0xB0, 0x5D, 0x24, 0x28, 0xD2, 0x7B, 0x48, 0xA2,
+			0x29, 0xA6, 0x19, 0x12, 0x17, 0x87, 0x9A, 0x90, 0xBF, 0xD7, 0xEB, 0xCD, 0x66, 0x33, 0x35, 0x80, 0x37, 0xF1, 0x34, 0x50,
+			0x90, 0x65, 0xD6, 0x10, 0x61, 0x01, 0xD3, 0xEB, 0x44, 0x2B, 0x42, 0x34, 0x75, 0x38, 0xA4, 0x50, 0xA5, 0x69, 0x89, 0xA8,
+			0x56, 0xAB, 0x88, 0xD6, 0x11, 0xAF, 0xCB, 0x34, 0x27, 0x9A, 0x30, 0x68, 0x95, 0xC7, 0xBB, 0x13, 0x48, 0xB1, 0xB1, 0xA9,
+			0x4E, 0xC3, 0x79, 0xC3, 0xA9, 0x73, 0xE2, 0x76, 0x15, 0x5F, 0xDD, 0xE4, 0xA5, 0x5A, 0x30, 0x92, 0x1A, 0xA6, 0x28, 0x33,
+			0x53, 0x78, 0x11, 0x55, 0x77, 0x70, 0x4F, 0x6E, 0x95, 0xB3, 0x44, 0x0D, 0x04, 0x93, 0x0A, 0xF1, 0x46, 0x64, 0xE3, 0x84,
+			0xF9, 0xC4, 0x3D, 0xB3, 0x75, 0xD2, 0xE9, 0x34, 0x33, 0x9B, 0xB8, 0x61, 0x32, 0x50, 0xFC, 0x58, 0x14, 0x45, 0xD4, 0x72,
+			0xB1, 0x68, 0xB9, 0x5C, 0x6E, 0x67, 0x67, 0x07, 0x34, 0x0D, 0x6C, 0x81, 0x6F, 0xC7, 0xD2, 0xA1, 0xB0, 0x91, 0xCD, 0x66,
+			0x7F, 0xF8, 0xEE, 0xBB, 0x6C, 0x71, 0x82, 0x17, 0xC8, 0x2A, 0x9F, 0x7F, 0xFE, 0xF9, 0xFE, 0x0D, 0x7D, 0xF6, 0xEC, 0x59,
+			0x21, 0xDB, 0x8A, 0xC2, 0x25, 0x38, 0x06, 0x9C, 0x99, 0xCF, 0x3F, 0xFF, 0x5C, 0xEC, 0xA9, 0xE0, 0x92, 0x12, 0x37, 0xA5,
+			0x52, 0xA9, 0x6B, 0xD7, 0xAE, 0xB1, 0x11, 0x35, 0x4D, 0xBB, 0x79, 0xF3, 0x26, 0xA7, 0x48, 0x58, 0xCC, 0xFB, 0x87, 0xBD,
+			0x09, 0xE8, 0x81, 0xFD, 0x85, 0x8B, 0xA6, 0x69, 0xDA, 0x07, 0x1F, 0x7C, 0xC0, 0x76, 0x14, 0x44, 0x9E, 0x44, 0xC1, 0x4A,
+			0x8E, 0xCD, 0xDC, 0xF3, 0x6D, 0x3B, 0x6D, 0x9A, 0xBA, 0x9A, 0xDB, 0x6E, 0xAA, 0x72, 0x74, 0xAC, 0xBA, 0x29, 0xEC, 0xE5,
+			0x42, 0x71, 0xE9, 0x0C, 0x43, 0x33, 0x4D, 0xDD, 0xB6, 0x0D, 0x3F, 0x52, 0xD9, 0x37, 0x0E, 0x7C, 0xE9, 0x29, 0xE8, 0xDF,
+			0xF3, 0x97, 0xC9, 0xF3, 0x50, 0x07, 0x55, 0xD3, 0x55, 0x72, 0x1A, 0x19, 0xDA, 0x22, 0x54, 0xFF, 0x98, 0x4D, 0xBC, 0xC5,
+			0x62, 0x77, 0x06, 0xAC, 0xAE, 0xAB, 0xEE, 0x36, 0x2F, 0xD0, 0xE6, 0x4B, 0x3F, 0x08, 0x83, 0x38, 0xD6, 0xA3, 0x28, 0x5E,
+			0x2E, 0x95, 0x3F, 0x76, 0xD4, 0x13, 0xF3, 0x4D, 0xD3, 0x0A, 0x02, 0xB5, 0xD5, 0xA8, 0xD7, 0xCD, 0x66, 0x8A, 0x1F, 0xE2,
+			0xBA, 0x69, 0x4D, 0x53, 0xEF, 0x31, 0x35, 0x6D, 0x3C, 0x57, 0x7E, 0x2B, 0x9D, 0x4E, 0x79, 0xC1, 0xC2, 0x36, 0xED, 0xD1,
+			0xCC, 0x0F, 0x43, 0xF5, 0xE1, 0xFF, 0xF8, 0xB3, 0x17, 0x85, 0x23, 0x44, 0x11, 0x5B, 0x24, 0x01, 0x84, 0xAD, 0xD4, 0xFE,
+			0xE5, 0x5B, 0x2A, 0x0F, 0x4D, 0xEA, 0x69, 0xF3, 0xAE, 0xE2, 0x35, 0xFF, 0x6C, 0x47, 0x5D, 0xF0, 0xD4, 0x34, 0x3B, 0x89,
+			0x5D, 0x18, 0xEF, 0x28, 0xDE, 0xA7, 0xCA, 0xBA, 0x36, 0x77, 0x43, 0x07, 0x0E, 0xC0, 0x74, 0xEF, 0x7C, 0xFE, 0xF5, 0xC7,
+			0xDF, 0x93, 0x89, 0x66, 0xFD, 0x4D, 0x65, 0x5F, 0x16, 0x7D, 0x95, 0xF5, 0x2F, 0xFA, 0x8E, 0x9C, 0x96, 0xE6, 0x1E, 0x49,
+			0x4C, 0x00, 0x04, 0x12, 0xD9, 0x79, 0x57, 0x41, 0x1C, 0xBD, 0xDB, 0xEF, 0x74, 0xF7, 0x38, 0x21, 0xA2, 0x83, 0xBD, 0x15,
+			0x86, 0x93, 0x15, 0xD5, 0x87, 0x09, 0x5B, 0x9F, 0xFF, 0xFB, 0xD0, 0xFE, 0x04, 0xCC, 0xF0, 0x83, 0xE2, 0xE7, 0x99, 0x2B,
+			0xCA, 0x19, 0xD4, 0x6F, 0x96, 0xAF, 0xA7, 0x3E, 0x45, 0xB1, 0xAE, 0xB1, 0x58, 0xB9, 0x96, 0xBE, 0x21, 0x49, 0x9E, 0xFA,
+			0xC7, 0xA3, 0x4A, 0x10, 0xA6, 0x7E, 0xB3, 0x9C, 0xFA, 0x6A, 0xEA, 0x1D, 0xE3, 0xCA, 0xC6, 0xF9, 0xBA, 0x68, 0xDD, 0xB4,
+			0xEE, 0xBA, 0x51, 0xB9, 0xA4, 0xC4, 0x41, 0x3F, 0xFE, 0xF8, 0x73, 0xDB, 0xB6, 0xB7, 0xEF, 0xE8, 0xAC, 0x7D, 0x54, 0xED,
+			0xDE, 0xAB, 0x12, 0x85, 0x03, 0x07, 0x0E, 0x7C, 0xF2, 0xE6, 0x4F, 0x17, 0xC7, 0x22, 0xFB, 0x75, 0xFD, 0xDA, 0xA9, 0xCF,
+			0x8C, 0xD0, 0x78, 0xEF, 0xD2, 0xE5, 0xD6, 0x5D, 0xFD, 0xCA, 0x45, 0x95, 0x91, 0xB0, 0xC1, 0x82, 0x13, 0xBA, 0xF9, 0x6E,
+			0xBC, 0x38, 0x16, 0x19, 0x7E, 0xF8, 0x83, 0xC1, 0x4F, 0x4A, 0x1F, 0x66, 0xAF, 0xA6, 0xAE, 0xA7, 0xE2, 0xF8, 0x8B, 0xE8,
+			0x6F, 0xAF, 0xE4, 0x6E, 0x96, 0x1A, 0xA5, 0xED, 0xED, 0x4B, 0x1B, 0xC1, 0x6F, 0xC9, 0xA8, 0xAC, 0x54, 0x2A, 0xA5, 0xEB,
+			0x7A, 0xAF, 0xF7, 0xFF, 0x73, 0xF5, 0xA6, 0x5F, 0x8E, 0xD6, 0xE7, 0x99, 0xFF, 0xF3, 0x68, 0xDF, 0x55, 0x2A, 0x95, 0xA4,
+			0x5A, 0x9A, 0x93, 0xED, 0x97, 0x9F, 0x83, 0x97, 0xB1, 0x31, 0x3B, 0xED, 0xA6, 0x9B, 0xC6, 0xD0, 0x2C, 0x76, 0x0C, 0x18,
+			0xCF, 0x1C, 0xC7, 0x09, 0x33, 0xF3, 0x37, 0x24, 0x6F, 0x72, 0x4E, 0xDE, 0xE4, 0x55, 0x4E, 0xDE, 0xCF, 0xEB, 0x9C, 0xCC,
+			0xC9, 0xD8, 0xE3, 0x0D, 0x63, 0xB3, 0xB5, 0x19, 0xE8, 0x6E, 0x03, 0xC6, 0xEE, 0xB6, 0x83, 0x6D, 0x8C, 0x1D, 0x93, 0x21,
+			0xCC, 0xE0, 0x50, 0x8B, 0x4A, 0x52, 0xA9, 0x4A, 0x2A, 0x6D, 0x8F, 0x96, 0x47, 0x73, 0xEE, 0xEF, 0x47, 0xBA, 0xAC, 0x43,
+			0xCD, 0x1C, 0x1F, 0xD2, 0x34, 0x2A, 0xE9, 0xD1, 0xF7, 0x7B, 0x2F, 0xD7, 0x7D, 0x5D, 0xD7, 0x6D, 0x0A, 0xCA, 0x55, 0x7E,
+			0xBD, 0xC2, 0x3A, 0x68, 0x98, 0xA6, 0x4D, 0xAC, 0xDC, 0x50, 0x6D, 0x2E, 0x2A, 0x81, 0xF6, 0x41, 0xEA, 0x35, 0xA5, 0xFC,
+			0x00, 0xCD, 0xE0, 0x3B, 0x12, 0xC0, 0x28, 0xF0, 0x47, 0x72, 0x62, 0x49, 0x07, 0xE0, 0x38, 0x8A, 0x6C, 0x2A, 0xC9, 0x82,
+			0x36, 0x52, 0x18, 0x9C, 0xB0, 0x96, 0x56, 0xAB, 0x01, 0xAA, 0x46, 0xDC, 0x04, 0x42, 0xC0, 0x50, 0x41, 0xD0, 0x25, 0xAA,
+			0x0B, 0x55, 0x7B, 0xF4, 0xE2, 0x4C, 0xEC, 0x19, 0xCC, 0xD2, 0xC7, 0xAB, 0xDD, 0x97, 0xB7, 0x2E, 0x10, 0x3F, 0xDA, 0x7E,
+			0x3C, 0x44, 0xB5, 0xD1, 0x17, 0x7C, 0xDF, 0x8E, 0xA8, 0x73, 0x7C, 0x81, 0xE2, 0x49, 0xE5, 0xC4, 0x14, 0x07, 0x48, 0xD9,
+			0x00, 0x13, 0x17, 0xC4, 0xF8, 0x75, 0x98, 0x06, 0x62, 0x9F, 0xCC, 0x46, 0x13, 0x75, 0x21, 0xEE, 0xA9, 0x2C, 0xD2, 0x39,
+			0x7C, 0x4D, 0xF2, 0x13, 0xF4, 0x19, 0x34, 0x6E, 0x90, 0xE2, 0x20, 0xF8, 0x23, 0x1C, 0x21, 0x5F, 0xB2, 0x6C, 0x0A, 0xDE,
+			0x1A, 0x55, 0x26, 0xC3, 0x8F, 0xB5, 0xB5, 0x35, 0xF2, 0x22, 0x65, 0x93, 0xCB, 0x53, 0x86, 0xC1, 0xA2, 0x5A, 0xA5, 0x38,
+			0x4B, 0xA7, 0xD3, 0x4C, 0x1A, 0x08, 0xA1, 0x80, 0xF9, 0x91, 0x48, 0xA4, 0xD1, 0x68, 0x14, 0x8B, 0x45, 0x88, 0x30, 0xE2,
+			0xD1, 0x92, 0xA2, 0x16, 0xAB, 0x1E, 0x58, 0x73, 0x03, 0xC0, 0xC7, 0x1C, 0x8F, 0x69, 0xB5, 0x7C, 0xD9, 0xA8, 0x0D, 0x65,
+			0x05, 0xEE, 0xA2, 0xD0, 0x14, 0x4E, 0x9E, 0x36, 0xBE, 0x92, 0xCC, 0x39, 0x04, 0xBC, 0x21, 0xB9, 0x3B, 0x09, 0x44, 0x3E,
+			0x3A, 0x3A, 0x52, 0x0F, 0x81, 0x30, 0x0A, 0x1C, 0x9C, 0x46, 0x4F, 0x9E, 0x82, 0xF3, 0xF9, 0xFC, 0xF0, 0xF0, 0x10, 0x14,
+			0x9E, 0x59, 0x1F, 0x59, 0x8A, 0x9C, 0xC9, 0xF6, 0x38, 0x71, 0xBC, 0xCE, 0x9D, 0x3B, 0x27, 0xFD, 0x61, 0x2E, 0x97, 0x83,
+			0x85, 0x42, 0x37, 0x84, 0x27, 0x0C, 0x46, 0x02, 0x80, 0x89, 0xEC, 0x81, 0x1B, 0x8F, 0xC7, 0xAD, 0x56, 0xEB, 0xA6, 0x9B,
+			0x6E, 0x9A, 0x4E, 0xA7, 0x2F, 0xBE, 0xF8, 0xE2, 0xA5, 0x4B, 0x97, 0x38, 0x31, 0x14, 0x0E, 0xF9, 0x7C, 0x9E, 0xCD, 0xC0,
+			0x95, 0x4A, 0x85, 0x81, 0xC1, 0xFA, 0xFA, 0xFA, 0xEE, 0xEE, 0xEE, 0xCE, 0xCE, 0xCE, 0xE1, 0xE1, 0x21, 0x82, 0x83, 0xA3,
+			0xA3, 0x23, 0xB6, 0x97, 0x74, 0x3A, 0x9D, 0xEB, 0xD7, 0xAF, 0x9F, 0x3F, 0x7F, 0x9E, 0x86, 0x89, 0xC7, 0x6A, 0x82, 0x43,
+			0xE7, 0xE4, 0x25, 0x8F, 0x2A, 0xDC, 0xAC, 0x28, 0x2B, 0x46, 0xA3, 0x51, 0x3E, 0x9F, 0xC7, 0xE1, 0xFD, 0xB5, 0x6F, 0xBC,
+			0xE5, 0xE6, 0x54, 0xB1, 0xE1, 0x64, 0xBE, 0x48, 0x51, 0x2B, 0x14, 0xBA, 0xE9, 0x74, 0x16, 0x5A, 0x04, 0x5F, 0xC0, 0xF2,
+			0xBF, 0x5B, 0xF6, 0x3D, 0x18, 0x4E, 0xA6, 0x5E, 0x3C, 0x9E, 0x8A, 0x85, 0xD6, 0x3B, 0x1B, 0xA6, 0x19, 0x99, 0x77, 0x2D,
+			0x6A, 0x27, 0xC7, 0xE3, 0xD1, 0xCC, 0x8B, 0x61, 0xD8, 0x90, 0x4C, 0xC6, 0x17, 0xC6, 0xEB, 0x43, 0x6B, 0x11, 0xC6, 0x63,
+			0x0B, 0xD0, 0x11, 0xCF, 0x0F, 0x27, 0x33, 0xDF, 0x5F, 0x40, 0xBA, 0x93, 0xA9, 0xD1, 0x40, 0x4F, 0x7A, 0xA6, 0x2B, 0x76,
+			0x73, 0xA7, 0xAE, 0xDA, 0x9D, 0xD9, 0xDC, 0x32, 0x6E, 0x24, 0x96, 0x8A, 0x46, 0x7D, 0xCF, 0x9B, 0x68, 0xF7, 0x48, 0x3F,
+			0xB0, 0x77, 0x63, 0x8F, 0x2B, 0x18, 0x79, 0x9E, 0x57, 0xF9, 0xBD, 0x4B, 0x12, 0x6C, 0xA3, 0x5B, 0xE1, 0xE2, 0x51, 0x19,
+			0x31, 0x43, 0x63, 0x3C, 0x03, 0xE7, 0x1D, 0x60, 0x14, 0xF8, 0xC5, 0xEC, 0xA7, 0x9A, 0xAF, 0xDF, 0xF4, 0x91, 0x2F, 0x20,
+			0x0B, 0xFA, 0xC3, 0xED, 0x6D, 0x30, 0x43, 0x28, 0x09, 0xE0, 0xF5, 0xF8, 0x0B, 0x89, 0x81, 0x8E, 0x41, 0x2E, 0x04, 0xAD,
+			0xE9, 0x74, 0x5A, 0xAD, 0x56, 0xDF, 0xF9, 0xD9, 0xD7, 0x7E, 0xEF, 0xE6, 0xC7, 0x31, 0xB9, 0x3D, 0x3C, 0x3C, 0x64, 0x9C,
+			0xE3, 0x3E, 0xCB, 0xC2, 0x53, 0x0C, 0xDE, 0x24, 0x77, 0x15, 0x1F, 0x76, 0xD9, 0xAF, 0xC6, 0x4F, 0xFF, 0xE1, 0x8F, 0xFF,
+			0xF8, 0xF7, 0xD9, 0xF6, 0xFC, 0xC2, 0xE4, 0xDA, 0xE7, 0x12, 0x17, 0x89, 0x3E, 0xD8, 0x6C, 0x25, 0xEF, 0x4D, 0x7E, 0xBD,
+			0xFD, 0xEC, 0x7F, 0xD9, 0xFE, 0x8F, 0x8D, 0x46, 0xE3, 0x85, 0xC9, 0xB5, 0xCF, 0x27, 0xEF, 0xB7, 0x03, 0x33, 0xFD, 0xC1,
+			0x53, 0x9B, 0x4F, 0x62, 0xFA, 0x9D, 0xC9, 0x64, 0xBE, 0xD7, 0x7F, 0xF9, 0x92, 0x7F, 0x2E, 0x7F, 0x6F, 0x1E, 0xA5, 0x4F,
+			0xE6, 0x93, 0x19, 0x5E, 0x6D, 0x21, 0x58, 0xB9, 0x3D, 0xF5, 0xFD, 0xF0, 0xB5, 0x8B, 0xD3, 0xBB, 0xD8, 0x7D, 0xE4, 0x5F,
+			0xB4, 0xDD, 0xA8, 0xA3, 0xE9, 0xA8, 0x78, 0x5A, 0x4C, 0xDC, 0x97, 0xB0, 0x41, 0xC8, 0xFD, 0xE3, 0x1F, 0xBE, 0x7E, 0xE3,
+			0x9E, 0x7B, 0x6E, 0xEF, 0x7D, 0xBC, 0x37, 0x0B, 0x66, 0xD7, 0x3E, 0x6D, 0x85, 0x82, 0xD3, 0x1B, 0xDF, 0x9A, 0x1C, 0x26,
+			0xE7, 0x77, 0xCD, 0xA3, 0xD3, 0xE8, 0xE9, 0xAD, 0xA7, 0xE1, 0x38, 0x4C, 0xDB, 0x9E, 0xCA, 0xF4, 0x78, 0x3C, 0xFE, 0xC6,
+			0xF1, 0x73, 0x9F, 0x1B, 0x5F, 0xAC, 0x7A, 0xD5, 0xBA, 0x5F, 0xFF, 0xB5, 0x7F, 0xB8, 0xBA, 0xF9, 0x0B, 0x4E, 0x8E, 0x0C,
+			0x68, 0xE5, 0x06, 0xBA, 0xCC, 0x6E, 0x0B, 0xD6, 0x96, 0x7C, 0x64, 0x09, 0x49, 0x8A, 0xBF, 0xF2, 0xAB, 0x51, 0x03, 0xEA,
+			0x8E, 0xCD, 0x62, 0x8F, 0x23, 0x2C, 0x0C, 0x15, 0x4F, 0xFC, 0x83, 0x26, 0x4F, 0xAB, 0x1E, 0x0F, 0xFC, 0x0A, 0xDE, 0xCF,
+			0x87, 0x30, 0x22, 0xEA, 0x6E, 0x91, 0x0E, 0xB4, 0xE9, 0x01, 0x73, 0x63, 0xE0, 0x07, 0x52, 0x85, 0x26, 0x49, 0x80, 0x4B,
+			0x5A, 0x05, 0xA1, 0x21, 0x84, 0x14, 0x48, 0xC8, 0x09, 0x3B, 0x9D, 0x0E, 0xDA, 0x0E, 0xD0, 0x60, 0x70, 0x3C, 0x90, 0x71,
+			0xDC, 0x2A, 0xB5, 0xCB, 0x1E, 0x71, 0x32, 0xB2, 0x2C, 0x26, 0xB1, 0xAB, 0x6B, 0xCF, 0x19, 0x0B, 0x41, 0xF8, 0x06, 0xB7,
+			0x21, 0xE0, 0xB8, 0xE7, 0xE7, 0xE9, 0x9D, 0x17, 0x0A, 0x05, 0xD3, 0xBE, 0xAD, 0xD9, 0xEE, 0x75, 0x71, 0x43, 0x5C, 0x34,
+			0x8D, 0x58, 0xB1, 0xE5, 0x80, 0x01, 0xC6, 0x93, 0x08, 0x89, 0xA0, 0x2A, 0x71, 0x9E, 0xF9, 0x13, 0x0A, 0x47, 0x36, 0xA6,
+			0x91, 0x3C, 0x00, 0xD3, 0x80, 0x9E, 0x08, 0xB9, 0xF8, 0xDC, 0xC1, 0x5E, 0xE5, 0xDB, 0x87, 0xD1, 0x47, 0x94, 0x66, 0xCE,
+			0x47, 0x1A, 0xD0, 0x7E, 0x05, 0x60, 0x15, 0x2D, 0x9B, 0x04, 0x9E, 0x21, 0x1F, 0x80, 0x19, 0xC0, 0xDC, 0xB5, 0x1A, 0x71,
+			0x30, 0x58, 0xCC, 0x7F, 0xB0, 0x30, 0x23, 0x87, 0xA3, 0x61, 0xA3, 0x2A, 0xA7, 0xB4, 0x3F, 0x3C, 0x3C, 0xA4, 0x49, 0x24,
+			0x9A, 0x68, 0x1B, 0xB0, 0x48, 0x45, 0x99, 0x4C, 0xA6, 0x54, 0x2A, 0xA1, 0x4C, 0x2B, 0x95, 0x4A, 0x8D, 0x46, 0x83, 0xF2,
+			0x5F, 0xE7, 0xEC, 0xE4, 0xE4, 0x84, 0x99, 0x89, 0x3D, 0x42, 0xCF, 0xE3, 0xAA, 0xF0, 0x3E, 0xC8, 0x10, 0xB8, 0xDF, 0xF1,
+			0x31, 0x48, 0x62, 0x6C, 0xDB, 0xE1, 0x7C, 0xD8, 0x7F, 0xB3, 0x04, 0x34, 0x44, 0x3F, 0xB0, 0x58, 0xE9, 0xDA, 0x19, 0x4C,
+			0x13, 0x41, 0xDC, 0x4E, 0x4E, 0x4E, 0xD8, 0x0B, 0x58, 0x2A, 0x95, 0x60, 0x37, 0x2A, 0x99, 0xF1, 0x19, 0xE5, 0x8E, 0x24,
+			0x0B, 0x6B, 0x8B, 0x9E, 0x4B, 0x61, 0x34, 0x04, 0x24, 0x30, 0x1F, 0x98, 0x4B, 0xB4, 0x81, 0xA8, 0x7F, 0x2D, 0xD8, 0xB9,
+			0x94, 0xD0, 0x6A, 0xB5, 0xB2, 0xD9, 0x6C, 0xBD, 0x5E, 0xEF, 0xF7, 0xFB, 0x28, 0x9C, 0xA1, 0x3F, 0x6A, 0x2B, 0x0E, 0xC8,
+			0x5D, 0xBD, 0x5E, 0x87, 0x21, 0x83, 0x8F, 0x8D, 0xFC, 0x0C, 0x18, 0x90, 0x08, 0x61, 0xE0, 0xBA, 0xAE, 0x3A, 0x39, 0xAB,
+			0x53, 0x93, 0x0C, 0x42, 0x9D, 0x38, 0x55, 0x2D, 0x8E, 0x6F, 0x6A, 0xA1, 0xB8, 0xA5, 0x32, 0x81, 0x01, 0x08, 0x82, 0xD8,
+			0xCA, 0xE5, 0x57, 0x65, 0x27, 0xF6, 0x37, 0x77, 0x9E, 0xFA, 0x08, 0xDD, 0x1C, 0x5D, 0xB0, 0x2A, 0x38, 0x72, 0xAD, 0x76,
+			0xE0, 0x68, 0x43, 0x80, 0xB2, 0xB5, 0xFC, 0xB0, 0x82, 0x20, 0x60, 0x03, 0x7B, 0xB3, 0xD9, 0x04, 0xDE, 0x01, 0xBA, 0xE1,
+			0x1A, 0xB3, 0x5A, 0xCF, 0x6A, 0x7F, 0x27, 0xE7, 0xE6, 0x51, 0x4C, 0xA7, 0xD3, 0xAD, 0xAD, 0x2D, 0x38, 0xDD, 0x7C, 0x70,
+			0xA8, 0xD3, 0x72, 0x61, 0xC4, 0x77, 0x96, 0x06, 0x56, 0x1C, 0x76, 0x01, 0x0B, 0xDB, 0xDB, 0xDB, 0x84, 0x7B, 0xAE, 0x37,
+			0x0E, 0xE1, 0x34, 0xC8, 0x5B, 0x5B, 0x5B, 0x20, 0xCE, 0xA8, 0xE4, 0x33, 0xEE, 0x87, 0xD3, 0x82, 0x93, 0x3B, 0x94, 0x09,
+			0x16, 0xBA, 0x01, 0x7E, 0x56, 0xAB, 0x55, 0x28, 0x4C, 0x8F, 0x65, 0x1E, 0x44, 0xC1, 0x8E, 0x77, 0x08, 0x2B, 0x0D, 0x10,
+			0x94, 0xA5, 0x52, 0xA9, 0xFF, 0xBC, 0xF5, 0x25, 0xC6, 0x4E, 0x5F, 0x2A, 0x3E, 0x1A, 0x8F, 0xC7, 0x5F, 0x89, 0xFC, 0x88,
+			0xBD, 0x1C, 0xD9, 0x37, 0x12, 0xAC, 0x90, 0x84, 0xEF, 0x51, 0x2A, 0x95, 0x98, 0xCB, 0x41, 0x35, 0x2E, 0x95, 0x4A, 0x0F,
+			0xCC, 0xCF, 0xE2, 0x14, 0xD4, 0xE9, 0x74, 0x2E, 0xCF, 0x5E, 0xCD, 0xE5, 0x72, 0x3F, 0xCE, 0xBF, 0x75, 0xBD, 0xF0, 0xCB,
+			0x66, 0xB3, 0xF9, 0xCD, 0x93, 0xE7, 0xA1, 0x6C, 0x63, 0x49, 0x8B, 0x71, 0xF4, 0x25, 0xFF, 0xDC, 0x3D, 0xBD, 0x4F, 0x71,
+			0xB7, 0x4F, 0x4F, 0x4F, 0x9F, 0x1F, 0x5F, 0x05, 0x95, 0xE6, 0x63, 0x06, 0x41, 0xF0, 0x17, 0xB5, 0x2F, 0xE2, 0x53, 0xFB,
+			0xD2, 0xFC, 0x75, 0x4D, 0x6B, 0x21, 0x86, 0x4A, 0x96, 0xCF, 0xA9, 0x06, 0x11, 0x02, 0x19, 0x90, 0x7A, 0x9C, 0xB9, 0xB1,
+			0x9C, 0x21, 0x08, 0xCA, 0xDC, 0x7D, 0xF9, 0x7E, 0x93, 0x09, 0x70, 0xF6, 0x16, 0x95, 0x03, 0x26, 0x1E, 0x40, 0x90, 0xFA,
+			0x03, 0x51, 0x0C, 0xD4, 0x16, 0x2B, 0x64, 0x33, 0x78, 0x14, 0xAD, 0x48, 0xFB, 0x20, 0xC5, 0x0B, 0x12, 0x36, 0xC5, 0x9F,
+			0xA0, 0x17, 0x11, 0x05, 0xDE, 0x3A, 0x60, 0x57, 0x80, 0x42, 0x0C, 0xC5, 0x1C, 0x4D, 0x7B, 0xD0, 0xE0, 0x20, 0x04, 0x41,
+			0xC0, 0x2A, 0x11, 0x26, 0xE4, 0x70, 0xF0, 0xD8, 0xD7, 0x8D, 0x91, 0x27, 0x73, 0x11, 0x74, 0x06, 0xC0, 0x12, 0xE8, 0xAE,
+			0x21, 0xDA, 0x52, 0x19, 0x68, 0xCD, 0x38, 0x3C, 0x72, 0xC9, 0xF2, 0xA1, 0x36, 0xA0, 0xBF, 0xE5, 0xEB, 0x00, 0x11, 0x01,
+			0xF4, 0x5E, 0xBD, 0xC8, 0x00, 0x35, 0x50, 0x4E, 0xA8, 0x8F, 0x21, 0xCA, 0x73, 0x5F, 0xF8, 0x13, 0x8C, 0x12, 0x78, 0x59,
+			0x0A, 0x3A, 0x11, 0x3D, 0x24, 0x75, 0xC6, 0xCB, 0x16, 0xE6, 0x2E, 0x73, 0x26, 0x52, 0x05, 0x47, 0x5A, 0x4F, 0x89, 0xD8,
+			0xC5, 0x28, 0x98, 0xDC, 0xC3, 0xEC, 0x93, 0xF3, 0x49, 0x49, 0x4D, 0x86, 0x60, 0xAA, 0xC1, 0x93, 0x47, 0x9A, 0xCA, 0xAF,
+			0x06, 0x38, 0x35, 0xDA, 0xA8, 0xB5, 0x60, 0x6E, 0x6C, 0xD2, 0x68, 0x34, 0x28, 0xE1, 0x29, 0x9D, 0xE8, 0xA6, 0x19, 0x46,
+			0x83, 0x72, 0x30, 0x34, 0x43, 0x83, 0x03, 0x0E, 0x25, 0xAB, 0x77, 0xBE, 0x42, 0x1C, 0xB4, 0xA3, 0xD1, 0x28, 0xE6, 0xD2,
+			0xB0, 0x95, 0xA9, 0x19, 0x59, 0xA3, 0x23, 0x07, 0x41, 0x1C, 0x54, 0xA8, 0x89, 0xC0, 0xE6, 0x60, 0x56, 0xD1, 0xA2, 0x62,
+			0x08, 0xC3, 0x01, 0xC2, 0x1F, 0xD5, 0x42, 0xB8, 0xE3, 0xD5, 0x40, 0x4F, 0xE2, 0x3D, 0x50, 0x47, 0x03, 0xF9, 0x11, 0xB0,
+			0xB4, 0xFA, 0xC3, 0x95, 0x44, 0xF6, 0xE0, 0x70, 0xBA, 0xE6, 0x74, 0x22, 0x07, 0x85, 0x75, 0xEA, 0x79, 0x1E, 0xCE, 0xEC,
+			0x7A, 0xB8, 0x0C, 0x21, 0xE5, 0xF0, 0x07, 0x85, 0x0E, 0x1B, 0x22, 0x31, 0x73, 0x78, 0x7D, 0x1B, 0x93, 0xBA, 0xE1, 0x0F,
+			0xDA, 0x3C, 0x82, 0x0B, 0xF1, 0x14, 0xF5, 0x32, 0x92, 0x37, 0xBE, 0x6F, 0x66, 0x1B, 0x8C, 0x8B, 0x91, 0xE4, 0x48, 0x1B,
+			0x81, 0x6D, 0x9C, 0xB5, 0x41, 0x4E, 0xB5, 0x9B, 0x8A, 0x98, 0xF1, 0xF2, 0x7A, 0xCE, 0x56, 0xF8, 0x66, 0x62, 0xE1, 0x7E,
+			0x37, 0x6C, 0x0E, 0xA3, 0xD9, 0xB8, 0xC9, 0x71, 0x73, 0x09, 0xAF, 0x35, 0x8A, 0x65, 0x62, 0x61, 0xD2, 0x37, 0xA5, 0xE2,
+			0x7E, 0xD7, 0x86, 0xBA, 0x88, 0x7E, 0xD3, 0xD1, 0xD9, 0xBF, 0xB7, 0xC7, 0xA9, 0xC8, 0x42, 0x4B, 0x7C, 0x70, 0x3A, 0x47,
+			0x3C, 0x5C, 0x4C, 0x47, 0x73, 0x09, 0xAF, 0x6D, 0x82, 0x4D, 0x9B, 0xEE, 0x66, 0x62, 0x61, 0x6B, 0x14, 0x3B, 0xEC, 0xFB,
+			0x0C, 0x7B, 0x13, 0x9E, 0x39, 0x39, 0xAF, 0x65, 0x62, 0x49, 0x7F, 0x62, 0x83, 0xDC, 0x41, 0x64, 0xF7, 0x64, 0xBA, 0x96,
+			0x89, 0xC5, 0xE7, 0x86, 0x84, 0xFC, 0x7B, 0x7B, 0xBC, 0x96, 0x89, 0x15, 0x52, 0x91, 0xF5, 0x5C, 0x62, 0xBF, 0x1B, 0x1E,
+			0x9C, 0x2E, 0xDE, 0x43, 0x3E, 0xE9, 0x1F, 0xF6, 0xFD, 0x74, 0x74, 0x96, 0x4F, 0xFA, 0x09, 0x6F, 0x7C, 0x70, 0x3A, 0xAF,
+			0xF7, 0x3C, 0xF1, 0x3A, 0xD6, 0xD7, 0xD7, 0xAB, 0xD5, 0x2A, 0x87, 0x8F, 0xB9, 0x19, 0x04, 0x5C, 0x74, 0x2E, 0x4A, 0x39,
+			0xAC, 0x91, 0xE0, 0xFC, 0xB1, 0x77, 0x5A, 0x4E, 0x1E, 0xBD, 0x5E, 0x8F, 0xA1, 0x4B, 0x36, 0x9B, 0x45, 0x28, 0x8B, 0x46,
+			0x01, 0x44, 0x91, 0x7C, 0x03, 0x4F, 0x91, 0x1B, 0x8B, 0x2E, 0x8C, 0xB9, 0x6B, 0xB1, 0x58, 0xC4, 0x80, 0x13, 0xED, 0xBA,
+			0x66, 0x8F, 0xF0, 0xEE, 0xD9, 0x29, 0xEF, 0xB4, 0x41, 0x6B, 0xC5, 0x62, 0x91, 0x4D, 0x2C, 0x10, 0x16, 0x00, 0x1E, 0x31,
+			0x9D, 0x67, 0x84, 0xC8, 0x88, 0xFE, 0xF4, 0xF4, 0x14, 0x33, 0x57, 0x18, 0x26, 0xFD, 0x7E, 0x9F, 0x96, 0xD4, 0xF7, 0xFD,
+			0xE7, 0x82, 0x2B, 0x9E, 0xE7, 0xDD, 0x3F, 0xBB, 0x5B, 0xE4, 0xB1, 0x87, 0xA3, 0xE7, 0x01, 0x91, 0xF2, 0xF9, 0xFC, 0x3F,
+			0xEE, 0x7F, 0x33, 0x12, 0x89, 0x5C, 0x8B, 0xDF, 0x78, 0x61, 0x72, 0xED, 0xB9, 0xE0, 0x0A, 0x41, 0x90, 0xD6, 0x2D, 0x9F,
+			0xCF, 0x9F, 0xED, 0xDF, 0x32, 0x1A, 0x8D, 0x3E, 0x97, 0xB8, 0xF8, 0xE9, 0xD6, 0x9F, 0xA0, 0xFC, 0x22, 0x46, 0xF3, 0x0E,
+			0x7D, 0xDF, 0x67, 0x23, 0x0D, 0x63, 0x40, 0xAA, 0x99, 0x07, 0xBD, 0xCF, 0x10, 0xB9, 0xB4, 0xE7, 0x44, 0x46, 0xF3, 0x0F,
+			0x45, 0xEE, 0x15, 0x45, 0x07, 0x27, 0x48, 0xED, 0xF3, 0x59, 0xE0, 0x84, 0x4B, 0x9A, 0x0D, 0x53, 0x1C, 0xAD, 0xE5, 0xC2,
+			0x4F, 0x46, 0x48, 0xB7, 0x40, 0x21, 0xFE, 0x44, 0x6C, 0x31, 0x49, 0x43, 0xA0, 0x5D, 0x88, 0xCB, 0x27, 0xF2, 0xA8, 0x8A,
+			0x33, 0x0D, 0x0E, 0x85, 0xAC, 0xE2, 0x67, 0xC0, 0x7F, 0x4B, 0x96, 0xE2, 0x3F, 0xA7, 0x4C, 0x91, 0x88, 0x4C, 0xC8, 0x3E,
+			0x6B, 0x5C, 0xE1, 0x68, 0xE0, 0xEE, 0x45, 0x40, 0xA4, 0x75, 0x86, 0xF8, 0x07, 0x34, 0xA7, 0x41, 0x97, 0x8D, 0x62, 0xDD,
+			0x57, 0x43, 0x61, 0x07, 0x9D, 0x86, 0x99, 0x13, 0x6E, 0xBE, 0xD4, 0x16, 0xA6, 0xBC, 0x2F, 0x14, 0x28, 0x4F, 0x69, 0xF4,
+			0x21, 0xB0, 0x28, 0x86, 0x8A, 0xB7, 0x8D, 0x46, 0x4C, 0x36, 0xC0, 0x94, 0x68, 0xD0, 0x34, 0xA5, 0x42, 0xA0, 0xB4, 0x15,
+			0x19, 0x1F, 0x37, 0x2A, 0x4E, 0x14, 0x7B, 0x7B, 0x90, 0xA6, 0x33, 0xAF, 0x46, 0x3D, 0x8A, 0x07, 0xDC, 0xAA, 0xFC, 0x5B,
+			0x47, 0x9A, 0xDA, 0x17, 0xAF, 0x62, 0x9E, 0x0C, 0x0E, 0x1C, 0x80, 0x5D, 0xE2, 0x85, 0x23, 0x97, 0xE1, 0x75, 0x64, 0x5C,
+			0x16, 0x8F, 0xC7, 0xAB, 0xD5, 0x2A, 0x24, 0xD1, 0xF5, 0xF5, 0x75, 0xC6, 0xBF, 0xAC, 0x57, 0x23, 0x15, 0xA9, 0x1B, 0x80,
+			0x8A, 0x2D, 0xDD, 0x35, 0x38, 0x04, 0x3B, 0x61, 0x0C, 0x28, 0x60, 0x20, 0x26, 0xFE, 0x1F, 0x03, 0x28, 0x3E, 0xE1, 0xC0,
+			0xFD, 0xF0, 0xDF, 0xB0, 0xEE, 0x15, 0x69, 0x1C, 0xE2, 0x2F, 0x59, 0x4B, 0xB3, 0x49, 0x06, 0xB5, 0x1B, 0xAB, 0x96, 0x15,
+			0x31, 0x29, 0x5B, 0x64, 0x0F, 0x80, 0xCB, 0x95, 0x78, 0x3B, 0xEC, 0x56, 0x66, 0x40, 0xC7, 0x4D, 0x93, 0xA5, 0x38, 0x6D,
+			0x9D, 0xDA, 0x16, 0xFB, 0x0E, 0x5D, 0x96, 0x66, 0x50, 0xCC, 0x08, 0x88, 0x59, 0x34, 0xC0, 0x02, 0x2E, 0x31, 0xC9, 0x64,
+			0xB2, 0xD1, 0x68, 0x34, 0x9B, 0x4D, 0xCE, 0xD3, 0x74, 0x3A, 0xDD, 0xDD, 0xDD, 0xE5, 0x3C, 0x51, 0x64, 0x21, 0xE8, 0x88,
+			0x2E, 0x7F, 0xAC, 0x89, 0x73, 0x50, 0xA3, 0x24, 0x60, 0xB4, 0x6F, 0x0E, 0x01, 0x4F, 0xE3, 0xB9, 0x6A, 0xB6, 0xA6, 0x2E,
+			0xBE, 0xB0, 0x6F, 0x8B, 0xA8, 0x61, 0xF8, 0xBB, 0xCB, 0xDE, 0x30, 0x8E, 0x39, 0x1F, 0x94, 0x27, 0x94, 0xB1, 0x14, 0x05,
+			0xE0, 0xB9, 0xBC, 0x0E, 0xB2, 0x09, 0x5E, 0xE4, 0xE8, 0xE8, 0x08, 0xA8, 0x8A, 0x84, 0x4C, 0xF1, 0x48, 0x24, 0x85, 0x56,
+			0x44, 0x67, 0x20, 0x2E, 0x9D, 0x4A, 0x27, 0x95, 0x6F, 0xB2, 0x59, 0x96, 0xF3, 0x14, 0x1F, 0x41, 0x3B, 0x15, 0xA8, 0xEB,
+			0x35, 0xAC, 0xA3, 0x52, 0xE0, 0xC8, 0x52, 0x4D, 0x93, 0xFB, 0x79, 0x05, 0xD5, 0x5C, 0x92, 0x74, 0xA8, 0x75, 0x50, 0x5B,
+			0x40, 0x4C, 0x91, 0xA5, 0x2D, 0xF7, 0x59, 0xBD, 0x0B, 0xAE, 0x0C, 0x3B, 0x3B, 0x3B, 0x94, 0x05, 0xA4, 0x61, 0x78, 0xC3,
+			0x7B, 0x7B, 0x7B, 0xF3, 0xF9, 0x7C, 0x73, 0x73, 0x53, 0xCB, 0xA5, 0xD1, 0xD6, 0x31, 0xA2, 0xA7, 0xBA, 0xE1, 0x88, 0xD3,
+			0x4F, 0x30, 0x46, 0xA2, 0xDD, 0x21, 0x8B, 0xD0, 0x51, 0xD1, 0x1A, 0xB2, 0xE0, 0x34, 0x1E, 0x8F, 0xD7, 0xEB, 0x75, 0xF2,
+			0x04, 0x7F, 0xD3, 0xF3, 0x3C, 0xAE, 0xA2, 0x88, 0xE1, 0xF8, 0x7B, 0x4B, 0xD6, 0x80, 0xFE, 0x8B, 0xCB, 0x40, 0x44, 0xA3,
+			0x6B, 0x94, 0xB5, 0x75, 0x26, 0x93, 0xF9, 0x7A, 0xFB, 0xD9, 0x6E, 0xB7, 0x8B, 0xDE, 0xE5, 0x46, 0xF1, 0xED, 0x5E, 0xAF,
+			0xF7, 0x64, 0xE1, 0x11, 0x88, 0xBF, 0xD9, 0x6C, 0x16, 0x73, 0xA7, 0xCF, 0x86, 0xF7, 0x20, 0xF2, 0xEA, 0xF5, 0x7A, 0x9C,
+			0x70, 0x82, 0x08, 0x12, 0xBF, 0xC7, 0xB3, 0x97, 0x5A, 0xAD, 0xD6, 0xD9, 0xFE, 0x2D, 0x5F, 0x48, 0x3F, 0xF0, 0x99, 0xC1,
+			0xA7, 0x89, 0xE6, 0x79, 0xF7, 0xD3, 0x6E, 0xB7, 0x89, 0x32, 0xDA, 0x92, 0x48, 0x0D, 0xC4, 0x33, 0xC4, 0x02, 0xEF, 0xB6,
+			0xF6, 0x47, 0xDB, 0xED, 0xF6, 0x33, 0xFD, 0x97, 0x7E, 0x98, 0xF9, 0x19, 0x9A, 0xE1, 0x7E, 0xBF, 0xFF, 0xF3, 0xCA, 0xBF,
+			0x32, 0xF1, 0x23, 0xB5, 0x84, 0x61, 0xF8, 0x9D, 0xDE, 0xF7, 0x59, 0x2D, 0x00, 0x5C, 0x00, 0xCD, 0x54, 0x8B, 0x1E, 0x41,
+			0x5C, 0xB5, 0x0C, 0x44, 0x5A, 0x77, 0xB1, 0xEF, 0x31, 0x57, 0x10, 0x85, 0x54, 0xDE, 0x6D, 0x44, 0x1C, 0xAB, 0x9E, 0x56,
+			0xB0, 0x1D, 0xC0, 0x10, 0x20, 0x38, 0xF0, 0x1C, 0xBE, 0x5C, 0xF5, 0x01, 0xA4, 0x04, 0x09, 0x0E, 0x40, 0x96, 0x68, 0x29,
+			0x20, 0xE4, 0x7C, 0xC8, 0x5A, 0x7C, 0x79, 0xF9, 0x16, 0x42, 0x04, 0x0C, 0x04, 0x99, 0x7E, 0x83, 0x0C, 0xF7, 0xFB, 0xFD,
+			0x76, 0xBB, 0x8D, 0xDC, 0x17, 0x86, 0xA5, 0xE4, 0x38, 0x9A, 0x33, 0x01, 0x89, 0xF0, 0x0F, 0x50, 0x33, 0x09, 0x0E, 0xC2,
+			0x9A, 0x00, 0x8D, 0x61, 0x1E, 0x72, 0xA7, 0x30, 0xAC, 0x45, 0xA3, 0x0E, 0x92, 0x4C, 0x3E, 0x00, 0x2A, 0xC0, 0xC3, 0x47,
+			0xA2, 0x62, 0x4C, 0x14, 0x48, 0x27, 0xDA, 0xD3, 0x72, 0x7A, 0x7A, 0xCA, 0x28, 0x94, 0x6B, 0x22, 0x6B, 0x6B, 0x8C, 0x6D,
+			0xB4, 0xEC, 0x97, 0xDB, 0x47, 0xE3, 0x22, 0x4C, 0x8C, 0x05, 0x35, 0x00, 0x53, 0x72, 0xB8, 0xD1, 0xCC, 0x00, 0x80, 0x9A,
+			0xD4, 0x42, 0x91, 0x14, 0x89, 0x44, 0x18, 0x4A, 0x83, 0x37, 0xC0, 0x9B, 0x90, 0xCD, 0x3B, 0xE6, 0x19, 0x90, 0x6B, 0x88,
+			0x9C, 0xEC, 0xB8, 0xE5, 0x1E, 0x31, 0x77, 0x81, 0x28, 0x01, 0x89, 0x51, 0x3A, 0x1E, 0xF0, 0x12, 0x9A, 0xF5, 0xC5, 0x56,
+			0x6D, 0xED, 0xB2, 0x61, 0xC1, 0x37, 0x61, 0x91, 0x64, 0xAB, 0x75, 0xBB, 0xC0, 0xBB, 0xF4, 0x32, 0x12, 0x49, 0x73, 0x9E,
+			0x36, 0x36, 0x36, 0x74, 0x92, 0x50, 0xBB, 0xD1, 0xBC, 0xA8, 0xE3, 0x83, 0xD0, 0x29, 0xBB, 0x12, 0x9C, 0xA4, 0xE8, 0x7C,
+			0xCD, 0x79, 0x26, 0x9D, 0x66, 0x8B, 0x1E, 0x7D, 0xAE, 0xFA, 0x0F, 0x86, 0x7B, 0x87, 0x87, 0x87, 0x9E, 0xE7, 0xB1, 0x76,
+			0x98, 0xFB, 0xCC, 0x56, 0x7A, 0x80, 0x05, 0xCA, 0x9F, 0x66, 0xB3, 0x29, 0xC1, 0xB0, 0x96, 0x93, 0xE0, 0xF7, 0x52, 0xAD,
+			0x56, 0x6B, 0xB5, 0x1A, 0x8B, 0xA5, 0xE4, 0x53, 0xEF, 0xF2, 0x8B, 0x2D, 0x99, 0xEA, 0x74, 0x3A, 0x5B, 0x5B, 0x5B, 0xEE,
+			0x28, 0x5A, 0x6A, 0x65, 0x75, 0x38, 0x29, 0x0A, 0xDF, 0x0C, 0x2A, 0x50, 0xCA, 0x19, 0x18, 0x26, 0xEC, 0xF1, 0x61, 0x74,
+			0x23, 0x76, 0x33, 0xBA, 0x21, 0xC8, 0xAF, 0x78, 0xBF, 0x60, 0xE3, 0x85, 0xA7, 0xE9, 0x74, 0x3A, 0xDD, 0xDB, 0xDB, 0x83,
+			0x73, 0xC6, 0x58, 0x9B, 0x4E, 0x93, 0xFD, 0x12, 0x34, 0xCE, 0x24, 0x67, 0x2A, 0x3B, 0xC2, 0x3D, 0xB5, 0x98, 0xB4, 0x54,
+			0x5C, 0x66, 0x6E, 0x9A, 0x48, 0x90, 0xA4, 0x55, 0xDD, 0x70, 0x6E, 0x32, 0x34, 0x59, 0x9E, 0x36, 0x52, 0x6A, 0x4D, 0xE6,
+			0xB1, 0x03, 0x04, 0x84, 0xE5, 0x7F, 0x0D, 0x0F, 0x5A, 0x4E, 0x71, 0x78, 0xF2, 0xDA, 0x22, 0xC9, 0xF4, 0x58, 0xB5, 0x86,
+			0x5E, 0x7F, 0xB5, 0x70, 0xD3, 0x06, 0x12, 0xAD, 0x9F, 0x66, 0xB6, 0xC6, 0xEF, 0x2D, 0x95, 0x4A, 0x14, 0xE6, 0x40, 0x0A,
+			0xDC, 0x1C, 0xAB, 0xD9, 0xDC, 0x4D, 0xC0, 0x72, 0x43, 0x56, 0x4B, 0xF6, 0x3E, 0xDC, 0xAF, 0x83, 0xB6, 0x4B, 0x6F, 0xCE,
+			0x2A, 0xE9, 0xBD, 0xBD, 0x3D, 0x2D, 0xD5, 0x51, 0xA5, 0x06, 0xFE, 0x6E, 0x43, 0x6C, 0xC7, 0x74, 0x82, 0x6E, 0xC4, 0x20,
+			0x6A, 0x67, 0x67, 0x07, 0x16, 0x3C, 0x85, 0xF0, 0xFA, 0xFA, 0x3A, 0xCD, 0x28, 0x63, 0x03, 0xD2, 0x30, 0x90, 0x2B, 0x71,
+			0x61, 0x6B, 0x6B, 0xEB, 0x7F, 0x79, 0x3F, 0x04, 0xD8, 0xFD, 0xB3, 0x8D, 0xC7, 0xC6, 0xE3, 0xF1, 0xFF, 0x68, 0x7C, 0x67,
+			0x38, 0x1C, 0x22, 0xF1, 0xF5, 0x3C, 0x6F, 0x6F, 0x6F, 0x2F, 0x95, 0x4A, 0x7D, 0xBB, 0xFB, 0x22, 0x23, 0x44, 0x3B, 0x3D,
+			0xCE, 0x2D, 0x83, 0x3B, 0x42, 0xCD, 0xFB, 0x56, 0xED, 0x5D, 0xF2, 0xC1, 0x70, 0x38, 0x2C, 0x95, 0x4A, 0x3B, 0x3B, 0x3B,
+			0x52, 0x77, 0x13, 0xD4, 0x78, 0x03, 0x34, 0x91, 0x07, 0x07, 0x07, 0xEA, 0x57, 0x7E, 0x90, 0xF8, 0x49, 0xFB, 0x36, 0xB3,
+			0x19, 0xCF, 0xE7, 0xF3, 0xCF, 0x8F, 0xAF, 0x32, 0x7B, 0xF3, 0x3C, 0xEF, 0x4F, 0x53, 0x9F, 0x05, 0xBD, 0x85, 0x01, 0x42,
+			0x5B, 0x9C, 0xCF, 0xE7, 0x1F, 0x98, 0x9F, 0xAD, 0xD7, 0xEB, 0x7F, 0xB6, 0xF1, 0xD8, 0xD9, 0xFE, 0x2D, 0x14, 0x01, 0x12,
+			0xB4, 0x73, 0x72, 0xA0, 0xE5, 0xC8, 0xD5, 0x40, 0x72, 0x3F, 0xE1, 0x3C, 0x8C, 0x5B, 0x38, 0x21, 0xDA, 0x36, 0x2A, 0xD0,
+			0x4F, 0xF3, 0x61, 0xB9, 0xA4, 0x10, 0x4D, 0x44, 0xF8, 0x99, 0x2D, 0xBD, 0xE2, 0xF9, 0x75, 0x6E, 0x82, 0x95, 0xE2, 0x84,
+			0x50, 0x4A, 0x83, 0x60, 0x90, 0xDD, 0x55, 0xAC, 0x10, 0xB8, 0x05, 0x37, 0x49, 0x96, 0x0C, 0x0C, 0xC8, 0xAB, 0x31, 0xCE,
+			0x95, 0xBD, 0x18, 0x4C, 0x39, 0x90, 0x3A, 0x94, 0xA1, 0xD0, 0xF9, 0x81, 0xBC, 0xC9, 0xEB, 0x18, 0x16, 0x60, 0x14, 0x88,
+			0x26, 0x89, 0x98, 0xCE, 0x66, 0x91, 0xA1, 0xFB, 0xE1, 0x18, 0x60, 0xD9, 0x0D, 0x55, 0x4F, 0x8B, 0x15, 0x69, 0xEE, 0x15,
+			0x91, 0x58, 0x25, 0x44, 0x94, 0x27, 0x09, 0x81, 0x02, 0x91, 0x00, 0x08, 0x02, 0x0C, 0x51, 0xB5, 0x7E, 0x1D, 0x2A, 0xA0,
+			0x91, 0xB9, 0x1D, 0xCD, 0x06, 0xB9, 0x03, 0x59, 0x13, 0x54, 0x9C, 0xD8, 0x05, 0x11, 0x86, 0xF7, 0x0C, 0x21, 0x18, 0xF4,
+			0x82, 0xC6, 0x91, 0xC6, 0x17, 0x69, 0x14, 0x63, 0x45, 0xAD, 0x12, 0xC3, 0xDD, 0x44, 0xD5, 0x0F, 0x77, 0x99, 0x23, 0x87,
+			0x5A, 0x18, 0xC8, 0x4B, 0xAB, 0xE7, 0x11, 0x07, 0xF0, 0x37, 0xF1, 0xD3, 0x04, 0xCA, 0x16, 0x24, 0x05, 0x0D, 0x87, 0x42,
+			0xDF, 0x52, 0xBE, 0x31, 0xF1, 0xFF, 0xE6, 0x6F, 0xF2, 0xE9, 0x1C, 0xDF, 0xDA, 0x00, 0x00, 0x26, 0xCB, 0x49, 0x44, 0x41,
+			0x54, 0x2B, 0x85, 0x61, 0x2C, 0x16, 0xDB, 0x71, 0x5F, 0x5B, 0xD9, 0x4D, 0x15, 0x98, 0x31, 0x52, 0xE3, 0x18, 0xE7, 0x72,
+			0xE9, 0xE9, 0x8A, 0x69, 0x0C, 0xBD, 0x6D, 0x61, 0x49, 0x5D, 0xB2, 0xD3, 0xE0, 0x72, 0x49, 0x61, 0xC9, 0x1C, 0xB0, 0x97,
+			0xFD, 0xAB, 0xBF, 0x4A, 0xB9, 0x43, 0x90, 0x71, 0x3A, 0x26, 0x1B, 0x24, 0x2C, 0xA3, 0x18, 0x95, 0x3E, 0x4F, 0x81, 0xC7,
+			0x67, 0x90, 0x88, 0x8B, 0xE6, 0x54, 0x10, 0x6B, 0xCB, 0x62, 0x84, 0x03, 0xAD, 0x75, 0x89, 0x66, 0x65, 0xE7, 0x4E, 0xEA,
+			0xF4, 0xBF, 0xFD, 0x83, 0xD0, 0x34, 0x4E, 0x15, 0x26, 0xCC, 0xE3, 0xF1, 0xB8, 0x56, 0xAB, 0x49, 0xCF, 0x49, 0xA3, 0x03,
+			0x5C, 0x88, 0xB4, 0x12, 0x46, 0xD7, 0x74, 0xF9, 0xBB, 0x18, 0x20, 0xD3, 0x27, 0x66, 0x32, 0x99, 0x66, 0xB3, 0x59, 0xAB,
+			0xD5, 0x98, 0x09, 0xF3, 0xD4, 0x44, 0x4E, 0x97, 0x0E, 0x90, 0x71, 0xA5, 0xFC, 0x40, 0x40, 0x21, 0x79, 0x1D, 0x66, 0xD7,
+			0x5A, 0xCB, 0x8E, 0xD5, 0xD4, 0xCE, 0xCE, 0x0E, 0x14, 0x31, 0x7A, 0x08, 0x39, 0x3A, 0xD9, 0xC7, 0xF7, 0xED, 0x72, 0xCE,
+			0xC6, 0x33, 0x6B, 0xF7, 0x22, 0xD1, 0x71, 0x30, 0x93, 0x3F, 0xB8, 0xA3, 0xEB, 0x18, 0x54, 0x1D, 0x86, 0x0B, 0x39, 0x0C,
+			0x98, 0xF8, 0x68, 0x34, 0xF4, 0x7D, 0x2B, 0xC7, 0xA6, 0x5E, 0x18, 0x8B, 0x79, 0x61, 0x24, 0x12, 0xCC, 0xA6, 0xF3, 0xD0,
+			0xE0, 0x4B, 0xDF, 0x77, 0x4F, 0x66, 0x3A, 0xF7, 0xBC, 0xDF, 0x39, 0x82, 0x85, 0x4B, 0xFF, 0x86, 0x4E, 0xDF, 0x9A, 0xA7,
+			0xE1, 0xC4, 0xCE, 0x90, 0xD1, 0x3D, 0x27, 0xD6, 0x19, 0xF8, 0xFE, 0x02, 0xFF, 0x95, 0xF0, 0x64, 0x1E, 0xB5, 0x0B, 0x3C,
+			0x0E, 0xAC, 0x3D, 0x0A, 0x43, 0x5B, 0x52, 0x6F, 0x29, 0x64, 0x6A, 0xDF, 0xFE, 0xCC, 0xF7, 0xBD, 0xC0, 0xA2, 0x00, 0xDF,
+			0x11, 0x0D, 0x38, 0xC2, 0x37, 0xD6, 0xF8, 0x91, 0xB1, 0xE0, 0xB9, 0xCA, 0xDB, 0xC7, 0x7D, 0x16, 0x9B, 0x91, 0x68, 0xA3,
+			0x29, 0x89, 0x53, 0xCB, 0x87, 0x87, 0xC3, 0x61, 0xB9, 0x5C, 0x86, 0x8F, 0x8F, 0x9F, 0x5A, 0xAB, 0xD5, 0x2A, 0x97, 0xCB,
+			0x9B, 0x9B, 0x9B, 0xCD, 0x66, 0x13, 0x6D, 0x01, 0xE5, 0x15, 0x04, 0x67, 0x72, 0xA7, 0xDC, 0x3D, 0xC5, 0x92, 0x96, 0x9A,
+			0x09, 0x59, 0x86, 0x20, 0x41, 0x8C, 0xBD, 0x44, 0xD0, 0x86, 0xD4, 0x01, 0xA9, 0x74, 0x38, 0x1E, 0xA6, 0x0B, 0x36, 0x61,
+			0xC3, 0x33, 0x2E, 0x33, 0xCA, 0x78, 0x3D, 0x13, 0x36, 0xBE, 0xEC, 0xBF, 0x71, 0xFB, 0x07, 0x1F, 0xA3, 0x61, 0x7F, 0x6A,
+			0xF3, 0xC9, 0x66, 0xB3, 0xD9, 0xEB, 0xF5, 0x7E, 0x98, 0xF9, 0xD9, 0x3D, 0x9D, 0x4F, 0xDD, 0x28, 0xBE, 0x7D, 0x21, 0x7D,
+			0x07, 0x9B, 0xE0, 0x26, 0x93, 0xC9, 0x7F, 0xA8, 0xFF, 0x7F, 0xC5, 0x9A, 0xC9, 0x59, 0x5E, 0x9A, 0xBF, 0xFE, 0x40, 0x78,
+			0x96, 0xED, 0x5D, 0x67, 0xF3, 0xB7, 0xFC, 0x20, 0xF1, 0x93, 0x3F, 0x4D, 0x7D, 0xD6, 0xF3, 0xBC, 0xE7, 0xC7, 0x57, 0x1F,
+			0x4D, 0xDE, 0x57, 0xA9, 0x54, 0x8C, 0x32, 0x54, 0xAD, 0x7E, 0xAB, 0xF3, 0xC2, 0x46, 0x66, 0xE3, 0x96, 0xFE, 0x47, 0x3E,
+			0x71, 0xF0, 0x47, 0xFF, 0xF6, 0x6F, 0xBF, 0xF5, 0x3E, 0x65, 0x97, 0xE5, 0x21, 0xEF, 0xDE, 0x7E, 0xCA, 0x4E, 0xC8, 0x23,
+			0xB1, 0x0B, 0x5F, 0x6B, 0x7D, 0xF7, 0x89, 0xDC, 0x43, 0x80, 0xDA, 0x5F, 0x2C, 0x19, 0x5B, 0xF4, 0x81, 0xC2, 0x59, 0x48,
+			0xEB, 0x88, 0xCF, 0x99, 0x31, 0xBC, 0xF5, 0x2F, 0x7B, 0x22, 0x35, 0xC9, 0x2F, 0x4B, 0xE7, 0x44, 0x72, 0x56, 0xF5, 0x9A,
+			0x64, 0x0B, 0x9A, 0x30, 0xE6, 0x04, 0x92, 0xE9, 0xF2, 0x3C, 0xD5, 0x59, 0xEA, 0x4A, 0x0A, 0xB6, 0x5D, 0x7A, 0x8F, 0x5B,
+			0xB7, 0x2A, 0xDB, 0x12, 0xC1, 0x4A, 0x32, 0x6C, 0x67, 0x98, 0x4C, 0xCE, 0xA0, 0x41, 0xA1, 0xE3, 0x81, 0x74, 0x40, 0xC4,
+			0xD0, 0xDE, 0xF9, 0x68, 0x34, 0xFA, 0xCB, 0x93, 0xEB, 0x14, 0x82, 0xA6, 0x39, 0xED, 0x06, 0xF1, 0xA1, 0xCD, 0xAB, 0xE2,
+			0x5E, 0x3C, 0x38, 0x0D, 0xE4, 0x8F, 0x66, 0xA5, 0xCF, 0x6C, 0x1A, 0xEB, 0xC6, 0x8C, 0xB6, 0x70, 0x62, 0xD1, 0x69, 0x7C,
+			0x6C, 0xF8, 0xA6, 0xDA, 0xD3, 0x79, 0x60, 0x8D, 0x2F, 0xCE, 0x2B, 0x16, 0x97, 0x63, 0xFE, 0xFF, 0x69, 0x2C, 0x88, 0xE3,
+			0xEF, 0x06, 0x6F, 0x45, 0x26, 0x91, 0xF0, 0x74, 0xB1, 0x0C, 0x20, 0x3C, 0x5A, 0x74, 0xD5, 0xFE, 0xC4, 0x3E, 0xB8, 0x85,
+			0xF2, 0x30, 0x61, 0x9F, 0x2E, 0x32, 0x7F, 0xBF, 0x6D, 0xEF, 0x6D, 0x16, 0x31, 0x52, 0xD9, 0xDC, 0xAE, 0x8F, 0xB7, 0xD7,
+			0xB1, 0x2C, 0x68, 0xAC, 0xBC, 0x43, 0x7B, 0xFD, 0x0F, 0x8E, 0x0D, 0xD8, 0x18, 0x06, 0xC3, 0xC4, 0x3C, 0x11, 0xB6, 0x8D,
+			0xB1, 0xB2, 0x59, 0x99, 0x7F, 0xEB, 0x85, 0xF7, 0x5C, 0x9C, 0xDC, 0x13, 0xDD, 0x80, 0xA0, 0xBC, 0xFC, 0xBC, 0x36, 0x08,
+			0xA4, 0x4A, 0x93, 0x47, 0x5E, 0x18, 0xB6, 0x56, 0x1F, 0xF2, 0x2A, 0xDB, 0x8A, 0x21, 0xDF, 0xF2, 0x5B, 0xB3, 0xBF, 0xF6,
+			0x8F, 0xDF, 0xFC, 0x25, 0xED, 0xA9, 0x9B, 0x28, 0x2C, 0x9C, 0xFD, 0x47, 0xA3, 0xDD, 0xE5, 0xDE, 0x5C, 0x9B, 0xA7, 0x32,
+			0x2B, 0x45, 0x16, 0x47, 0x0E, 0x48, 0xA7, 0xDB, 0xF2, 0x34, 0x05, 0xA9, 0x73, 0xE9, 0xC4, 0x08, 0xF4, 0x4A, 0x9F, 0xD6,
+			0x53, 0xFD, 0xF6, 0x2F, 0xFF, 0x92, 0xFF, 0x1B, 0x7E, 0x95, 0xA8, 0xBB, 0xE6, 0x58, 0xE4, 0x2E, 0x33, 0xA7, 0x8A, 0xF9,
+			0xCC, 0xC9, 0xC9, 0xC9, 0xCE, 0xDF, 0xFF, 0xFD, 0xF1, 0xDF, 0xFE, 0xAD, 0x75, 0x09, 0x4B, 0x93, 0x77, 0x28, 0x50, 0xA0,
+			0x4E, 0x88, 0x17, 0x48, 0x5F, 0x42, 0x24, 0xF9, 0x07, 0xCB, 0xC5, 0x6E, 0xD0, 0x81, 0x45, 0x35, 0x6F, 0x1A, 0x7E, 0x8E,
+			0xAC, 0x20, 0x46, 0xA3, 0xD1, 0xD6, 0xDF, 0xFD, 0xDD, 0xC1, 0x5F, 0xFF, 0x35, 0x74, 0x05, 0x5A, 0x33, 0xEE, 0x36, 0xA9,
+			0x1E, 0xC8, 0xCC, 0xC2, 0xD6, 0x72, 0x2C, 0x19, 0xAE, 0x6D, 0xE3, 0xE7, 0x5C, 0xA9, 0x54, 0x08, 0xDF, 0x94, 0x72, 0x89,
+			0x44, 0x02, 0x4D, 0xA0, 0xB6, 0x04, 0xF7, 0xFB, 0xFD, 0xDD, 0xDD, 0xDD, 0x4A, 0xA5, 0x42, 0x99, 0x03, 0xC2, 0xCB, 0x44,
+			0x88, 0xA8, 0x8D, 0x45, 0xAD, 0x7C, 0xBA, 0xDB, 0xED, 0x36, 0xEB, 0xE3, 0x41, 0x99, 0xC1, 0x37, 0x68, 0x11, 0xD8, 0x67,
+			0x30, 0x5B, 0xFE, 0x50, 0xBE, 0x01, 0x88, 0x99, 0xFB, 0xA6, 0xDB, 0xD6, 0xA6, 0xBF, 0x50, 0xA9, 0x54, 0xDC, 0x17, 0x66,
+			0xBB, 0x73, 0xE1, 0x90, 0x09, 0xCC, 0x25, 0xD5, 0xDB, 0x91, 0x1A, 0x8C, 0xA7, 0xF3, 0x68, 0x24, 0x12, 0xB3, 0xE6, 0xD2,
+			0xAE, 0x8A, 0x21, 0xB9, 0xF1, 0x78, 0x32, 0x0C, 0x67, 0x9E, 0x67, 0xD1, 0x73, 0x38, 0x9C, 0x24, 0x0B, 0xC9, 0x89, 0xA3,
+			0xE5, 0xD8, 0xF1, 0x9A, 0x5B, 0xBB, 0x33, 0x1A, 0x8C, 0x47, 0x63, 0x63, 0xEF, 0x70, 0x9F, 0x67, 0xB3, 0x30, 0x12, 0xB1,
+			0x46, 0x6D, 0x3C, 0x1E, 0xCD, 0xE7, 0x36, 0x33, 0x9F, 0x05, 0x0B, 0xF4, 0xC3, 0x4D, 0x65, 0x17, 0xA3, 0x66, 0x9B, 0xFE,
+			0x8F, 0x67, 0x61, 0x68, 0xC9, 0xE0, 0xA4, 0x37, 0x4A, 0x24, 0x92, 0x83, 0x71, 0xE8, 0xFB, 0x96, 0xA1, 0x23, 0x91, 0xD8,
+			0x72, 0x9B, 0x58, 0x38, 0x31, 0x28, 0xC0, 0xF2, 0xC7, 0x60, 0x10, 0x44, 0xF3, 0x89, 0x68, 0x38, 0xEF, 0x0F, 0x82, 0x60,
+			0xEA, 0xCD, 0x66, 0xB1, 0xF9, 0xDC, 0xCA, 0xF0, 0xF1, 0x64, 0x3C, 0xF3, 0x6D, 0x46, 0x6D, 0xF7, 0xD6, 0xA1, 0xE1, 0xF4,
+			0xEC, 0xF4, 0x37, 0x94, 0x5D, 0x18, 0x2E, 0x69, 0x7E, 0x0B, 0xE9, 0x2B, 0x97, 0xCB, 0x61, 0xAA, 0x23, 0x56, 0x86, 0xED,
+			0xF6, 0x71, 0x06, 0xD1, 0xE0, 0xB9, 0x58, 0x56, 0xA9, 0x57, 0x25, 0x7C, 0xF0, 0x1F, 0x36, 0x1A, 0x0D, 0xCA, 0x34, 0x44,
+			0x1E, 0x8C, 0xE3, 0xAC, 0xEC, 0x70, 0x8C, 0x83, 0x54, 0x2A, 0x55, 0xAF, 0xD7, 0xCB, 0xE5, 0xB2, 0x14, 0x03, 0x83, 0xC1,
+			0x40, 0x2E, 0x43, 0xC4, 0x3E, 0xF8, 0x48, 0x80, 0x24, 0x66, 0x3C, 0xE0, 0xCE, 0xDE, 0xA5, 0xF6, 0xB9, 0x7F, 0x3A, 0x7C,
+			0xFA, 0xCB, 0xE5, 0x2F, 0xCC, 0xE7, 0xF3, 0xAF, 0xB5, 0xBE, 0xFB, 0x78, 0xF6, 0x52, 0xAA, 0x6C, 0x2F, 0xF8, 0xB9, 0xE9,
+			0xC5, 0x17, 0xCB, 0x3F, 0xB8, 0xF5, 0xE8, 0x66, 0xA4, 0x49, 0x2C, 0x6C, 0x7A, 0x3C, 0x7B, 0xE9, 0xAB, 0x83, 0x67, 0x6A,
+			0x99, 0x5A, 0xC2, 0x4B, 0xD8, 0x92, 0xC8, 0xB1, 0x01, 0x98, 0x1C, 0xB0, 0xD1, 0x68, 0xF4, 0x48, 0xFE, 0x02, 0xFA, 0xD2,
+			0x5B, 0xEB, 0x37, 0x87, 0x89, 0xF0, 0xB6, 0xF6, 0x47, 0x67, 0xB5, 0xD9, 0xC9, 0xC9, 0x49, 0x7B, 0xD2, 0x1E, 0x15, 0x47,
+			0xBD, 0x5E, 0x0F, 0xDD, 0xEF, 0x63, 0xD9, 0x07, 0xC7, 0x27, 0xE3, 0xE2, 0x46, 0xF1, 0x9B, 0x27, 0xCF, 0x17, 0x47, 0x19,
+			0xA8, 0x13, 0xB4, 0xCE, 0x85, 0x42, 0xE1, 0x65, 0xFF, 0x8D, 0x2F, 0xC5, 0x1E, 0x9D, 0x4C, 0x26, 0xD7, 0xE2, 0x37, 0x9E,
+			0xAA, 0x3D, 0xF9, 0xED, 0xEE, 0x8B, 0xD6, 0xEC, 0x27, 0x4D, 0xA9, 0x0B, 0x87, 0xFD, 0xB9, 0xE0, 0xCA, 0xC3, 0xD1, 0xF3,
+			0xDF, 0x0F, 0x5F, 0x8B, 0x79, 0xC6, 0x82, 0x27, 0xE8, 0x13, 0x2B, 0x45, 0x80, 0x56, 0xB5, 0x44, 0x34, 0x67, 0x31, 0xB2,
+			0xFC, 0x3C, 0xB4, 0xD8, 0x59, 0x4B, 0x60, 0xE4, 0xF5, 0x2D, 0xA6, 0x26, 0xB8, 0xFF, 0x2A, 0x73, 0x74, 0xC1, 0x4F, 0x5D,
+			0x6E, 0x04, 0xA1, 0xEC, 0xD3, 0x2F, 0xE5, 0xE4, 0xCB, 0xCF, 0x00, 0x10, 0xC9, 0x42, 0xED, 0xF2, 0xFD, 0x48, 0xD7, 0x62,
+			0x85, 0x8B, 0xA3, 0x42, 0x9E, 0x09, 0xFF, 0xFF, 0x48, 0x32, 0xE2, 0xA7, 0x7C, 0xB4, 0x57, 0x58, 0x26, 0x84, 0x5E, 0x98,
+			0x8A, 0xA6, 0x86, 0xBE, 0xDD, 0xBB, 0x62, 0xB6, 0x68, 0x26, 0x9D, 0x39, 0xFB, 0x3A, 0x0E, 0x0F, 0x0F, 0x0F, 0xA2, 0xEF,
+			0xDD, 0x9C, 0xBD, 0xD5, 0x82, 0x6C, 0x26, 0x01, 0x4F, 0x0F, 0x34, 0x6F, 0x32, 0x9D, 0xF8, 0xA1, 0x9F, 0x2D, 0x9A, 0x84,
+			0x1B, 0x0B, 0xC2, 0xE1, 0xDC, 0xD9, 0x1D, 0x3B, 0x7B, 0x06, 0x83, 0x25, 0x0B, 0x26, 0xC9, 0xB4, 0x89, 0x42, 0xB8, 0x30,
+			0xBF, 0x41, 0xB9, 0x6D, 0x34, 0xBF, 0x98, 0x91, 0x15, 0x87, 0xC3, 0x61, 0x32, 0x97, 0xFC, 0xE5, 0xC9, 0xF5, 0x3B, 0xB7,
+			0xCC, 0x63, 0xB5, 0xDD, 0x6E, 0x97, 0xCB, 0xE5, 0x66, 0xB3, 0x09, 0x15, 0x10, 0xEA, 0x3D, 0x09, 0xA3, 0xB2, 0x65, 0xA1,
+			0xC6, 0xDD, 0xCA, 0xE1, 0xA3, 0x0F, 0x1E, 0xC0, 0xEC, 0xC0, 0x99, 0x86, 0xCF, 0x05, 0xBD, 0x0D, 0xA6, 0x06, 0x2D, 0x14,
+			0xA1, 0x39, 0x12, 0x89, 0x3C, 0xFB, 0xCA, 0xEE, 0x97, 0xBF, 0xF0, 0x11, 0x2D, 0x0A, 0xC6, 0xAB, 0x03, 0xB1, 0x14, 0xE2,
+			0x21, 0xE8, 0xE6, 0xA4, 0xE7, 0x7E, 0xBF, 0x5F, 0xAD, 0x56, 0x81, 0x19, 0xA1, 0xC3, 0xB2, 0xDE, 0x87, 0xFF, 0x5C, 0x66,
+			0xF2, 0x78, 0xEA, 0x10, 0x51, 0xBF, 0xFE, 0xEC, 0xFF, 0xFE, 0xCA, 0xE3, 0x37, 0xE3, 0x48, 0x01, 0x72, 0x4E, 0x4B, 0x2D,
+			0x56, 0x08, 0x92, 0x4C, 0xFD, 0x98, 0xD4, 0x3E, 0x95, 0xB2, 0x0D, 0x24, 0x60, 0x9A, 0xB4, 0x7E, 0xF8, 0x3F, 0x83, 0xCA,
+			0xA1, 0x6A, 0x03, 0x84, 0x45, 0x7E, 0x36, 0x18, 0x0C, 0x90, 0x9F, 0x21, 0x02, 0x12, 0x14, 0x0E, 0x31, 0x94, 0xB7, 0xCB,
+			0x0C, 0x9A, 0xA2, 0x4F, 0x0A, 0x3A, 0x2E, 0xBC, 0x7D, 0x07, 0xEE, 0x43, 0x32, 0x7D, 0x62, 0x6D, 0x3A, 0x7F, 0x07, 0x2E,
+			0x70, 0xB9, 0x5C, 0xCE, 0x64, 0x32, 0xC5, 0x62, 0x91, 0x7E, 0x8D, 0x29, 0x39, 0x87, 0x92, 0xA0, 0x8F, 0x5B, 0x0B, 0xF5,
+			0x0B, 0xA9, 0x92, 0x17, 0x99, 0xCD, 0x66, 0xFB, 0xFB, 0xFB, 0x78, 0x00, 0x60, 0xD4, 0x93, 0xCD, 0x66, 0x29, 0xF9, 0x41,
+			0x6F, 0x71, 0x94, 0xC3, 0xE6, 0x9A, 0x52, 0x97, 0xE8, 0x0C, 0x2B, 0x94, 0xA9, 0x06, 0x94, 0x61, 0x24, 0xA3, 0x28, 0xA7,
+			0x41, 0xC9, 0x6C, 0x15, 0x94, 0x7B, 0x7B, 0xA0, 0x13, 0x2E, 0x49, 0x5B, 0x58, 0x47, 0x86, 0x97, 0x4E, 0xA7, 0x09, 0x55,
+			0x42, 0xF1, 0xD6, 0xD6, 0xD6, 0xE0, 0x1D, 0x8E, 0x46, 0x23, 0x8C, 0x0C, 0xE1, 0xBD, 0xC0, 0x58, 0x20, 0x77, 0x52, 0xC9,
+			0x36, 0x06, 0x11, 0x34, 0xC0, 0x49, 0x7F, 0x72, 0x70, 0x3A, 0x47, 0x64, 0x9B, 0x4F, 0xFA, 0xB8, 0x37, 0xA7, 0xA3, 0xB3,
+			0x58, 0x38, 0xCA, 0x27, 0xFD, 0xF7, 0x5B, 0xA3, 0xE6, 0xD0, 0x22, 0x2F, 0xC6, 0xCE, 0xAC, 0xEA, 0xCD, 0x25, 0xBC, 0x74,
+			0x74, 0xB6, 0x7B, 0x32, 0x6D, 0x8F, 0x6D, 0x7A, 0x1C, 0x9F, 0x07, 0xB1, 0x70, 0x74, 0x32, 0x4D, 0xE5, 0x93, 0x36, 0xAD,
+			0xB5, 0x3F, 0x89, 0xC7, 0x9B, 0xC3, 0x68, 0x21, 0x15, 0xC9, 0xC6, 0xE7, 0xE5, 0x7C, 0x72, 0xBF, 0x1B, 0xC6, 0xC2, 0x51,
+			0x26, 0x16, 0xA6, 0xA3, 0xB3, 0xFD, 0x6E, 0x58, 0xEF, 0x79, 0x09, 0x6F, 0x9C, 0x89, 0x85, 0x8C, 0x9D, 0xB3, 0x71, 0xAB,
+			0x7A, 0x18, 0xF0, 0xEE, 0x9E, 0x4C, 0x13, 0xDE, 0x38, 0x16, 0x8E, 0xD6, 0x73, 0x09, 0x94, 0xC9, 0xEB, 0xB9, 0x04, 0xD6,
+			0xD3, 0xBF, 0x3D, 0x0A, 0xD6, 0x32, 0xB1, 0x8D, 0x82, 0x19, 0x50, 0x33, 0x1B, 0x07, 0xC2, 0x62, 0xE2, 0xCD, 0x3C, 0x93,
+			0xF2, 0x07, 0x7B, 0x16, 0xC4, 0xF4, 0x3C, 0x76, 0x40, 0x1E, 0xA2, 0x30, 0x68, 0x09, 0xE3, 0x2C, 0x0C, 0x3C, 0x8C, 0x15,
+			0xEA, 0x5A, 0x6F, 0xC8, 0x79, 0x2C, 0x7C, 0x06, 0x36, 0xA5, 0xF6, 0xC7, 0x06, 0xAA, 0xD1, 0x68, 0xD0, 0x80, 0x62, 0xD4,
+			0xC3, 0x06, 0x53, 0x10, 0x80, 0x9D, 0x9D, 0x1D, 0xC9, 0x71, 0xED, 0x6E, 0x2F, 0x47, 0xAC, 0x0C, 0x75, 0x49, 0xBD, 0x90,
+			0xD3, 0x65, 0xFE, 0xDE, 0x68, 0x34, 0x36, 0x37, 0x37, 0x3F, 0x9F, 0xBC, 0x1F, 0x54, 0xF4, 0xA9, 0xCD, 0x27, 0x5F, 0xF6,
+			0xDF, 0xB8, 0x3C, 0x7B, 0x95, 0xDC, 0x7F, 0xB6, 0x7F, 0x8B, 0x4C, 0x41, 0x10, 0x01, 0x8D, 0x46, 0xA3, 0x0B, 0xE3, 0x3B,
+			0x3E, 0xB6, 0xF7, 0x07, 0x34, 0x2B, 0x58, 0x61, 0x6B, 0xFE, 0xDF, 0xED, 0x76, 0x7F, 0x5A, 0xFA, 0xF5, 0xC1, 0xC1, 0x01,
+			0x2A, 0x81, 0x9D, 0x9D, 0x9D, 0xCB, 0xB3, 0x57, 0xAF, 0xC5, 0x6F, 0x5C, 0xF2, 0xCF, 0xE1, 0x05, 0xF6, 0xED, 0xEE, 0x8B,
+			0x40, 0xA9, 0x20, 0x9C, 0xE7, 0x86, 0xB7, 0x82, 0x53, 0x5F, 0x9E, 0xBD, 0x2A, 0xEC, 0xBE, 0xD7, 0xEB, 0x61, 0xBE, 0xF2,
+			0xD4, 0xE6, 0x93, 0x61, 0x18, 0x5E, 0x18, 0xDF, 0xF1, 0x48, 0xEC, 0x82, 0x35, 0xEB, 0x85, 0x82, 0xEF, 0xFB, 0xCF, 0x8F,
+			0xAF, 0xFE, 0x45, 0xED, 0x8B, 0x37, 0x8A, 0x6F, 0xDF, 0x7E, 0xFC, 0x31, 0xD9, 0xF2, 0xC8, 0x04, 0x10, 0xF8, 0x5B, 0x5C,
+			0x20, 0xD5, 0xE9, 0x78, 0x9F, 0xAC, 0x96, 0xF3, 0x54, 0xFD, 0x54, 0x94, 0x90, 0x3E, 0x24, 0x0C, 0xE4, 0x2D, 0x49, 0xD3,
+			0xCB, 0x33, 0x14, 0x6E, 0x03, 0x62, 0x23, 0x8B, 0x0E, 0xFA, 0x75, 0x3E, 0xBE, 0x70, 0x21, 0xF9, 0x85, 0xC8, 0x09, 0x46,
+			0x6C, 0x1F, 0xB9, 0xC5, 0xB8, 0x5A, 0xD8, 0xA6, 0xD3, 0x3C, 0x55, 0xCC, 0xEE, 0x79, 0x35, 0x02, 0x08, 0xFF, 0x8B, 0x9D,
+			0x03, 0xBE, 0x23, 0x7A, 0x29, 0xBE, 0x3E, 0xDC, 0x6B, 0xF0, 0x23, 0xA0, 0x92, 0xA0, 0xFF, 0xD0, 0x20, 0x0A, 0xF1, 0x1D,
+			0x54, 0x3D, 0xB6, 0x39, 0x32, 0x95, 0x84, 0x36, 0xD3, 0x6E, 0xB7, 0xC5, 0x3D, 0x73, 0x71, 0xD5, 0xAA, 0xEF, 0x46, 0xA3,
+			0x81, 0x62, 0xB1, 0xDD, 0x6E, 0x17, 0x8B, 0x45, 0xF6, 0x3F, 0x1B, 0x70, 0xE9, 0xA6, 0x7D, 0xE5, 0x72, 0x19, 0x1C, 0x0C,
+			0x8E, 0x3B, 0xD0, 0x9C, 0xE2, 0xBB, 0xE8, 0x98, 0x7C, 0x89, 0xAC, 0xAD, 0x25, 0xD6, 0x49, 0xD7, 0x86, 0x7D, 0x64, 0xBF,
+			0xDF, 0x87, 0x36, 0x4A, 0x94, 0x23, 0x07, 0x00, 0x60, 0x58, 0x59, 0xEB, 0xEA, 0x69, 0x98, 0xE5, 0x62, 0xE8, 0x31, 0x19,
+			0x66, 0x5A, 0x20, 0x00, 0x8A, 0x9B, 0x25, 0xC3, 0x63, 0x64, 0x0D, 0x72, 0x64, 0x02, 0x30, 0xE7, 0x6B, 0x65, 0x48, 0x80,
+			0x65, 0x16, 0x89, 0xC7, 0x28, 0x15, 0xAD, 0x56, 0x0B, 0x34, 0x93, 0x81, 0x2A, 0xA9, 0x43, 0x53, 0x3E, 0x0E, 0x07, 0xE3,
+			0x78, 0x32, 0x9E, 0x65, 0x63, 0xF7, 0x8E, 0xA1, 0x81, 0x6A, 0x49, 0x2F, 0x6B, 0xF6, 0x30, 0xCD, 0xA7, 0x3D, 0xD4, 0x92,
+			0x26, 0x74, 0xAD, 0x78, 0x3F, 0x00, 0x71, 0xC2, 0xCC, 0x81, 0xD8, 0x43, 0x1A, 0xA7, 0x1F, 0xA7, 0xCC, 0x6F, 0xB5, 0x5A,
+			0x70, 0xF8, 0xA0, 0x12, 0xC1, 0xF6, 0xA1, 0xAA, 0xC2, 0xBE, 0xA3, 0x52, 0xA9, 0x60, 0xDF, 0x06, 0x4E, 0xC2, 0x73, 0xA4,
+			0x0B, 0x59, 0x5F, 0x5F, 0x3F, 0x73, 0xE6, 0x0C, 0xAE, 0x26, 0x06, 0x13, 0x2D, 0xA1, 0xFC, 0xCD, 0xCD, 0x4D, 0xF4, 0x14,
+			0xF0, 0xC3, 0xB4, 0x21, 0x92, 0x26, 0x03, 0xBC, 0x08, 0x51, 0x1B, 0x53, 0x14, 0x6B, 0xFA, 0xDC, 0x14, 0x17, 0x93, 0x54,
+			0x2E, 0x36, 0x3C, 0x10, 0x19, 0x49, 0x52, 0xDA, 0x48, 0xD6, 0x21, 0x99, 0x5B, 0xB7, 0xDB, 0x45, 0xB6, 0xCE, 0xF0, 0x83,
+			0x02, 0x1C, 0x42, 0xAD, 0xBC, 0x95, 0x70, 0x34, 0x93, 0x85, 0x86, 0x2C, 0x79, 0xB5, 0xC9, 0x52, 0x93, 0x70, 0x59, 0xB4,
+			0x7B, 0xCB, 0x1F, 0x7E, 0x91, 0x66, 0x6E, 0xFA, 0xCB, 0x6A, 0xB6, 0x84, 0xD4, 0xAB, 0x0B, 0xA1, 0x6F, 0x40, 0xA3, 0xAB,
+			0x7D, 0x32, 0x92, 0x23, 0xBA, 0xD6, 0xD2, 0x8A, 0x44, 0xB2, 0xB8, 0x98, 0xA0, 0x2A, 0x06, 0x29, 0xF3, 0xB5, 0x10, 0x7C,
+			0xD5, 0x2D, 0x80, 0x46, 0x12, 0xA1, 0x3C, 0x3E, 0x48, 0x70, 0x1B, 0x46, 0xA3, 0x11, 0xC6, 0x7C, 0x78, 0xDE, 0xB9, 0x16,
+			0xC4, 0x16, 0x9C, 0x4A, 0x50, 0xC2, 0x18, 0x1F, 0xC7, 0x50, 0x1A, 0x3B, 0x66, 0xF5, 0x74, 0xBB, 0x7C, 0x2E, 0x1E, 0xB5,
+			0x63, 0x24, 0x14, 0x99, 0xEA, 0x97, 0xCB, 0xE5, 0xC3, 0xC3, 0x43, 0x1A, 0x47, 0xCA, 0x11, 0x12, 0x2D, 0x9D, 0x0D, 0x18,
+			0x28, 0xD8, 0x11, 0x02, 0x63, 0xB9, 0xBD, 0x62, 0x30, 0xC0, 0xD7, 0x04, 0x88, 0x0C, 0x0E, 0x30, 0x9B, 0xCD, 0x6A, 0xB5,
+			0x1A, 0x30, 0x3D, 0x19, 0x7A, 0x7F, 0x7F, 0xFF, 0xF1, 0xEC, 0xA5, 0xBB, 0x4F, 0x3F, 0xC9, 0x04, 0x12, 0x93, 0x19, 0xA0,
+			0x2D, 0xAC, 0xB8, 0xC0, 0xD3, 0x0B, 0x85, 0xC2, 0x6B, 0xA9, 0x7F, 0xBE, 0xF5, 0xE8, 0xE6, 0xD9, 0x6C, 0xF6, 0x6A, 0xF2,
+			0xA7, 0xDF, 0x0F, 0x5F, 0xBB, 0x1A, 0xBB, 0x3E, 0x18, 0x0C, 0x2A, 0x95, 0xCA, 0x6D, 0xED, 0x8F, 0x22, 0x32, 0x62, 0x0D,
+			0xFD, 0xED, 0xC7, 0x1F, 0x7B, 0x22, 0xF7, 0x50, 0x3E, 0x9F, 0xDF, 0x70, 0x3F, 0x17, 0xC6, 0x77, 0x74, 0xBB, 0xDD, 0x5E,
+			0xAF, 0x77, 0x79, 0xF6, 0xEA, 0x62, 0x02, 0x77, 0xD1, 0x82, 0xF5, 0xFD, 0xB3, 0xBB, 0x49, 0x75, 0xB1, 0x58, 0xEC, 0xCF,
+			0xAB, 0x4F, 0x10, 0x37, 0x3F, 0xF8, 0xE0, 0x03, 0xE4, 0xA0, 0xD4, 0x98, 0x98, 0x05, 0x91, 0x68, 0x1F, 0xCF, 0x5E, 0x22,
+			0xFC, 0x49, 0x2B, 0x2E, 0xCB, 0x4F, 0xFE, 0x81, 0xC3, 0x43, 0xAB, 0x44, 0x2D, 0xAF, 0xED, 0x2E, 0xF0, 0x05, 0x62, 0xEE,
+			0xC7, 0x5D, 0xF7, 0x05, 0x53, 0x40, 0x36, 0x6D, 0x50, 0x47, 0xE4, 0x8C, 0xCF, 0x63, 0x54, 0xA6, 0x91, 0x2E, 0x1D, 0x88,
+			0x5F, 0x2B, 0xDD, 0x81, 0x1A, 0xEC, 0x45, 0x97, 0xD3, 0x69, 0x52, 0x9D, 0x3C, 0x6E, 0x01, 0x54, 0xB1, 0x62, 0x14, 0x92,
+			0xCC, 0x20, 0x17, 0x20, 0xA1, 0x58, 0x2C, 0x32, 0x53, 0x24, 0x19, 0x6B, 0x56, 0xCF, 0xF3, 0xA7, 0xB7, 0x20, 0xBC, 0xB0,
+			0x8C, 0xD7, 0xDA, 0x59, 0x57, 0xCC, 0x31, 0x1F, 0xA2, 0x45, 0x93, 0x87, 0x3E, 0xB1, 0x1B, 0x24, 0x10, 0x80, 0x81, 0x4D,
+			0xFD, 0x8C, 0x1B, 0x85, 0xFA, 0xF2, 0x19, 0x49, 0xA5, 0xA2, 0x03, 0x68, 0xD5, 0x3B, 0x26, 0x02, 0xD9, 0x6C, 0x56, 0x0B,
+			0xBA, 0x21, 0x5C, 0x42, 0xB2, 0x00, 0x72, 0x84, 0x61, 0xC8, 0xAE, 0x69, 0xEE, 0x1D, 0x2E, 0x11, 0x3C, 0x55, 0x86, 0x01,
+			0xD0, 0xB4, 0x60, 0x0F, 0x82, 0x27, 0x23, 0x7D, 0x87, 0x97, 0x28, 0x6B, 0x6B, 0x01, 0x41, 0x86, 0x99, 0x67, 0x32, 0x1A,
+			0xC0, 0x50, 0xFB, 0x62, 0x79, 0x09, 0xFF, 0x90, 0xC3, 0x09, 0xE7, 0x15, 0x47, 0x32, 0x42, 0x2E, 0xA4, 0x52, 0xC0, 0x1B,
+			0xA5, 0x6D, 0x91, 0x53, 0x0D, 0xD7, 0x75, 0x57, 0x95, 0xF7, 0x60, 0x96, 0x2C, 0x6E, 0x88, 0x32, 0x10, 0x57, 0x81, 0x31,
+			0x29, 0x13, 0x86, 0xD1, 0x68, 0xC4, 0x39, 0x4E, 0xA5, 0x52, 0x4C, 0x90, 0x0D, 0xA4, 0x76, 0xD5, 0x01, 0x82, 0x61, 0xF6,
+			0xB0, 0x13, 0xA9, 0xC7, 0xE3, 0x31, 0xE9, 0x11, 0xAF, 0x51, 0xD2, 0x2F, 0x8A, 0x2D, 0x4A, 0x6C, 0xEA, 0x08, 0x2C, 0x6A,
+			0x19, 0xA7, 0xE0, 0x88, 0xCD, 0x59, 0x07, 0x89, 0xC2, 0xD0, 0x15, 0xBF, 0x1D, 0xEB, 0xD0, 0x1D, 0x9D, 0x8B, 0xDD, 0x93,
+			0x04, 0x7A, 0x18, 0xA5, 0x54, 0x16, 0x5C, 0x51, 0x40, 0x7F, 0xEA, 0x3E, 0xFE, 0xB7, 0x5A, 0xAD, 0xE2, 0xF1, 0xC2, 0x51,
+			0xC0, 0x31, 0x98, 0x95, 0x90, 0x64, 0x48, 0x4E, 0xC3, 0xE1, 0xE1, 0x21, 0x95, 0x85, 0x61, 0x88, 0xF3, 0x79, 0xBD, 0x5E,
+			0x27, 0x07, 0x30, 0xD8, 0x21, 0x7F, 0x58, 0x97, 0xE4, 0x70, 0x24, 0x06, 0x8F, 0xCD, 0x66, 0x53, 0xBE, 0xC4, 0xED, 0x76,
+			0x7B, 0x7B, 0x7B, 0x9B, 0xF5, 0x55, 0xF8, 0xE1, 0x30, 0xAF, 0x27, 0xBE, 0xF0, 0xE8, 0xF8, 0x4A, 0xD6, 0xD6, 0xD6, 0xB0,
+			0x1F, 0xE0, 0x7B, 0xD5, 0xB8, 0x52, 0x2B, 0x87, 0xC4, 0xCF, 0x15, 0x56, 0x2B, 0x84, 0xFD, 0x43, 0xFE, 0x5C, 0x12, 0xC8,
+			0xAC, 0x76, 0xEB, 0xD2, 0x39, 0xBB, 0x78, 0x6E, 0x75, 0x90, 0xFB, 0xEA, 0xED, 0x36, 0x12, 0xE5, 0xA5, 0x0C, 0xA0, 0x43,
+			0xE7, 0xF3, 0x8A, 0xDA, 0xAC, 0xBB, 0xCA, 0xA7, 0xE0, 0x26, 0x6B, 0x0A, 0x4D, 0xA6, 0x01, 0xF9, 0xA5, 0x5A, 0xE7, 0x9B,
+			0xA2, 0x96, 0xA7, 0xC3, 0x3D, 0x3E, 0x3E, 0x86, 0x4F, 0x05, 0x6B, 0x00, 0x92, 0xEB, 0x4D, 0x37, 0xDD, 0xF4, 0xC1, 0x07,
+			0x1F, 0x70, 0xAB, 0x41, 0x69, 0xF6, 0xF7, 0xF7, 0xB9, 0x60, 0x50, 0x74, 0x38, 0x57, 0x7C, 0x64, 0x10, 0x48, 0x8E, 0xA9,
+			0x38, 0x8B, 0x2C, 0x4F, 0x86, 0x87, 0x06, 0xA9, 0x03, 0x88, 0x19, 0x7B, 0x0F, 0x58, 0x64, 0x00, 0x7D, 0xEB, 0xEB, 0xEB,
+			0x00, 0xF4, 0x9C, 0xDE, 0xED, 0xED, 0x6D, 0x3A, 0x68, 0x28, 0x7D, 0xD3, 0xE9, 0x94, 0xA9, 0x2C, 0x39, 0x8C, 0x52, 0x8B,
+			0x06, 0xF9, 0xE8, 0x56, 0x8B, 0x8C, 0x85, 0x42, 0xE1, 0xDB, 0xDD, 0x17, 0xEB, 0xF5, 0xFA, 0xF7, 0x86, 0x2F, 0x87, 0x61,
+			0xF8, 0xED, 0xEE, 0x8B, 0x34, 0xFB, 0x40, 0x52, 0xAC, 0x09, 0x9A, 0x4E, 0xA7, 0x64, 0x32, 0xF6, 0x11, 0x66, 0x32, 0x99,
+			0x27, 0x72, 0x0F, 0x61, 0xE7, 0xFB, 0x44, 0xEE, 0xA1, 0xC7, 0x32, 0x0F, 0x3E, 0x30, 0x3F, 0xCB, 0x96, 0x7C, 0x98, 0x66,
+			0x5C, 0xE9, 0x57, 0x22, 0x3F, 0xE2, 0xE3, 0x47, 0x22, 0x91, 0xEF, 0xF4, 0xBE, 0xCF, 0x44, 0x2D, 0x97, 0xCB, 0x6D, 0x6F,
+			0x6F, 0xFF, 0x79, 0xF5, 0x09, 0x2E, 0x1D, 0x6B, 0x9B, 0x98, 0xCB, 0xD1, 0xD1, 0x87, 0x61, 0x88, 0xC1, 0x2D, 0x60, 0x23,
+			0x0D, 0x3A, 0xBD, 0x7C, 0x22, 0x91, 0xF8, 0x4A, 0xE5, 0x71, 0xA6, 0x2C, 0x2C, 0xCC, 0x10, 0xF3, 0x87, 0x3F, 0xE4, 0x36,
+			0x49, 0x88, 0xCB, 0x6D, 0x95, 0x0A, 0x57, 0x16, 0x05, 0x44, 0xBD, 0x55, 0xF4, 0x5F, 0x7E, 0xA2, 0x08, 0x7D, 0x54, 0x4C,
+			0xF0, 0xAC, 0x48, 0xB7, 0x04, 0x41, 0x1E, 0xA3, 0xFE, 0x10, 0xE7, 0x83, 0x55, 0x37, 0x72, 0xD8, 0x6B, 0xA0, 0xA3, 0xE2,
+			0x29, 0xA8, 0xB7, 0xA0, 0x50, 0x95, 0x32, 0x40, 0x13, 0x38, 0x86, 0x49, 0x9D, 0x4E, 0x87, 0xFB, 0x0B, 0x70, 0x4A, 0xFC,
+			0x65, 0x84, 0x4E, 0x95, 0xC9, 0xA7, 0x46, 0xA2, 0xC5, 0xB2, 0x17, 0xCA, 0x7C, 0x1A, 0x4D, 0xCC, 0x12, 0xA8, 0x38, 0xC1,
+			0x9A, 0xB8, 0x83, 0xD4, 0xE6, 0xD0, 0x0E, 0xA9, 0x60, 0xD0, 0xD6, 0xF2, 0x88, 0xA4, 0x1C, 0x86, 0x1C, 0x4C, 0x9C, 0x05,
+			0x2E, 0xE6, 0xF8, 0xF1, 0x9E, 0xA1, 0x0B, 0x22, 0x9F, 0xD6, 0x34, 0x9B, 0x61, 0x35, 0x2B, 0x56, 0x28, 0xEC, 0x98, 0xC3,
+			0x6B, 0x40, 0x02, 0x31, 0x14, 0x84, 0x19, 0xA2, 0x1D, 0x70, 0xA8, 0xBC, 0x48, 0x01, 0x45, 0x60, 0x13, 0xD1, 0xDD, 0x42,
+			0x78, 0x11, 0x83, 0x88, 0x05, 0x2A, 0xA0, 0x0B, 0x3C, 0x2E, 0xD8, 0x98, 0x78, 0xFD, 0x53, 0x54, 0xC9, 0xAE, 0x98, 0x81,
+			0x0A, 0xA0, 0x25, 0x8C, 0x0C, 0xC8, 0xEE, 0x1C, 0x7B, 0x84, 0xDF, 0x5A, 0xF4, 0x1F, 0x41, 0xA8, 0xC9, 0x9E, 0x55, 0x49,
+			0x9F, 0x85, 0xE4, 0xC2, 0x0C, 0x01, 0xBF, 0xE3, 0xBF, 0x54, 0xAA, 0x81, 0x42, 0x20, 0xAB, 0x6E, 0x98, 0x3C, 0x52, 0x9A,
+			0x30, 0x58, 0x67, 0x40, 0x6A, 0xF4, 0x0E, 0x97, 0x1B, 0x48, 0x21, 0xE0, 0xB6, 0xCA, 0x75, 0x92, 0x14, 0xD1, 0xA1, 0x50,
+			0x1D, 0x70, 0xFF, 0x25, 0xA8, 0x11, 0x1F, 0x09, 0xF8, 0x85, 0x72, 0x00, 0x44, 0x8F, 0x86, 0x4E, 0xCB, 0x58, 0x7C, 0xDF,
+			0xAF, 0xBB, 0x9F, 0x62, 0xB1, 0xB8, 0xB5, 0xB5, 0xA5, 0xAE, 0x9F, 0xEF, 0xCF, 0x46, 0x49, 0x8E, 0x88, 0x82, 0x8E, 0x01,
+			0x3C, 0x87, 0x86, 0x80, 0xEF, 0x9B, 0x1C, 0x0E, 0x85, 0x80, 0xA2, 0x89, 0xFB, 0x49, 0x00, 0x05, 0xE0, 0x3B, 0x38, 0x38,
+			0x68, 0xB5, 0x5A, 0xB5, 0x5A, 0x8D, 0xDE, 0x02, 0x79, 0x05, 0xFB, 0xFB, 0x29, 0x15, 0xCF, 0x9C, 0x39, 0x43, 0xBD, 0xC6,
+			0xFB, 0x81, 0xFF, 0x07, 0xC1, 0x94, 0x56, 0x91, 0xF2, 0x16, 0x85, 0x33, 0x31, 0x9A, 0x3B, 0xBC, 0x1A, 0x9D, 0x01, 0x64,
+			0xB5, 0xB5, 0x91, 0xBA, 0x5B, 0x5E, 0x5A, 0xCC, 0x51, 0xA9, 0x1F, 0x79, 0x86, 0xE2, 0x6E, 0x13, 0xF1, 0xD1, 0x58, 0x42,
+			0x6C, 0xE7, 0x4F, 0xC4, 0x11, 0x24, 0xEE, 0xCB, 0xCB, 0x57, 0x96, 0xEB, 0x30, 0xDB, 0xE4, 0x85, 0x42, 0xA7, 0xAF, 0xBD,
+			0xA9, 0x94, 0x93, 0x54, 0x5E, 0x8C, 0x85, 0x94, 0x87, 0xD4, 0x0D, 0x40, 0xF1, 0xA2, 0x3E, 0x35, 0x03, 0xF2, 0x4E, 0xE7,
+			0xCC, 0x99, 0x33, 0x80, 0xEF, 0xDC, 0xD2, 0x6C, 0x36, 0x5B, 0xAD, 0x56, 0x39, 0x3C, 0x88, 0x72, 0xF1, 0xE7, 0x12, 0xB1,
+			0x5D, 0x5A, 0x7F, 0xB3, 0x79, 0x70, 0x7D, 0x2E, 0x11, 0x96, 0xFC, 0x41, 0x6F, 0x94, 0x48, 0x24, 0xD8, 0x0B, 0x06, 0x40,
+			0xC7, 0x33, 0x09, 0x82, 0xE0, 0xCC, 0x99, 0x33, 0x10, 0x76, 0x21, 0x7D, 0x91, 0xD8, 0xF6, 0xF7, 0xF7, 0x45, 0x13, 0x82,
+			0x04, 0x25, 0x55, 0x20, 0x02, 0xCE, 0xF2, 0x9B, 0xC6, 0x10, 0x1F, 0x0C, 0x06, 0x97, 0xFC, 0x73, 0x95, 0x4A, 0xE5, 0x41,
+			0xEF, 0x33, 0x85, 0x42, 0xE1, 0xB3, 0xE1, 0x3D, 0x54, 0x18, 0x9C, 0x7F, 0xD0, 0xED, 0x5C, 0x2E, 0x77, 0xA3, 0x68, 0x16,
+			0x23, 0x57, 0xA2, 0x3F, 0x1E, 0x0C, 0x06, 0xF5, 0x7A, 0x3D, 0x08, 0x02, 0x76, 0x44, 0xDB, 0xE7, 0x77, 0x82, 0xF9, 0x74,
+			0x3A, 0xFD, 0xF6, 0xD6, 0x7B, 0x10, 0x45, 0x88, 0xAA, 0x8F, 0xC6, 0xEF, 0xA3, 0x06, 0x7A, 0x69, 0xFE, 0xFA, 0x13, 0xB9,
+			0x87, 0x3C, 0xCF, 0x7B, 0x35, 0xF9, 0xD3, 0xE7, 0xC7, 0x57, 0x69, 0xB8, 0x79, 0xAA, 0xE5, 0x37, 0x4D, 0x32, 0x42, 0xB9,
+			0x07, 0x3C, 0x42, 0x14, 0xFE, 0xF5, 0xCE, 0xFF, 0x55, 0xA6, 0x67, 0x98, 0xF1, 0xB2, 0xFF, 0x06, 0xD7, 0x01, 0xC8, 0xF4,
+			0x5B, 0x9D, 0x17, 0x64, 0xDB, 0x4E, 0x0D, 0x21, 0xD1, 0xD6, 0x72, 0x77, 0xB4, 0xDD, 0x11, 0xE9, 0x6F, 0xD5, 0x16, 0x08,
+			0xA0, 0xE7, 0xBB, 0x00, 0x8C, 0xA5, 0x41, 0x97, 0x8A, 0xC2, 0x32, 0xC3, 0x92, 0xDC, 0xA1, 0x5D, 0x31, 0xAB, 0x8C, 0x4F,
+			0xEA, 0x71, 0x08, 0x5A, 0x20, 0x3C, 0x2A, 0x4A, 0xC4, 0xB4, 0xE6, 0x03, 0x72, 0x1A, 0x39, 0x90, 0xB4, 0xE0, 0x80, 0xC3,
+			0xEE, 0xCF, 0xCC, 0x40, 0x05, 0xA6, 0x0D, 0x5D, 0x32, 0x1E, 0x5C, 0x54, 0xD6, 0xB0, 0x0A, 0x09, 0x26, 0x9C, 0x3A, 0xB2,
+			0xBE, 0x3A, 0x51, 0x39, 0x77, 0xF2, 0x7C, 0x80, 0xA3, 0x51, 0xCF, 0x72, 0xAF, 0xB5, 0x10, 0x5F, 0xA6, 0x43, 0x34, 0xD9,
+			0xEC, 0xB0, 0xCC, 0xE7, 0xF3, 0x30, 0x06, 0x69, 0x89, 0x58, 0x51, 0x25, 0x10, 0x1B, 0x4B, 0x41, 0x25, 0x0C, 0xA2, 0x19,
+			0xB3, 0x01, 0x4C, 0x24, 0xE9, 0x5D, 0x9A, 0xCD, 0x26, 0x0B, 0x4A, 0x61, 0x8E, 0xA0, 0x48, 0x60, 0x64, 0x05, 0x26, 0x0C,
+			0xD2, 0xEB, 0x28, 0xE8, 0x19, 0xBB, 0x5D, 0x6E, 0x1F, 0x19, 0xDD, 0x15, 0x9F, 0x54, 0x3B, 0x29, 0x45, 0x8E, 0xC8, 0x64,
+			0x32, 0x1F, 0x4A, 0x0C, 0x94, 0xAA, 0xB4, 0xDA, 0xA0, 0x43, 0x48, 0xAC, 0xE8, 0x84, 0xD0, 0x51, 0x62, 0x86, 0x01, 0x73,
+			0x8F, 0x4A, 0x88, 0x3A, 0x80, 0x90, 0x0B, 0xE3, 0x96, 0xF7, 0x43, 0x65, 0x69, 0x34, 0x50, 0x84, 0x2A, 0xA0, 0x07, 0x50,
+			0x2F, 0x60, 0xB9, 0x10, 0x3A, 0xD5, 0xF7, 0x01, 0xC0, 0x91, 0x5B, 0x88, 0x56, 0x47, 0x47, 0x47, 0xF0, 0x9A, 0x99, 0xBC,
+			0xE3, 0xAF, 0xCB, 0xBD, 0xE2, 0x9B, 0x56, 0x6A, 0x4D, 0xA7, 0xD3, 0x36, 0xFF, 0x75, 0x7F, 0x8D, 0x41, 0x07, 0x4B, 0x5C,
+			0xD1, 0x16, 0x72, 0xC3, 0xD1, 0xB9, 0x21, 0x20, 0x80, 0xA7, 0xC1, 0x54, 0x59, 0x0B, 0x8E, 0xB9, 0xBD, 0x32, 0xFE, 0x25,
+			0x92, 0xEA, 0x50, 0xD2, 0xA9, 0x21, 0x11, 0xD8, 0xD8, 0xD8, 0x80, 0x26, 0x01, 0xEC, 0x80, 0xBC, 0x08, 0x86, 0xFE, 0x60,
+			0x30, 0x20, 0xE1, 0x21, 0xD3, 0xA5, 0x2C, 0x02, 0x33, 0x45, 0x45, 0x49, 0x9C, 0x05, 0x71, 0x62, 0x8D, 0x1C, 0x19, 0x9E,
+			0x26, 0x83, 0x1E, 0x99, 0xFA, 0x82, 0xA1, 0x02, 0xCC, 0x30, 0x0E, 0x22, 0xAD, 0x1C, 0x34, 0xD9, 0x72, 0xB9, 0xCC, 0x5A,
+			0x22, 0x25, 0xFC, 0x4E, 0xA7, 0xC3, 0x98, 0x8B, 0xF5, 0x17, 0x8D, 0x46, 0x83, 0xD2, 0x58, 0x70, 0x9E, 0x84, 0x4B, 0x62,
+			0x58, 0x8B, 0x6B, 0x68, 0xD1, 0x65, 0x59, 0x7C, 0x49, 0xBA, 0x29, 0xFB, 0x36, 0xE1, 0xDA, 0xAA, 0xB0, 0xB4, 0xF5, 0x97,
+			0x66, 0x90, 0xFB, 0x23, 0x12, 0x82, 0xCA, 0x37, 0xD1, 0x0F, 0xF4, 0x90, 0x19, 0x40, 0x09, 0x13, 0xD0, 0x46, 0x30, 0x15,
+			0x95, 0xBC, 0x2B, 0x7E, 0xA9, 0x84, 0x23, 0x58, 0x2F, 0x60, 0x8F, 0x8C, 0x93, 0x3B, 0xA0, 0x22, 0xD1, 0xB3, 0xD1, 0x68,
+			0xD0, 0xA5, 0x42, 0xAC, 0x06, 0x7F, 0x40, 0x79, 0x4F, 0x53, 0xE5, 0x79, 0x5E, 0xBD, 0x5E, 0xAF, 0x56, 0xAB, 0x84, 0x1E,
+			0x9A, 0x6B, 0xFE, 0x8E, 0x86, 0x43, 0x0A, 0x1C, 0xBC, 0x48, 0xB1, 0x58, 0x94, 0x15, 0x8F, 0x8E, 0x32, 0xBF, 0x02, 0xC3,
+			0x13, 0x88, 0x00, 0xB4, 0x74, 0xCC, 0xF9, 0x69, 0xB7, 0x99, 0x51, 0x61, 0x74, 0x88, 0xE1, 0x15, 0xF7, 0x87, 0x0A, 0xAB,
+			0xD5, 0x6A, 0x01, 0x42, 0x12, 0xB0, 0x60, 0xE9, 0x75, 0xBB, 0xDD, 0xAB, 0xB1, 0xEB, 0xCC, 0x24, 0x3E, 0xDD, 0xFA, 0x93,
+			0x20, 0x08, 0xEE, 0xE9, 0x7D, 0x0A, 0x66, 0x81, 0x16, 0xEA, 0x82, 0x64, 0x3E, 0x7D, 0x7A, 0xF9, 0xE9, 0xD3, 0xCB, 0x0F,
+			0xCC, 0xCF, 0x7E, 0xB5, 0xF9, 0x0C, 0x3A, 0x47, 0xEC, 0x21, 0xD9, 0xF6, 0x7E, 0x71, 0x7A, 0x17, 0x64, 0xB0, 0x7B, 0x47,
+			0xB7, 0xB1, 0x16, 0xF8, 0xE8, 0xE8, 0x88, 0x3E, 0x63, 0x3E, 0x9F, 0x5F, 0x8D, 0x5D, 0xC7, 0xFB, 0x9A, 0x41, 0xDA, 0xFF,
+			0x3C, 0xFA, 0x5E, 0x2C, 0x16, 0xFB, 0xE8, 0xEE, 0xEF, 0x53, 0xD0, 0x5D, 0x8D, 0x5D, 0xCF, 0xE5, 0x72, 0xAF, 0x44, 0x7E,
+			0xF4, 0x68, 0xFC, 0x3E, 0x0A, 0x49, 0xA0, 0xE4, 0x87, 0xA3, 0xE7, 0x49, 0xFC, 0x94, 0x0B, 0xFC, 0xAF, 0xBE, 0x59, 0x06,
+			0xAA, 0x92, 0x9E, 0xAA, 0x92, 0x58, 0x35, 0x84, 0xA0, 0x54, 0x97, 0x6F, 0x04, 0x6C, 0x66, 0x09, 0x1B, 0xC1, 0xF4, 0x01,
+			0x15, 0xC5, 0xB7, 0xE1, 0x38, 0x11, 0x49, 0x99, 0xAF, 0x12, 0xCB, 0xB4, 0x95, 0x5E, 0x7B, 0xA9, 0xB4, 0x97, 0x5C, 0x72,
+			0x04, 0x49, 0x73, 0x45, 0x23, 0xD6, 0x51, 0x21, 0xC2, 0x68, 0x29, 0x98, 0x2C, 0xF2, 0xA9, 0xD3, 0xE9, 0xF5, 0x59, 0x1B,
+			0x45, 0xA7, 0xCB, 0xDE, 0x0E, 0xF5, 0x04, 0x38, 0xE4, 0xA8, 0xEE, 0x91, 0x53, 0x59, 0xA1, 0x50, 0x90, 0xE0, 0x43, 0x68,
+			0x3B, 0x48, 0x20, 0xF5, 0x2B, 0xE9, 0x0A, 0x94, 0x9B, 0xF6, 0x28, 0xE9, 0x7E, 0x98, 0xB8, 0x70, 0x23, 0xA8, 0xFC, 0x08,
+			0x41, 0xB0, 0xC9, 0x75, 0x95, 0x38, 0x87, 0x38, 0xC7, 0x48, 0x7F, 0xC7, 0xC7, 0x94, 0x0F, 0x18, 0x2C, 0x06, 0xF9, 0xC4,
+			0x00, 0xAD, 0x83, 0x1A, 0x81, 0x7E, 0x33, 0x47, 0xE4, 0x2B, 0xA3, 0x7D, 0x0F, 0x82, 0x00, 0x42, 0x0D, 0x3C, 0xE9, 0x6A,
+			0xB5, 0x0A, 0x23, 0xDC, 0x46, 0x80, 0x0E, 0xBC, 0xC2, 0xCB, 0x53, 0x9F, 0x57, 0xE9, 0x5F, 0x10, 0x9C, 0xC8, 0x3F, 0xA0,
+			0x5B, 0x3A, 0xAB, 0x78, 0xD9, 0xAA, 0x0C, 0x8D, 0xAC, 0x3A, 0xD8, 0xC8, 0x9B, 0x41, 0x22, 0x91, 0xE9, 0x74, 0x4A, 0x03,
+			0x2B, 0xC0, 0x9A, 0xE6, 0x54, 0x0B, 0x17, 0x41, 0xFF, 0xE9, 0x47, 0x08, 0xC7, 0xE2, 0xB0, 0xE3, 0xEA, 0x0C, 0x14, 0x53,
+			0xAD, 0x56, 0xE5, 0x21, 0x41, 0x4D, 0x01, 0x3A, 0x46, 0x13, 0x44, 0x59, 0xCA, 0x18, 0x04, 0x2F, 0x53, 0x54, 0xF8, 0xE4,
+			0x55, 0xCF, 0x33, 0xB3, 0x2D, 0x82, 0x54, 0xB9, 0x5C, 0x66, 0xF7, 0x24, 0x3A, 0x3A, 0x5C, 0x4F, 0x61, 0xDA, 0x1E, 0x1C,
+			0x1C, 0x10, 0x23, 0xC0, 0x7F, 0xE1, 0x0C, 0xD0, 0x0A, 0xE5, 0xF3, 0x79, 0xFC, 0x0B, 0x29, 0x4E, 0x0B, 0x85, 0x02, 0x85,
+			0x12, 0xE8, 0x3C, 0x86, 0xF2, 0x2C, 0x83, 0x14, 0x2D, 0xB5, 0x58, 0x2C, 0x6A, 0x85, 0x21, 0xE3, 0x38, 0x5C, 0x48, 0xD9,
+			0xEF, 0x03, 0xD8, 0xC5, 0x9B, 0x21, 0x38, 0x5A, 0x72, 0x77, 0xE4, 0x51, 0x50, 0x4B, 0xDF, 0xF7, 0x35, 0xC9, 0x80, 0x3C,
+			0xCE, 0x5E, 0x72, 0xB8, 0x04, 0x48, 0x51, 0x78, 0xCE, 0xD6, 0xBB, 0xB8, 0x01, 0xEC, 0xFB, 0xAD, 0x51, 0xD2, 0x9F, 0xE4,
+			0x12, 0x5E, 0x2A, 0x32, 0xDD, 0xEB, 0xCC, 0xE2, 0xF3, 0xA0, 0x98, 0x8E, 0x96, 0xF3, 0xC9, 0xE6, 0x30, 0x7A, 0xD8, 0xB7,
+			0xDD, 0xBC, 0xE9, 0xE8, 0x2C, 0x97, 0x30, 0x50, 0x3E, 0x1B, 0x9F, 0xA7, 0x22, 0xD3, 0x6C, 0x7C, 0x7E, 0xD8, 0xF7, 0x77,
+			0x4F, 0xA6, 0xE9, 0xE8, 0x0C, 0x41, 0xEF, 0x61, 0xDF, 0x4F, 0xFA, 0x93, 0x4C, 0x2C, 0x2C, 0xA4, 0x4C, 0xD9, 0x8B, 0xCF,
+			0xB3, 0x01, 0x4D, 0x91, 0x69, 0x6B, 0x64, 0xCA, 0xDE, 0xB5, 0x4C, 0x6C, 0x2D, 0x63, 0x7A, 0x60, 0xA6, 0xB8, 0x99, 0x58,
+			0x58, 0xCA, 0xC6, 0x0F, 0x4E, 0x6D, 0xA4, 0x1C, 0x9F, 0xDB, 0x22, 0xE2, 0x86, 0xB3, 0x06, 0xE1, 0x77, 0x95, 0xB2, 0xF1,
+			0xD6, 0x28, 0x86, 0xD8, 0x98, 0x17, 0xAF, 0xF7, 0xBC, 0xF8, 0x3C, 0x48, 0x47, 0x67, 0x1B, 0x85, 0x14, 0x63, 0xE1, 0x4C,
+			0xCC, 0xA2, 0x39, 0x15, 0x2E, 0x47, 0x6D, 0x7F, 0x7F, 0x9F, 0x33, 0xAD, 0xF5, 0xBC, 0xC8, 0x23, 0xE9, 0x29, 0x31, 0xAB,
+			0x62, 0x5A, 0x88, 0x52, 0x94, 0xD6, 0x8A, 0x61, 0x89, 0x06, 0x98, 0xD0, 0xB4, 0x37, 0x36, 0x36, 0xC0, 0x09, 0xB7, 0xB7,
+			0xB7, 0xC1, 0x94, 0xA0, 0xF3, 0x73, 0x81, 0xB1, 0x68, 0x27, 0x4F, 0x9B, 0xAD, 0x9B, 0x03, 0x5E, 0x50, 0x6F, 0x00, 0x12,
+			0x8E, 0x46, 0xA3, 0x9D, 0x9D, 0x9D, 0x5A, 0xAD, 0xB6, 0xB5, 0xB5, 0xC5, 0x21, 0x07, 0xA7, 0xA2, 0xBD, 0x60, 0xB6, 0x44,
+			0x9E, 0xE8, 0x76, 0xBB, 0xB8, 0x78, 0x4A, 0x5C, 0x92, 0x4E, 0xA7, 0xAF, 0xC6, 0xAE, 0x87, 0x61, 0xF8, 0xD3, 0xD2, 0xAF,
+			0xD7, 0xD6, 0xD6, 0xEE, 0x1D, 0xDD, 0x96, 0x4E, 0xA7, 0x59, 0x2B, 0xF4, 0x56, 0xED, 0x5D, 0xEC, 0xA4, 0x82, 0x20, 0x78,
+			0x23, 0xFB, 0x73, 0xEC, 0xA4, 0xD6, 0xD6, 0xD6, 0x52, 0xA9, 0xD4, 0x03, 0xF3, 0xB3, 0x0F, 0x47, 0xCF, 0x9F, 0x9C, 0x9C,
+			0x3C, 0x1A, 0xBF, 0x2F, 0x08, 0x02, 0x86, 0x9C, 0xAE, 0x0E, 0x8E, 0xE2, 0x44, 0xC2, 0x55, 0x4A, 0xA7, 0xD3, 0xCF, 0x8E,
+			0x5E, 0x61, 0xDA, 0xC1, 0xBE, 0xC0, 0x68, 0x34, 0x7A, 0xF7, 0xE9, 0x27, 0x61, 0xDD, 0x00, 0x1F, 0x7D, 0x3E, 0x79, 0xFF,
+			0x60, 0x30, 0xE0, 0x76, 0xCC, 0xE7, 0xF3, 0x87, 0xA3, 0xE7, 0x5B, 0xAD, 0xD6, 0x23, 0xB1, 0x0B, 0x02, 0xDF, 0x60, 0x2B,
+			0xD4, 0x6A, 0x35, 0x09, 0x09, 0x57, 0x1D, 0xD9, 0xF8, 0x2E, 0x00, 0x79, 0x39, 0xD2, 0x46, 0x09, 0x5B, 0x29, 0x02, 0xC4,
+			0xCD, 0x97, 0xA5, 0x0F, 0x90, 0xA6, 0x46, 0xC4, 0xE0, 0x0C, 0x24, 0x0F, 0xFD, 0x87, 0x04, 0x56, 0xD1, 0xA6, 0xD9, 0xE8,
+			0x49, 0xB1, 0x4F, 0x73, 0x46, 0x1D, 0x43, 0xA1, 0xF0, 0x21, 0x95, 0x19, 0xD5, 0x1E, 0x6F, 0x40, 0xD6, 0x26, 0x2C, 0x3B,
+			0xD2, 0xD6, 0x78, 0xAC, 0x4A, 0x58, 0x43, 0x82, 0x4E, 0x48, 0x3B, 0x2C, 0x39, 0x33, 0xCC, 0xCC, 0xB0, 0x40, 0x00, 0xC1,
+			0x63, 0x02, 0x07, 0x01, 0x46, 0x73, 0x4A, 0x69, 0xD6, 0xAC, 0xEA, 0x74, 0xF1, 0x14, 0xD6, 0x2F, 0x5E, 0x3A, 0xC4, 0x74,
+			0x84, 0x23, 0xD4, 0x61, 0x58, 0x67, 0x8B, 0xDA, 0xCE, 0xEB, 0xCB, 0x29, 0x9A, 0x9A, 0xCC, 0x3D, 0xF5, 0xD9, 0xE9, 0xE9,
+			0xA9, 0x11, 0x90, 0x1C, 0xF6, 0x42, 0x97, 0x4F, 0x71, 0x0D, 0x0D, 0x6C, 0x63, 0x63, 0x83, 0x15, 0x58, 0xCC, 0x5D, 0x70,
+			0xB3, 0xA0, 0x35, 0x81, 0x90, 0x66, 0xBD, 0xF6, 0xD2, 0xAC, 0x05, 0xE9, 0x80, 0x7A, 0x3E, 0xED, 0x02, 0x33, 0x32, 0x92,
+			0x03, 0x09, 0xCD, 0x45, 0xD1, 0xAD, 0x35, 0xED, 0x76, 0xBB, 0xF0, 0x11, 0x18, 0xE3, 0x33, 0xAF, 0x5A, 0x5F, 0x5F, 0xC7,
+			0x6D, 0x97, 0xCF, 0x2B, 0xD8, 0x59, 0xEA, 0x8A, 0x58, 0x2C, 0x86, 0x27, 0x2E, 0x1D, 0x0C, 0x41, 0x9F, 0x55, 0x33, 0x94,
+			0x9E, 0xFC, 0xD2, 0x85, 0x89, 0x84, 0x88, 0xAB, 0x4A, 0x41, 0x88, 0x9B, 0xD1, 0xBB, 0xD2, 0xCA, 0x49, 0xDB, 0xD5, 0xEF,
+			0xF7, 0xF9, 0x3E, 0xC4, 0x38, 0x16, 0x10, 0x44, 0x49, 0xC5, 0x32, 0x2F, 0x6E, 0x35, 0x60, 0x0E, 0xA3, 0x8C, 0x4C, 0x26,
+			0x03, 0x6C, 0x02, 0x81, 0x17, 0x58, 0xA6, 0xDF, 0xEF, 0x63, 0x02, 0xAA, 0xC3, 0x0A, 0xE2, 0x04, 0x5B, 0x0E, 0x13, 0xA1,
+			0x62, 0xB1, 0xB8, 0xDA, 0x72, 0x56, 0x2A, 0x15, 0x7D, 0xBB, 0x7A, 0x0F, 0x99, 0x4C, 0x46, 0xE6, 0x74, 0xC0, 0xCA, 0xA8,
+			0x07, 0xE5, 0xE0, 0x41, 0x9A, 0x05, 0x2C, 0xAE, 0xD7, 0xEB, 0xB4, 0x51, 0xD4, 0x86, 0x28, 0xEB, 0x30, 0xCF, 0xA1, 0x25,
+			0xC4, 0x66, 0xA0, 0xD1, 0x68, 0xD0, 0xAA, 0x37, 0x9B, 0x4D, 0x19, 0xB5, 0x5B, 0xA4, 0x77, 0x83, 0x4D, 0xE3, 0xAB, 0xB9,
+			0x53, 0xCE, 0x78, 0x9C, 0x8E, 0x92, 0xE8, 0x03, 0x4F, 0x09, 0x67, 0x21, 0x3C, 0xD1, 0xD8, 0xAD, 0x2F, 0xDE, 0x05, 0xA0,
+			0x04, 0x43, 0x4B, 0x89, 0x30, 0x39, 0xC1, 0x22, 0xF0, 0x71, 0x98, 0xB4, 0xD0, 0x43, 0x1A, 0x60, 0x7B, 0xD6, 0x2E, 0x2F,
+			0x4A, 0x33, 0x2C, 0x67, 0x15, 0x35, 0xE3, 0x5A, 0xF6, 0xC4, 0xDF, 0x94, 0x54, 0x18, 0xF5, 0x93, 0xB6, 0x25, 0xEB, 0x32,
+			0x6B, 0xDF, 0x8B, 0xA6, 0x02, 0xBC, 0x38, 0x93, 0x2E, 0x9E, 0xA7, 0xF0, 0x3A, 0x79, 0x10, 0xF1, 0x0A, 0xA5, 0x52, 0xA9,
+			0x56, 0xAB, 0xD1, 0xC3, 0x31, 0xB0, 0x31, 0xD1, 0xB6, 0x5B, 0xD9, 0x2F, 0xCB, 0x26, 0xF6, 0xE7, 0x31, 0x27, 0xA0, 0x24,
+			0x64, 0xAA, 0xC6, 0x08, 0x8E, 0xD7, 0x44, 0x79, 0xCF, 0x11, 0x62, 0x8A, 0x0E, 0xB1, 0x0A, 0xC4, 0x0C, 0xA1, 0x3F, 0x56,
+			0xC0, 0x38, 0x3F, 0x37, 0x1A, 0x0D, 0xE8, 0x00, 0xEC, 0x73, 0xA6, 0x25, 0x62, 0xBA, 0x05, 0x0D, 0x74, 0x77, 0x77, 0xB7,
+			0x5E, 0xAF, 0x63, 0xF8, 0xCC, 0xE5, 0x84, 0x57, 0xCA, 0x66, 0x7F, 0xC8, 0xC7, 0x1A, 0x51, 0xA2, 0x94, 0xA1, 0x2A, 0x84,
+			0x9E, 0x74, 0x74, 0x74, 0xF4, 0xD9, 0xF0, 0x1E, 0x0A, 0x1A, 0xD5, 0x2E, 0x77, 0x9C, 0x7C, 0x1C, 0xC4, 0x16, 0x7D, 0x2C,
+			0x8F, 0xA2, 0x5E, 0xAF, 0xE7, 0x72, 0xB9, 0x17, 0x26, 0xD7, 0xF8, 0x08, 0x32, 0x2F, 0x11, 0xC9, 0xB8, 0xD3, 0xE9, 0x3C,
+			0x3B, 0x7A, 0xE5, 0xB9, 0xE0, 0x0A, 0x08, 0xC3, 0x57, 0x2A, 0x8F, 0xB3, 0x54, 0x8E, 0x4D, 0x1B, 0x94, 0x5F, 0x74, 0x90,
+			0x4C, 0x5F, 0x5F, 0x4F, 0xBF, 0x99, 0x4C, 0x26, 0x5F, 0xF6, 0xDF, 0x48, 0xA7, 0xD3, 0x3F, 0xAF, 0xFC, 0xEB, 0x74, 0x3A,
+			0xFD, 0x97, 0x33, 0xEF, 0x03, 0xC9, 0x72, 0x92, 0x89, 0xA4, 0x5A, 0x09, 0xA9, 0x39, 0xB0, 0x06, 0x33, 0xF0, 0x20, 0xE4,
+			0x2B, 0x2E, 0x75, 0x34, 0xDF, 0x1A, 0xC1, 0x4E, 0xB0, 0x21, 0xC1, 0x4E, 0x8B, 0x8F, 0xA4, 0x4D, 0xD5, 0xAE, 0x18, 0xB5,
+			0x95, 0xC2, 0x03, 0x5B, 0xAD, 0x96, 0x86, 0xBA, 0x5A, 0x8E, 0x24, 0x4D, 0x22, 0x5F, 0x99, 0xCA, 0x2C, 0x70, 0x70, 0xAD,
+			0x18, 0x11, 0x02, 0x4C, 0x8B, 0x80, 0x92, 0x1F, 0xBA, 0xDD, 0xAA, 0x40, 0x5A, 0x9B, 0x5F, 0x49, 0x6F, 0x0C, 0x81, 0x69,
+			0x14, 0xF0, 0x0B, 0x22, 0xC5, 0xC2, 0xE6, 0x54, 0x3F, 0xC1, 0x97, 0x45, 0xD0, 0x97, 0x8E, 0x81, 0x9E, 0x15, 0x04, 0x2C,
+			0x93, 0xC9, 0xA0, 0x52, 0x4C, 0x24, 0x12, 0xFC, 0x33, 0xA5, 0x24, 0x03, 0x76, 0xF7, 0x39, 0x42, 0x71, 0xB5, 0xA9, 0xB8,
+			0x71, 0x96, 0x14, 0x98, 0x81, 0x7B, 0x8D, 0x19, 0xF9, 0x2D, 0x37, 0x8E, 0x51, 0x0A, 0xB3, 0xDE, 0x9C, 0xC3, 0x03, 0x9B,
+			0x06, 0x7E, 0x33, 0xF8, 0x0A, 0x5D, 0x26, 0xF8, 0x2D, 0x1D, 0x89, 0xD6, 0xB3, 0x1C, 0x1D, 0x1D, 0x11, 0x87, 0xC1, 0x70,
+			0x8C, 0x34, 0xEC, 0xF8, 0x6F, 0x90, 0xBE, 0x56, 0xCB, 0x23, 0x00, 0x40, 0xF2, 0x16, 0x1B, 0xDA, 0xC5, 0x43, 0xC1, 0x6C,
+			0x0A, 0xA8, 0x99, 0x09, 0x30, 0xA5, 0x0C, 0x14, 0x29, 0xD6, 0x9B, 0x33, 0x8C, 0x24, 0x87, 0xC1, 0x74, 0x5F, 0x14, 0x02,
+			0x84, 0x15, 0xB0, 0x2A, 0x46, 0x70, 0xA0, 0x07, 0x0C, 0x9A, 0x35, 0x21, 0x44, 0x59, 0x60, 0x06, 0xE5, 0xEE, 0xFF, 0x84,
+			0x6F, 0x0B, 0xD2, 0xCD, 0xC7, 0x66, 0xCC, 0x4B, 0x75, 0x23, 0x23, 0x04, 0x08, 0x33, 0x78, 0x68, 0xB0, 0x62, 0x1F, 0x40,
+			0x4D, 0xA3, 0xCB, 0x72, 0xB9, 0x4C, 0xD9, 0x0E, 0x3C, 0x27, 0x08, 0x12, 0xE3, 0x56, 0x6D, 0x17, 0xE0, 0xAE, 0x92, 0x51,
+			0x4B, 0xA5, 0x12, 0x0C, 0x59, 0xEA, 0x26, 0x0C, 0x5E, 0xB0, 0xB3, 0x86, 0x1A, 0x45, 0x79, 0xE2, 0xB2, 0xBE, 0x11, 0x34,
+			0x79, 0xA6, 0xAC, 0x41, 0x80, 0xDE, 0x8A, 0x3B, 0x20, 0xC7, 0x91, 0x86, 0x03, 0x10, 0xAD, 0xD5, 0x6A, 0xA1, 0x11, 0x23,
+			0x0F, 0x43, 0x0E, 0x21, 0x28, 0x54, 0xAB, 0x55, 0x02, 0xB7, 0xF0, 0x77, 0x1C, 0x84, 0x48, 0x36, 0xA0, 0x13, 0x2C, 0x9F,
+			0xAA, 0x56, 0xAB, 0xC4, 0x50, 0x56, 0xBC, 0x5A, 0x38, 0x74, 0xC9, 0x00, 0xB5, 0x14, 0x84, 0x74, 0xBE, 0x5D, 0xED, 0x45,
+			0x12, 0x43, 0x43, 0x06, 0x09, 0xCC, 0x66, 0x89, 0xA1, 0x34, 0xD4, 0xCA, 0xD3, 0xB2, 0x2D, 0x62, 0x12, 0x25, 0xCF, 0x29,
+			0x81, 0xE9, 0xAB, 0x6D, 0xBB, 0xD2, 0x80, 0x2A, 0x35, 0x5D, 0x2A, 0xC6, 0x74, 0xDA, 0x09, 0xAE, 0x04, 0xC0, 0x57, 0x40,
+			0x81, 0xAC, 0x4D, 0x64, 0xAB, 0x96, 0x5E, 0x4C, 0x14, 0xC0, 0x8E, 0xF7, 0xF6, 0xF6, 0xD8, 0x6F, 0x4C, 0x43, 0x86, 0xBB,
+			0x4E, 0x3C, 0x1E, 0xAF, 0xD5, 0x6A, 0x4C, 0x9F, 0x22, 0x91, 0x08, 0xCC, 0x4E, 0x8C, 0x10, 0xB4, 0x93, 0x96, 0x9D, 0x4D,
+			0xDA, 0x4F, 0x00, 0x80, 0xC3, 0xFE, 0x1F, 0x12, 0x6D, 0x3E, 0x9F, 0xDF, 0xDD, 0xDD, 0x25, 0x23, 0xC2, 0xE9, 0x64, 0xD9,
+			0x24, 0xE3, 0x41, 0x22, 0xA0, 0xCE, 0xA7, 0xEF, 0xFB, 0x7B, 0x7B, 0x7B, 0x5A, 0x99, 0x19, 0x8B, 0xC5, 0xCE, 0x9C, 0x39,
+			0xC3, 0x77, 0x01, 0x1E, 0x4A, 0x81, 0x52, 0xAD, 0x56, 0x99, 0x45, 0x6B, 0xED, 0x1F, 0x5C, 0x52, 0xD6, 0xC5, 0xB0, 0x69,
+			0xF6, 0xF8, 0xF8, 0xF8, 0xBE, 0xC9, 0x9D, 0xAC, 0x15, 0xEB, 0x74, 0x3A, 0x4F, 0x9F, 0x5E, 0x66, 0x21, 0x14, 0xC6, 0x00,
+			0x48, 0xDB, 0x72, 0xB9, 0xDC, 0xE3, 0xD9, 0x4B, 0xC0, 0xE8, 0x78, 0xFA, 0x5F, 0x9C, 0xDE, 0x25, 0x14, 0x54, 0x0C, 0xD7,
+			0xCB, 0xB3, 0x57, 0x39, 0x2D, 0x4F, 0x6D, 0x3E, 0x49, 0x47, 0x28, 0xE1, 0x15, 0x9D, 0x25, 0xB3, 0x0A, 0xF4, 0x25, 0xB1,
+			0x58, 0xEC, 0xF9, 0xF1, 0xD5, 0xBD, 0xBD, 0xBD, 0x8B, 0xD3, 0xBB, 0xD2, 0xE9, 0xF4, 0x9D, 0x9D, 0x4F, 0x14, 0x8B, 0xC5,
+			0x47, 0x62, 0x17, 0x32, 0x99, 0xCC, 0x85, 0xF1, 0x1D, 0xE8, 0xF3, 0x5B, 0xAD, 0x16, 0x81, 0x15, 0x03, 0x15, 0x42, 0xBF,
+			0x9C, 0x15, 0xF8, 0x76, 0xA4, 0xF5, 0x15, 0xBC, 0x43, 0x76, 0xD4, 0x52, 0x07, 0x55, 0xD6, 0x80, 0x1B, 0xD0, 0x2B, 0xE4,
+			0xE9, 0x06, 0xA0, 0x2F, 0xD7, 0x36, 0x61, 0x47, 0x62, 0x04, 0x80, 0xB0, 0x6B, 0x89, 0xB7, 0xCE, 0xAD, 0xEC, 0x43, 0x64,
+			0x43, 0x0D, 0x7F, 0x4C, 0xF5, 0x22, 0x11, 0x5F, 0xC5, 0x1C, 0xE7, 0x96, 0xC5, 0xE8, 0x90, 0x62, 0xC1, 0xD3, 0x04, 0x72,
+			0x52, 0x68, 0xB3, 0xBB, 0x31, 0x97, 0xCB, 0xD5, 0x6A, 0x35, 0x0E, 0xB6, 0xFC, 0x93, 0x11, 0x69, 0xB6, 0x5A, 0x2D, 0x8C,
+			0x29, 0x59, 0x32, 0x08, 0x72, 0xE5, 0x22, 0xBF, 0xC5, 0x19, 0xB0, 0x75, 0x0E, 0x06, 0x4D, 0x00, 0xE5, 0x11, 0xEB, 0x9A,
+			0xF3, 0xF9, 0x3C, 0x5A, 0x59, 0x59, 0x40, 0xBB, 0xE0, 0x97, 0x3A, 0x3E, 0x3E, 0x66, 0x39, 0x3B, 0x36, 0x27, 0x04, 0x4D,
+			0x19, 0xE5, 0x6A, 0xCE, 0xCF, 0xDF, 0x67, 0x02, 0x64, 0x5C, 0x1A, 0x37, 0x58, 0x62, 0x14, 0x81, 0x76, 0x0A, 0xD0, 0x9B,
+			0xD2, 0x9E, 0x3B, 0xC8, 0x73, 0xE0, 0x9C, 0x53, 0x3E, 0x62, 0x63, 0x83, 0xDD, 0x3D, 0x51, 0x48, 0xF2, 0x3A, 0x5C, 0x76,
+			0x18, 0x6E, 0xE9, 0x51, 0x73, 0x00, 0xA8, 0x11, 0xB9, 0x0B, 0x14, 0xEE, 0xF6, 0x81, 0x97, 0xE6, 0x05, 0x08, 0x9E, 0x35,
+			0xF3, 0x03, 0x7F, 0xC6, 0xB2, 0x82, 0x99, 0xE8, 0x78, 0x3C, 0x36, 0x0A, 0x6C, 0xB1, 0x58, 0x64, 0x74, 0x49, 0x74, 0xD0,
+			0xDE, 0x1C, 0x5E, 0xBD, 0xD3, 0xE9, 0x40, 0xB9, 0x23, 0xD6, 0x03, 0x11, 0x22, 0x95, 0x82, 0x0F, 0xC3, 0x29, 0x81, 0x8A,
+			0xCB, 0x5F, 0x83, 0x6A, 0xDA, 0x6E, 0xB7, 0x19, 0xAB, 0xA2, 0x57, 0x44, 0xDA, 0x43, 0xAE, 0xC6, 0xAD, 0x81, 0xA6, 0x5E,
+			0x75, 0x07, 0x75, 0x2E, 0x4B, 0xD7, 0x34, 0x69, 0xA4, 0x62, 0x65, 0x55, 0x1B, 0x64, 0x50, 0x9A, 0x15, 0xF1, 0xCD, 0x09,
+			0x43, 0x58, 0x2D, 0xCA, 0x01, 0x95, 0x3C, 0x04, 0xBF, 0x4A, 0x58, 0x13, 0xC8, 0x32, 0x62, 0x60, 0x9C, 0x14, 0x39, 0x3D,
+			0xC6, 0x84, 0x75, 0xA7, 0x7C, 0x67, 0x67, 0xC7, 0x7C, 0x7A, 0x9D, 0x4B, 0x01, 0x53, 0x20, 0x9C, 0x84, 0xC9, 0x93, 0x98,
+			0xE2, 0x91, 0x0E, 0x99, 0xC6, 0x10, 0xA1, 0x58, 0xF0, 0xC2, 0xF4, 0x02, 0x94, 0x8C, 0x34, 0xD9, 0x68, 0x34, 0x5C, 0x7F,
+			0x62, 0x3E, 0x68, 0x6C, 0xB9, 0xA2, 0x9C, 0xC7, 0xEB, 0x02, 0x13, 0x73, 0xF9, 0x5E, 0x01, 0xAA, 0x8A, 0x79, 0x09, 0xD4,
+			0x28, 0xD3, 0x5D, 0x5D, 0x33, 0x8A, 0x2F, 0xF8, 0x33, 0xAA, 0xE6, 0x64, 0xCF, 0x00, 0xE0, 0xCE, 0x79, 0xE2, 0x61, 0x8A,
+			0xB0, 0xC1, 0x9D, 0xD1, 0x02, 0x39, 0x82, 0xA3, 0xC8, 0xA6, 0x6A, 0x11, 0xC4, 0xF9, 0x01, 0x3A, 0xA0, 0xDD, 0x06, 0xE2,
+			0x44, 0xA4, 0xAE, 0xB5, 0xC3, 0x52, 0x2A, 0x52, 0xBA, 0xEE, 0xEC, 0xEC, 0x90, 0xF0, 0x18, 0xEA, 0x62, 0x80, 0x7E, 0x7C,
+			0x7C, 0xCC, 0xDF, 0xE7, 0xB0, 0x5A, 0xBC, 0x71, 0x48, 0x3D, 0xA4, 0x69, 0xEA, 0x2F, 0x31, 0x9A, 0x3A, 0x9D, 0x0E, 0x82,
+			0x5E, 0x1E, 0x05, 0xB7, 0x91, 0x4D, 0x4F, 0x40, 0x13, 0x3C, 0x5B, 0x20, 0xB8, 0xC1, 0x60, 0xB0, 0xB7, 0xB7, 0x27, 0x4F,
+			0x0B, 0x2D, 0x3D, 0xE7, 0x5B, 0x63, 0xE8, 0x02, 0xAD, 0xFB, 0xE8, 0xE8, 0x48, 0xAA, 0x77, 0x38, 0xF8, 0x24, 0x12, 0x06,
+			0x77, 0xFC, 0x2E, 0xE6, 0x1C, 0x5F, 0x6F, 0x3F, 0xCB, 0x56, 0x71, 0xF8, 0xC1, 0x9B, 0x9B, 0x9B, 0x5A, 0x62, 0x1C, 0x8F,
+			0xC7, 0x1F, 0x89, 0x5D, 0xF8, 0x6A, 0xF3, 0x19, 0x2A, 0x65, 0x48, 0xE2, 0x74, 0x78, 0xFF, 0xFD, 0xE0, 0x5B, 0x7C, 0x84,
+			0xCF, 0x25, 0x2E, 0xA2, 0x42, 0x58, 0x5B, 0x5B, 0x3B, 0x3E, 0x3E, 0xC6, 0xB9, 0x93, 0xBD, 0x34, 0xE7, 0x86, 0xB7, 0x12,
+			0x35, 0x1A, 0x8D, 0xC6, 0x97, 0xCB, 0x5F, 0x00, 0xDF, 0x00, 0xC3, 0x29, 0x95, 0x4A, 0xC7, 0xC7, 0xC7, 0xD7, 0xE2, 0x37,
+			0x66, 0xB3, 0x19, 0xCD, 0xEB, 0x60, 0x30, 0xB8, 0x6F, 0x72, 0x27, 0x57, 0x09, 0x53, 0x45, 0xDC, 0xC1, 0x60, 0x73, 0x43,
+			0x4A, 0xD9, 0xDE, 0xDE, 0x6E, 0xB7, 0xDB, 0xAF, 0xA7, 0xDF, 0x14, 0xEB, 0x86, 0xDB, 0x0A, 0x50, 0x23, 0x56, 0x02, 0xFD,
+			0xA2, 0x12, 0x36, 0x81, 0x5E, 0xD4, 0x14, 0x50, 0x69, 0x0A, 0x20, 0xBE, 0xEE, 0xD5, 0xA3, 0x42, 0x0F, 0x4D, 0x3D, 0xA1,
+			0x74, 0x45, 0x1D, 0x8D, 0x8F, 0x10, 0xE9, 0x84, 0xCF, 0xA8, 0x61, 0xB2, 0x76, 0x0D, 0xC9, 0x54, 0x8E, 0x02, 0x85, 0x29,
+			0x23, 0xB1, 0x55, 0x06, 0xA2, 0xB2, 0xA5, 0x62, 0x24, 0x06, 0x77, 0x9C, 0x44, 0x05, 0x11, 0xBE, 0xDD, 0x6E, 0xD3, 0xCC,
+			0x01, 0x1B, 0x30, 0x98, 0xA4, 0x83, 0x3C, 0x3E, 0x3E, 0xA6, 0x17, 0xC1, 0xDD, 0x41, 0xBD, 0x23, 0xB7, 0x80, 0xE5, 0x5F,
+			0xD4, 0xAF, 0x50, 0x4B, 0x55, 0x10, 0x98, 0x98, 0xCE, 0x1D, 0x3F, 0x66, 0x48, 0x68, 0x62, 0x9B, 0xCD, 0x26, 0x0B, 0x71,
+			0x71, 0x18, 0xE4, 0x1A, 0xF2, 0x25, 0x4E, 0x26, 0x13, 0x26, 0x94, 0xC8, 0xFE, 0x59, 0xCB, 0x05, 0xE7, 0x15, 0x4E, 0x27,
+			0x45, 0x09, 0xE5, 0x36, 0x27, 0x2D, 0xEF, 0x7E, 0x10, 0xFC, 0x83, 0x06, 0x8B, 0x78, 0x4D, 0x60, 0xE4, 0x64, 0x32, 0x36,
+			0x60, 0x51, 0x0C, 0x00, 0x94, 0x86, 0x6A, 0x78, 0x2E, 0xF0, 0xAC, 0x08, 0xBF, 0xA4, 0x55, 0x1A, 0x6B, 0x08, 0x29, 0xF0,
+			0xD7, 0xA9, 0x74, 0x21, 0xAA, 0x31, 0xD3, 0x42, 0x5D, 0x8F, 0x62, 0x86, 0x2A, 0x13, 0xCC, 0x07, 0x1A, 0x12, 0x5D, 0x11,
+			0x18, 0xB8, 0x56, 0x0A, 0x47, 0xE4, 0x7C, 0x04, 0xA3, 0x96, 0xE6, 0x91, 0xA5, 0x42, 0x1C, 0xA3, 0xE1, 0x70, 0x58, 0xA9,
+			0x54, 0xB0, 0xC7, 0x22, 0xB7, 0x2B, 0x52, 0x00, 0xBD, 0x01, 0xCB, 0x50, 0x75, 0x22, 0xBE, 0x70, 0xE7, 0xD0, 0x5A, 0x4E,
+			0x32, 0x33, 0x82, 0x7E, 0xD9, 0x47, 0x03, 0x54, 0x41, 0xB3, 0x15, 0xD9, 0x0B, 0x76, 0x2A, 0xFF, 0x8A, 0x5C, 0xAD, 0x0D,
+			0xEC, 0x58, 0xFD, 0xB0, 0x00, 0x9A, 0x36, 0x0D, 0x81, 0x1F, 0x28, 0x8D, 0xC9, 0x68, 0xDD, 0x37, 0x1D, 0x8D, 0x46, 0x59,
+			0x2C, 0x25, 0xB3, 0x56, 0xB6, 0xCB, 0xF6, 0x7A, 0xBD, 0xAD, 0xAD, 0x2D, 0xD2, 0x03, 0xA3, 0x3C, 0xC4, 0x0A, 0x38, 0x2C,
+			0x6E, 0x6E, 0x6E, 0x62, 0x29, 0x0C, 0x1F, 0x3C, 0x97, 0xCB, 0x35, 0x1A, 0x8D, 0x20, 0x08, 0x54, 0xEC, 0x1B, 0xE6, 0xEE,
+			0x36, 0x40, 0x4D, 0x26, 0x13, 0xCE, 0x0A, 0x70, 0xB0, 0x5C, 0xC5, 0x21, 0xD4, 0x53, 0x5B, 0xE1, 0x30, 0x43, 0x6F, 0x4B,
+			0x28, 0x81, 0xBC, 0x08, 0x45, 0x6C, 0x73, 0x73, 0x13, 0xFB, 0x75, 0x46, 0x2C, 0x58, 0x28, 0xF3, 0x91, 0xC1, 0x43, 0x49,
+			0x69, 0x62, 0x40, 0x09, 0x9F, 0xD1, 0x22, 0x65, 0xB5, 0xF0, 0xF2, 0x63, 0xD1, 0xFC, 0x87, 0x4F, 0xAD, 0x19, 0xA0, 0x44,
+			0x3D, 0x44, 0x28, 0x82, 0x3E, 0xED, 0x02, 0xBF, 0x7D, 0xBE, 0xFC, 0x11, 0xD7, 0x5B, 0x6D, 0xA6, 0x4C, 0x22, 0xF5, 0xDB,
+			0x71, 0x82, 0x82, 0x08, 0xB8, 0xD8, 0xE4, 0xB4, 0xEC, 0xD6, 0xA1, 0xBA, 0xF3, 0x2D, 0xA3, 0xDD, 0x6B, 0x34, 0x1A, 0x82,
+			0xEC, 0x41, 0xE1, 0xC4, 0x51, 0x01, 0xCD, 0x93, 0x44, 0x5C, 0x33, 0x2E, 0xFC, 0x65, 0x79, 0xCF, 0xCC, 0xDF, 0x18, 0xC3,
+			0x50, 0x1D, 0x63, 0x34, 0xC4, 0x13, 0xC6, 0xBF, 0x05, 0x4E, 0x2D, 0x2C, 0x49, 0xA2, 0x18, 0x94, 0xA4, 0xED, 0xED, 0x6D,
+			0xB8, 0x5B, 0x08, 0x6A, 0xB0, 0xDC, 0x6A, 0xB5, 0x5A, 0xA2, 0x4C, 0xD0, 0x71, 0xF3, 0xA9, 0xBB, 0xDD, 0x2E, 0x78, 0xD7,
+			0x68, 0x34, 0xBA, 0xE4, 0x9F, 0x53, 0x75, 0xE2, 0xBE, 0x93, 0x51, 0xAF, 0xD7, 0x3B, 0x38, 0x38, 0x90, 0xF4, 0xE6, 0x81,
+			0xF9, 0x59, 0x7A, 0xF0, 0xAD, 0xAD, 0x2D, 0x4E, 0xF8, 0xEE, 0xEE, 0xEE, 0x7F, 0xDD, 0xF9, 0x4F, 0xE6, 0x99, 0xE1, 0xF0,
+			0x2B, 0x4C, 0xAA, 0x61, 0x31, 0xBC, 0x12, 0xF9, 0x11, 0xB3, 0x3B, 0xDC, 0x81, 0x38, 0x42, 0xAC, 0xB8, 0xA0, 0x10, 0x7B,
+			0x76, 0xF4, 0x0A, 0x04, 0xD6, 0xD1, 0x68, 0x74, 0xDF, 0xE4, 0x4E, 0xB2, 0x3B, 0x42, 0x21, 0x38, 0x85, 0x64, 0xE5, 0x5E,
+			0xAF, 0x87, 0x6B, 0xDB, 0x33, 0xFD, 0x97, 0xC0, 0xB8, 0x9E, 0xE9, 0xBF, 0x04, 0x27, 0xF5, 0xCE, 0xCE, 0x27, 0xA8, 0x7C,
+			0xB5, 0x1A, 0x1A, 0xC4, 0x86, 0x0F, 0x25, 0xE7, 0x2F, 0xBE, 0xD6, 0xD5, 0x6B, 0x2B, 0xD7, 0x71, 0xE5, 0x5A, 0xF9, 0x3A,
+			0xA8, 0x81, 0x50, 0xE9, 0x00, 0xE4, 0x22, 0x39, 0x31, 0x5E, 0x4F, 0x9A, 0xDF, 0x2A, 0x03, 0x69, 0x4E, 0xA0, 0x2E, 0x93,
+			0x70, 0x8F, 0x58, 0x07, 0x56, 0xB1, 0x7A, 0x14, 0x1D, 0x2A, 0xC6, 0x12, 0xA2, 0xAE, 0x69, 0xC7, 0x3D, 0x08, 0x04, 0x50,
+			0x2A, 0xEA, 0xFA, 0xD5, 0x20, 0x8E, 0x74, 0x14, 0x06, 0x97, 0xCB, 0x3E, 0x71, 0x18, 0x35, 0xBA, 0x41, 0x0C, 0xF3, 0xBA,
+			0xDD, 0x2E, 0x64, 0x0D, 0xAA, 0x43, 0x5C, 0xB9, 0xA4, 0x9B, 0x15, 0x74, 0x0E, 0x14, 0x81, 0x90, 0x90, 0xE6, 0x40, 0xA3,
+			0x0B, 0x4C, 0xD6, 0x58, 0xCF, 0xC2, 0x1D, 0xC1, 0x54, 0x6A, 0xDD, 0xFD, 0x40, 0xD9, 0xE2, 0x4C, 0x62, 0xF0, 0xE9, 0xAA,
+			0x05, 0x5B, 0xF8, 0x45, 0xC9, 0x2F, 0x1A, 0x3A, 0x67, 0x0F, 0xC2, 0xB7, 0xDA, 0x7A, 0xCE, 0x89, 0x76, 0xBB, 0x37, 0x1A,
+			0x0D, 0xA1, 0xD6, 0x84, 0x66, 0xEA, 0x48, 0x7A, 0x50, 0xAD, 0xDA, 0xC5, 0x6D, 0x53, 0x2B, 0x07, 0x80, 0x3D, 0xC0, 0x09,
+			0xE8, 0x1E, 0xD8, 0xE6, 0x8B, 0x30, 0x96, 0x98, 0x4C, 0xEC, 0x65, 0x41, 0xBA, 0xDE, 0x39, 0xCB, 0x9C, 0x17, 0xD2, 0x07,
+			0x18, 0x8A, 0x20, 0xFB, 0xE8, 0xF1, 0x18, 0x60, 0xD2, 0x3B, 0xD0, 0xAD, 0x34, 0x1A, 0x0D, 0xD6, 0x03, 0x2C, 0x83, 0x86,
+			0x45, 0x7C, 0x2C, 0xD4, 0x11, 0xC1, 0x92, 0x27, 0x61, 0xE6, 0x42, 0x72, 0xE2, 0x69, 0xF2, 0xDD, 0x08, 0xB0, 0xCE, 0x66,
+			0xB3, 0x20, 0x62, 0xF4, 0x32, 0xE4, 0x3A, 0x8D, 0x73, 0x79, 0x3A, 0xDA, 0x11, 0x21, 0x57, 0x13, 0x46, 0x67, 0x94, 0xEA,
+			0x8C, 0xC2, 0xE9, 0xFA, 0x89, 0x7A, 0x1A, 0xE2, 0x43, 0xD2, 0xDA, 0xDF, 0xDF, 0x47, 0x2B, 0x8F, 0xE2, 0x19, 0xAC, 0x10,
+			0xF0, 0x6E, 0x7B, 0x7B, 0x1B, 0x72, 0x21, 0x50, 0x43, 0x22, 0x91, 0xD8, 0xD8, 0xD8, 0xA0, 0x30, 0xE4, 0x9C, 0x31, 0xF0,
+			0x00, 0x91, 0x14, 0xCE, 0xCB, 0x84, 0x93, 0xFF, 0x57, 0xAB, 0xD5, 0xB0, 0xB2, 0x38, 0x38, 0x38, 0x60, 0x94, 0xAD, 0x35,
+			0x9E, 0xF8, 0x2E, 0x89, 0x77, 0x01, 0x88, 0x49, 0xD5, 0xCF, 0x87, 0xE5, 0x3B, 0xC6, 0x4E, 0x43, 0xEB, 0x61, 0xC1, 0xCD,
+			0xD1, 0xE5, 0xAE, 0x65, 0x62, 0xCD, 0x61, 0x34, 0x1D, 0x9D, 0xE1, 0xB7, 0xDC, 0x1C, 0x46, 0xEB, 0x3D, 0x8F, 0x61, 0xEF,
+			0x5A, 0x26, 0xB6, 0xD7, 0xB1, 0x3F, 0xCF, 0xC4, 0xAC, 0xA0, 0x3B, 0x9E, 0x24, 0x1B, 0x83, 0xC8, 0x46, 0x21, 0x95, 0x89,
+			0x85, 0xD9, 0xF8, 0x7C, 0xF7, 0x64, 0x9A, 0x4F, 0xFA, 0xFC, 0x7F, 0x84, 0xC4, 0x49, 0x7F, 0x92, 0x8D, 0xCF, 0x4B, 0xD9,
+			0x78, 0x63, 0x10, 0xB1, 0x50, 0x98, 0x89, 0x65, 0xE3, 0x66, 0x13, 0xCD, 0x8B, 0xF0, 0x82, 0x07, 0xA7, 0xF3, 0x62, 0x3A,
+			0xBA, 0x9E, 0x4B, 0x64, 0x62, 0xE1, 0x5E, 0x67, 0x86, 0x89, 0x74, 0x3A, 0x6A, 0x65, 0x75, 0x63, 0x10, 0x49, 0x78, 0x63,
+			0x5E, 0x7C, 0xBF, 0x1B, 0xFE, 0xF6, 0x28, 0x48, 0x78, 0x63, 0xA6, 0xD3, 0x12, 0x2D, 0xC7, 0xE7, 0x41, 0x67, 0x96, 0xDE,
+			0x3D, 0xB1, 0xF9, 0x18, 0xEA, 0x8A, 0xD5, 0x2D, 0x3D, 0x70, 0x72, 0x20, 0xF5, 0x0F, 0x06, 0x03, 0x36, 0x2E, 0xE5, 0xF3,
+			0xF9, 0xED, 0xED, 0x6D, 0x60, 0x62, 0xB8, 0x3A, 0x60, 0x3B, 0x32, 0xB0, 0x43, 0xA8, 0x89, 0x19, 0xA7, 0x25, 0xFF, 0x20,
+			0x68, 0x34, 0x1A, 0x6C, 0x31, 0x65, 0x24, 0xE0, 0x79, 0x1E, 0xE0, 0x06, 0x16, 0x4F, 0x18, 0xF3, 0xB1, 0x0D, 0x14, 0xE6,
+			0x15, 0xF2, 0x6F, 0x60, 0x56, 0x36, 0x40, 0xD1, 0x83, 0x46, 0x22, 0x91, 0x6A, 0xB5, 0x2A, 0xC4, 0x12, 0xBB, 0x40, 0x71,
+			0xA5, 0xC4, 0x91, 0x17, 0x3D, 0xE6, 0xC5, 0xE9, 0x0F, 0x00, 0x2A, 0xD9, 0x16, 0x00, 0x0C, 0x08, 0x05, 0x19, 0x8C, 0xCE,
+			0x46, 0xF7, 0xCE, 0xC8, 0x8F, 0x0D, 0xD8, 0x58, 0x7F, 0x03, 0xE3, 0x6C, 0x6C, 0x6C, 0x7C, 0xE2, 0xE0, 0x8F, 0x5E, 0x9A,
+			0xBF, 0x0E, 0x9D, 0x01, 0x3A, 0x50, 0xA3, 0xD1, 0x78, 0xB3, 0xFC, 0x9B, 0x52, 0xA9, 0x54, 0xAF, 0xD7, 0xA3, 0xD1, 0xE8,
+			0xF9, 0xE0, 0x76, 0x98, 0x08, 0xF0, 0x0E, 0xDB, 0xB7, 0x9D, 0x4A, 0xCC, 0xC1, 0x91, 0xE0, 0x91, 0x32, 0x06, 0xF0, 0x7D,
+			0xFF, 0xC2, 0xF8, 0x0E, 0x34, 0x89, 0xE7, 0x86, 0xB7, 0x22, 0x12, 0xC6, 0x21, 0x91, 0x18, 0xAA, 0xCC, 0xFD, 0xD0, 0xBD,
+			0xF7, 0x6A, 0x17, 0x18, 0x89, 0x5C, 0xF3, 0x30, 0xD9, 0xFD, 0x4B, 0x41, 0x8A, 0xFF, 0x36, 0xF1, 0x97, 0xFA, 0x5D, 0x76,
+			0x3D, 0x8F, 0x3D, 0xF8, 0x20, 0xC1, 0x71, 0x11, 0x23, 0x96, 0x2E, 0x40, 0x0F, 0x9F, 0x3F, 0xAF, 0xA2, 0x84, 0xE7, 0xA0,
+			0x95, 0x12, 0x22, 0x73, 0x6B, 0xED, 0x0C, 0x93, 0x33, 0xB1, 0x99, 0x57, 0xE5, 0x8A, 0x4F, 0x3E, 0xF2, 0x88, 0x50, 0x4D,
+			0x99, 0xD0, 0x91, 0xCF, 0xD8, 0x1B, 0x81, 0x5A, 0x1E, 0x67, 0x17, 0xF0, 0x28, 0x69, 0x38, 0x08, 0x47, 0xB4, 0x6B, 0xCD,
+			0x66, 0x13, 0x83, 0x77, 0x3A, 0x1B, 0xA0, 0x69, 0x34, 0xFC, 0x61, 0x18, 0x82, 0x3C, 0x53, 0xF0, 0x02, 0x18, 0x72, 0xC6,
+			0x40, 0xD5, 0xE5, 0xFB, 0x06, 0x53, 0x53, 0x9B, 0x0C, 0x60, 0x09, 0x33, 0x25, 0xE2, 0x81, 0x60, 0xEA, 0xA0, 0x47, 0x0D,
+			0xCE, 0xD1, 0xEB, 0xF5, 0xE8, 0x5A, 0x68, 0x4C, 0x57, 0x97, 0xA9, 0x59, 0x1E, 0x70, 0x6D, 0x04, 0x3B, 0xC2, 0x08, 0xA1,
+			0x3C, 0x01, 0xBA, 0x34, 0x10, 0x08, 0xB9, 0x40, 0x23, 0x9C, 0x24, 0x26, 0x74, 0x3A, 0x1D, 0x3D, 0x61, 0x08, 0xAF, 0x5A,
+			0x1D, 0x8C, 0xD8, 0x02, 0x7C, 0x02, 0xB2, 0xE5, 0xE1, 0xE1, 0xA1, 0x18, 0xC3, 0x48, 0x3D, 0xE0, 0x50, 0xC9, 0xA2, 0x8D,
+			0x5C, 0xCB, 0xF4, 0x71, 0x30, 0x18, 0x34, 0x1A, 0x0D, 0xE4, 0x75, 0x36, 0x89, 0x76, 0x9D, 0xE5, 0xC2, 0x0B, 0x84, 0x27,
+			0x45, 0x8E, 0x62, 0x6A, 0xC4, 0xA3, 0x67, 0x4F, 0x8D, 0x54, 0xE3, 0x04, 0x5F, 0x49, 0x8A, 0xB9, 0x21, 0x4C, 0x4D, 0xF5,
+			0xF9, 0x19, 0xAA, 0x00, 0x53, 0x64, 0xB3, 0xD9, 0x76, 0xBB, 0x0D, 0x55, 0x03, 0xD0, 0xC6, 0xD9, 0x8A, 0x85, 0x8B, 0x6D,
+			0xF4, 0x8E, 0x20, 0x81, 0x22, 0x19, 0x54, 0x51, 0x8B, 0x23, 0x44, 0xA6, 0x84, 0x0A, 0x42, 0x73, 0x0D, 0xA4, 0xC5, 0x5E,
+			0x1B, 0x5A, 0x04, 0x2D, 0x9F, 0x82, 0x1C, 0xD2, 0xE9, 0x74, 0x12, 0x89, 0x04, 0x35, 0x20, 0x2B, 0x24, 0xA1, 0x76, 0x81,
+			0x2D, 0x6C, 0x6C, 0x6C, 0x40, 0xF2, 0x21, 0x34, 0x93, 0x84, 0xD8, 0xD2, 0x99, 0x4A, 0xA5, 0x30, 0xD5, 0x03, 0xA7, 0x06,
+			0xEE, 0x27, 0xF4, 0x33, 0x68, 0x65, 0xCB, 0x3C, 0x0F, 0x8B, 0x11, 0x25, 0x2B, 0xD2, 0x92, 0xC9, 0x64, 0xBD, 0x5E, 0x87,
+			0xA0, 0x76, 0x78, 0x78, 0x88, 0xD7, 0x18, 0x33, 0xE4, 0xBD, 0xBD, 0x3D, 0x1A, 0x52, 0x1A, 0x91, 0xDD, 0xDD, 0x5D, 0x76,
+			0x9A, 0x83, 0x15, 0xE2, 0x1D, 0xA4, 0x07, 0x62, 0x15, 0xE5, 0x38, 0x1C, 0x5A, 0xEA, 0xB5, 0x0A, 0x3D, 0x98, 0x4C, 0x47,
+			0x93, 0x49, 0x30, 0xF5, 0xE6, 0xBE, 0x55, 0xCA, 0xD3, 0x99, 0x3F, 0x19, 0x1B, 0xB0, 0x63, 0x93, 0xB6, 0x49, 0xB8, 0xCC,
+			0x8E, 0x0B, 0x3B, 0x97, 0x25, 0x87, 0x2A, 0x3E, 0x1D, 0x4D, 0x47, 0x53, 0x8B, 0xA7, 0xD3, 0xB9, 0x17, 0x8F, 0x27, 0xA2,
+			0xB3, 0xE9, 0xDC, 0xF3, 0x23, 0x11, 0x2B, 0x8B, 0x8C, 0x89, 0x3F, 0x0E, 0x66, 0x9E, 0x15, 0xEC, 0xF1, 0x78, 0x64, 0x30,
+			0x9E, 0x45, 0x22, 0xB1, 0xF9, 0xDC, 0x9E, 0x61, 0x18, 0x2E, 0xE2, 0x82, 0x4D, 0xFD, 0x22, 0x91, 0xA8, 0xFF, 0x3B, 0x7E,
+			0xB7, 0x2B, 0x7E, 0x27, 0x4B, 0xDE, 0xF4, 0x7C, 0x30, 0xB6, 0xBE, 0x7E, 0xD0, 0x0F, 0x3C, 0x2F, 0x2A, 0xBE, 0x9A, 0xE7,
+			0x59, 0xC3, 0x01, 0xF0, 0x4A, 0xF4, 0x44, 0x30, 0x25, 0x3E, 0x65, 0x3A, 0x9D, 0x66, 0x3E, 0x8C, 0x34, 0x91, 0x6C, 0x0D,
+			0x85, 0x2C, 0x1A, 0x8D, 0x36, 0x9B, 0x4D, 0xFB, 0x5E, 0x96, 0x73, 0x48, 0x8E, 0x3B, 0xDC, 0x86, 0x5A, 0xAD, 0x06, 0xE0,
+			0xA3, 0x73, 0x05, 0x0C, 0x0A, 0x0E, 0x46, 0xE5, 0x05, 0x5E, 0x01, 0x11, 0x80, 0xD9, 0xCC, 0x70, 0x38, 0x44, 0x02, 0x46,
+			0x8B, 0x2D, 0xE7, 0x5A, 0x18, 0xA2, 0xBC, 0x67, 0x8D, 0x1F, 0xA1, 0x91, 0xF8, 0x47, 0x0B, 0xBB, 0x76, 0x04, 0x2E, 0xA4,
+			0xF9, 0xCF, 0xCF, 0xEE, 0xF7, 0x92, 0x8B, 0x85, 0x4A, 0x8C, 0x6D, 0x70, 0xCF, 0xAF, 0xD5, 0x6A, 0x78, 0x37, 0x02, 0x09,
+			0xE2, 0x0C, 0x01, 0x6F, 0x84, 0x7F, 0x05, 0xB9, 0x20, 0x9B, 0xCD, 0xDE, 0x71, 0xF2, 0xF1, 0x53, 0xDF, 0xC2, 0x7A, 0xAD,
+			0x56, 0x3B, 0x38, 0x38, 0xA8, 0x54, 0x2A, 0x77, 0x9F, 0xA6, 0x82, 0x84, 0x79, 0xC4, 0x2F, 0xBC, 0x6B, 0x96, 0x34, 0xD6,
+			0xAF, 0xB5, 0xBE, 0xFB, 0x07, 0xEF, 0x9E, 0x89, 0xDC, 0x61, 0x53, 0xC4, 0x6A, 0xB5, 0x0A, 0x67, 0x91, 0xBE, 0x3C, 0x08,
+			0x82, 0xCF, 0x27, 0xEF, 0x67, 0x7E, 0x88, 0x2B, 0x24, 0x17, 0xBE, 0xD1, 0x68, 0xFC, 0x64, 0xED, 0x57, 0xF7, 0x8F, 0xEF,
+			0x36, 0x27, 0x9C, 0xDF, 0xEC, 0x93, 0xBA, 0x3A, 0x9D, 0xCE, 0x37, 0x9E, 0x7B, 0x4E, 0xE0, 0x21, 0x51, 0x80, 0x5F, 0xA1,
+			0x21, 0x1F, 0x80, 0x2D, 0x51, 0x9B, 0x69, 0xB3, 0xFE, 0x95, 0x4C, 0x9B, 0x7D, 0xDF, 0xFF, 0xD6, 0x0B, 0x2F, 0xC8, 0x22,
+			0x9B, 0x29, 0x37, 0xFB, 0x06, 0xFE, 0xE9, 0xE9, 0xA7, 0xA1, 0x42, 0x88, 0x14, 0x24, 0x57, 0x1F, 0x65, 0x1D, 0x69, 0xD7,
+			0x51, 0xB7, 0x01, 0x24, 0xAE, 0xFA, 0xC1, 0xA5, 0x52, 0xA9, 0xA7, 0x2F, 0x5F, 0x16, 0x1D, 0x08, 0x6A, 0x09, 0x20, 0x30,
+			0x97, 0x11, 0xF6, 0x36, 0xA8, 0x32, 0xD5, 0xB4, 0x42, 0xB3, 0x81, 0xBA, 0x73, 0xD3, 0x9F, 0x62, 0xA2, 0x55, 0x2E, 0x97,
+			0x59, 0x6B, 0xC3, 0xE4, 0x13, 0xA6, 0x06, 0x72, 0x13, 0x46, 0x56, 0x44, 0x36, 0x4E, 0x08, 0xC5, 0x38, 0x75, 0x31, 0x57,
+			0x9E, 0x0A, 0x8F, 0xE1, 0x13, 0x4B, 0xAB, 0xC0, 0x3C, 0xF8, 0x3B, 0xD9, 0x6C, 0x56, 0x8B, 0x6F, 0x29, 0x26, 0x20, 0x26,
+			0x2D, 0x3C, 0x31, 0x5D, 0x87, 0xC7, 0xDC, 0x8B, 0x89, 0x3A, 0x8A, 0x19, 0xBA, 0x5E, 0xD6, 0x2D, 0x60, 0x22, 0x24, 0xFC,
+			0x9D, 0x1A, 0x65, 0x34, 0x6A, 0xE0, 0x42, 0xB6, 0x4A, 0xFF, 0x47, 0x34, 0x00, 0x64, 0x2F, 0x91, 0x04, 0xCC, 0x08, 0xAA,
+			0x19, 0x96, 0x8B, 0xE1, 0x65, 0x49, 0x23, 0xC2, 0x84, 0x09, 0xB2, 0x25, 0x49, 0xE2, 0xE8, 0xE8, 0x08, 0x74, 0x1A, 0x72,
+			0x0A, 0xAA, 0x7B, 0xBE, 0x08, 0xA8, 0x1C, 0x4C, 0xEC, 0x70, 0xF1, 0xE9, 0xF5, 0x7A, 0xD5, 0x6A, 0xD5, 0xCE, 0x40, 0xD2,
+			0xFD, 0x80, 0x54, 0x40, 0x3A, 0x26, 0x2B, 0x62, 0xC3, 0xCD, 0x1D, 0x00, 0xD8, 0x01, 0x78, 0xE1, 0x38, 0x4A, 0x5B, 0x4C,
+			0xF8, 0x63, 0x30, 0xD5, 0x73, 0x3F, 0x14, 0xB6, 0x84, 0x03, 0x90, 0x38, 0x36, 0xA7, 0x83, 0x6C, 0x20, 0x05, 0x90, 0x05,
+			0x12, 0xEB, 0xC0, 0xD0, 0xD7, 0xA1, 0xB0, 0xA7, 0xEA, 0x27, 0x70, 0x80, 0xD5, 0x52, 0xD6, 0x31, 0x09, 0xA1, 0xC9, 0xE0,
+			0xF6, 0x82, 0x77, 0x6B, 0x9A, 0xC4, 0x4E, 0x31, 0xE8, 0x9E, 0xD8, 0xAE, 0xF1, 0xAE, 0xB6, 0xB7, 0xB7, 0x31, 0x07, 0xA6,
+			0x95, 0x23, 0x63, 0xC3, 0xB9, 0x66, 0xE9, 0x04, 0xDF, 0x10, 0x07, 0xBD, 0xD7, 0xEB, 0xED, 0xEF, 0xEF, 0x4B, 0xC7, 0x08,
+			0xA2, 0x42, 0xC9, 0x4F, 0x9F, 0xCE, 0xE4, 0x04, 0x76, 0x07, 0xE3, 0x62, 0x7A, 0x05, 0xC6, 0x53, 0x90, 0x47, 0xC5, 0x56,
+			0x64, 0xE8, 0x87, 0x3C, 0xF8, 0xF8, 0xF8, 0x18, 0x37, 0xA1, 0x7E, 0xBF, 0x0F, 0x85, 0x39, 0x97, 0xCB, 0xE1, 0x18, 0xEA,
+			0x02, 0xBA, 0xDD, 0x61, 0x21, 0xF8, 0x89, 0x44, 0x42, 0xBC, 0xBD, 0x55, 0x97, 0x7F, 0xA9, 0x6C, 0x34, 0xE0, 0x95, 0x0B,
+			0xBC, 0xF8, 0xE0, 0xD4, 0x62, 0x38, 0x92, 0x83, 0xFA, 0xE9, 0x41, 0x89, 0xE3, 0xCF, 0x3F, 0x53, 0x95, 0x68, 0x47, 0x87,
+			0x34, 0x9C, 0xEE, 0xDF, 0xC4, 0x3E, 0xE4, 0x33, 0x01, 0x90, 0xCA, 0xAF, 0x8B, 0x2D, 0x7F, 0x44, 0xFF, 0x28, 0x16, 0x8B,
+			0xB8, 0x3E, 0x48, 0x4D, 0x0D, 0x02, 0x0E, 0x06, 0x82, 0xAE, 0x1B, 0xD2, 0x0B, 0x84, 0x10, 0x42, 0x33, 0x29, 0x5C, 0x00,
+			0x34, 0xCD, 0x2F, 0x35, 0x35, 0x2B, 0x65, 0xB8, 0x36, 0xC0, 0x26, 0xF4, 0x9D, 0x44, 0x5B, 0xC8, 0xB8, 0xA0, 0xA2, 0xAC,
+			0x62, 0x4E, 0x24, 0x12, 0x70, 0x31, 0xB1, 0x7A, 0x85, 0x5B, 0x02, 0xD1, 0xA8, 0x5C, 0x2E, 0xCB, 0x0C, 0x99, 0xDA, 0x82,
+			0x2A, 0x92, 0x37, 0x4F, 0x3F, 0x9E, 0x4A, 0xA5, 0x4A, 0xA5, 0xD2, 0x73, 0xC1, 0x15, 0x8C, 0x60, 0xA9, 0x40, 0x81, 0x65,
+			0x5E, 0x4F, 0xBF, 0x89, 0x20, 0xE8, 0x5A, 0xFC, 0x06, 0xA8, 0x1D, 0x10, 0x16, 0x93, 0x30, 0x70, 0x79, 0x8E, 0x1C, 0x94,
+			0x76, 0xB8, 0xCB, 0x10, 0x81, 0x04, 0x48, 0x9E, 0x9C, 0x9C, 0xAC, 0xAF, 0xAF, 0xC3, 0xAC, 0xE7, 0xE3, 0x68, 0x5D, 0xDF,
+			0x23, 0xB1, 0x0B, 0x14, 0x4C, 0x5A, 0x96, 0x99, 0xCD, 0x66, 0xF7, 0xF6, 0xCC, 0x5A, 0x52, 0xB7, 0x12, 0xE4, 0x10, 0x8D,
+			0x24, 0xC3, 0xC9, 0xF3, 0xC1, 0xED, 0xAC, 0xE5, 0xD0, 0x48, 0x8C, 0x88, 0x2F, 0x7F, 0x0E, 0xCD, 0x96, 0xE4, 0x22, 0x00,
+			0xEC, 0x43, 0xDD, 0x00, 0x98, 0xA0, 0x9D, 0x91, 0x12, 0x76, 0x68, 0xD8, 0xFB, 0xA1, 0x75, 0x5D, 0xCC, 0x3C, 0x00, 0x8B,
+			0x88, 0x50, 0x6A, 0x6D, 0x05, 0x26, 0x50, 0xBA, 0x92, 0x95, 0x81, 0x44, 0xF8, 0xBC, 0x8C, 0x1C, 0x56, 0x65, 0x68, 0x3A,
+			0x42, 0xBC, 0x01, 0x5D, 0x46, 0xFA, 0x00, 0x0E, 0xB3, 0xE5, 0x36, 0xF7, 0x66, 0xF4, 0x04, 0x48, 0x0F, 0x42, 0x2F, 0x99,
+			0x94, 0x80, 0x22, 0x40, 0xFA, 0xAA, 0x56, 0xAB, 0x74, 0x9F, 0xCC, 0x05, 0xD9, 0x44, 0xA4, 0x6F, 0xDC, 0x44, 0x4F, 0x6E,
+			0x74, 0xCF, 0xFD, 0x35, 0x26, 0x9F, 0x9B, 0xD0, 0x1E, 0x1F, 0x1F, 0xB3, 0xA0, 0x90, 0xC1, 0xBE, 0x00, 0x2B, 0x86, 0x43,
+			0x00, 0x12, 0xAC, 0x74, 0x15, 0x5F, 0x0E, 0xEC, 0xE1, 0xF4, 0xF4, 0x94, 0x05, 0x03, 0x54, 0x3C, 0x68, 0xF5, 0x6B, 0xB5,
+			0x9A, 0x98, 0x87, 0x80, 0x16, 0x40, 0x94, 0x12, 0x09, 0x71, 0xAF, 0x31, 0x8B, 0x06, 0x39, 0x04, 0x27, 0x50, 0x43, 0x00,
+			0xAC, 0x07, 0x2A, 0x0E, 0x1F, 0x84, 0xA2, 0x81, 0x1D, 0xEE, 0x00, 0xEF, 0x36, 0x5D, 0x5C, 0x16, 0xA9, 0x18, 0xCF, 0xB1,
+			0xA0, 0x14, 0x44, 0xBD, 0x58, 0x2C, 0xAE, 0xAF, 0xAF, 0x0B, 0x7C, 0x43, 0x72, 0xCC, 0x49, 0x20, 0x35, 0xAA, 0x57, 0xB3,
+			0xEF, 0x97, 0xE8, 0xC0, 0x18, 0x13, 0x6C, 0x14, 0xA7, 0x08, 0x8A, 0x38, 0xF4, 0xF4, 0xB4, 0x7B, 0x66, 0x1E, 0x79, 0x72,
+			0x92, 0x74, 0x4F, 0x9C, 0x99, 0x38, 0x6D, 0x29, 0x0F, 0x17, 0xDF, 0x2E, 0x10, 0x00, 0x84, 0x54, 0xD4, 0xAA, 0xB0, 0x1D,
+			0x44, 0x10, 0xE2, 0xDA, 0x8B, 0xA2, 0xC4, 0x00, 0x04, 0x31, 0xCE, 0xE9, 0xA9, 0xB9, 0xA2, 0x93, 0xE7, 0xA9, 0x91, 0x81,
+			0xCE, 0x69, 0x61, 0x40, 0x93, 0xD9, 0xAB, 0x49, 0xC8, 0x4B, 0xA7, 0xD3, 0x96, 0x15, 0x8A, 0x5B, 0x6F, 0xBD, 0xF5, 0x96,
+			0xEC, 0xA3, 0xE5, 0x9C, 0xA3, 0x79, 0x97, 0x0E, 0xA5, 0x2A, 0x17, 0x0E, 0xF7, 0x62, 0x27, 0xBB, 0x7B, 0x46, 0x57, 0xAF,
+			0x5E, 0x15, 0xC1, 0x59, 0x0B, 0x5E, 0xE4, 0x86, 0xB4, 0x4A, 0x95, 0x11, 0x69, 0x5A, 0xF7, 0xC7, 0x1E, 0x62, 0x2C, 0x76,
+			0xE5, 0xCA, 0x15, 0xA6, 0x20, 0xAB, 0x6A, 0x29, 0x71, 0x7B, 0xC4, 0xC3, 0x13, 0xDA, 0xF0, 0xAB, 0x5F, 0xFD, 0x4A, 0xB5,
+			0xB6, 0x03, 0xD3, 0x63, 0x89, 0x44, 0xCC, 0x8B, 0x45, 0x7C, 0x7F, 0x01, 0x05, 0x8C, 0xC3, 0x88, 0xBC, 0x54, 0xFB, 0xC1,
+			0xD4, 0x8A, 0xFA, 0xA8, 0x17, 0x86, 0x0B, 0x62, 0x95, 0x63, 0x4F, 0x78, 0xF3, 0xB9, 0x1F, 0x89, 0x44, 0x23, 0xF6, 0xDA,
+			0x36, 0x09, 0x98, 0xCD, 0x26, 0x33, 0xF3, 0x8E, 0x0E, 0x27, 0x13, 0x83, 0xEF, 0xC6, 0x83, 0xB1, 0xEF, 0x2D, 0xF8, 0x21,
+			0xB3, 0x89, 0x51, 0x92, 0x4F, 0x7A, 0xA3, 0x78, 0x31, 0x15, 0x99, 0x4E, 0x26, 0xA3, 0xC9, 0x3C, 0x6A, 0x43, 0x7E, 0x3F,
+			0x6E, 0x50, 0xE3, 0x92, 0x2A, 0x60, 0xF9, 0x62, 0x69, 0x25, 0x1F, 0x99, 0xCD, 0xEC, 0xAD, 0xCE, 0x26, 0xB3, 0x71, 0x38,
+			0x1F, 0x8D, 0xEC, 0x66, 0x0E, 0xC6, 0xA6, 0x64, 0x09, 0xC3, 0x68, 0x10, 0x4C, 0x43, 0xCF, 0x92, 0x87, 0x37, 0xB1, 0xF1,
+			0xE6, 0xD1, 0x07, 0x2F, 0x9B, 0xF7, 0xEA, 0x92, 0xA6, 0xD5, 0x39, 0x08, 0x35, 0x30, 0xD4, 0xE9, 0x32, 0x97, 0xF3, 0x83,
+			0xC5, 0xD3, 0x1B, 0xB8, 0x1B, 0xD2, 0xAD, 0x2F, 0xD6, 0x4B, 0x25, 0x12, 0x89, 0xBD, 0x77, 0x9F, 0xE3, 0x5E, 0xD9, 0x5B,
+			0x75, 0x27, 0x55, 0x3A, 0xB8, 0x54, 0x2A, 0x55, 0x5F, 0x32, 0xDF, 0x35, 0x91, 0x6E, 0x2C, 0xB7, 0x13, 0x0F, 0x8F, 0x16,
+			0x43, 0x8B, 0x83, 0xF7, 0x5E, 0x68, 0xB9, 0xE4, 0x64, 0x2C, 0xE4, 0xDD, 0xC5, 0xB7, 0xB6, 0xBA, 0xDB, 0x60, 0xB9, 0x50,
+			0xDE, 0x80, 0xBB, 0x7E, 0xD3, 0x10, 0x0F, 0x52, 0xDD, 0x1F, 0x6E, 0x46, 0xDE, 0x79, 0xE7, 0xBD, 0x77, 0xDF, 0x7D, 0x7F,
+			0x36, 0x9B, 0xDD, 0xE4, 0x6D, 0xDE, 0x88, 0xFC, 0x8C, 0x5C, 0x2B, 0xFA, 0x40, 0x3A, 0x1A, 0xBB, 0xF2, 0xA9, 0x1F, 0x6F,
+			0xDF, 0xA8, 0x64, 0x46, 0xF1, 0x9F, 0x79, 0x6F, 0x83, 0xAA, 0xAB, 0x3E, 0x20, 0x84, 0xBD, 0xFD, 0xF6, 0x3B, 0x8E, 0xCD,
+			0xF5, 0x9B, 0x68, 0x34, 0xFA, 0xCE, 0x3B, 0xEF, 0xC9, 0xC9, 0x43, 0x33, 0x06, 0x07, 0x8B, 0xFF, 0x52, 0x86, 0xFB, 0x7A,
+			0x05, 0xCD, 0x42, 0x7F, 0xF1, 0x8B, 0x5F, 0x83, 0xCF, 0x60, 0x4C, 0xA2, 0xB5, 0xF2, 0xBC, 0xED, 0xF9, 0x7C, 0xFE, 0x5A,
+			0xEA, 0x9F, 0xAB, 0xBF, 0x30, 0x65, 0xA2, 0xC9, 0x5C, 0xEF, 0x1A, 0xAE, 0xFD, 0x78, 0xA9, 0xE5, 0x49, 0x59, 0x8E, 0x21,
+			0x74, 0x72, 0xEA, 0xC4, 0xF3, 0x11, 0xAB, 0xD8, 0xFD, 0xF6, 0x85, 0x23, 0x08, 0x79, 0x54, 0x53, 0x1F, 0xF1, 0x08, 0x64,
+			0xF7, 0xA6, 0x37, 0x26, 0xF5, 0x16, 0x93, 0x30, 0xB8, 0xED, 0xE2, 0xE6, 0x8B, 0x20, 0xA4, 0x0E, 0x43, 0x8B, 0xAC, 0xC5,
+			0x46, 0x17, 0x89, 0x5E, 0x80, 0x92, 0x7E, 0x23, 0x2F, 0x8E, 0xD2, 0x1B, 0xDA, 0x98, 0x48, 0x01, 0x50, 0x36, 0x68, 0xE8,
+			0xD9, 0x6F, 0x45, 0x85, 0x41, 0xC1, 0x3E, 0xEB, 0xFE, 0x8E, 0x99, 0x46, 0x38, 0x63, 0x75, 0xA0, 0x56, 0x04, 0xC2, 0x41,
+			0x57, 0x17, 0x8B, 0x18, 0x1E, 0xC8, 0x81, 0x85, 0xDE, 0x64, 0x50, 0x26, 0xA8, 0xEC, 0xB0, 0x03, 0x13, 0x07, 0xBF, 0x0D,
+			0xC2, 0xA0, 0xB0, 0x66, 0x1B, 0x87, 0xE8, 0x2A, 0xE4, 0x33, 0x2F, 0x07, 0x17, 0x42, 0x13, 0x80, 0x36, 0xA2, 0x2D, 0xA5,
+			0x28, 0xC4, 0x2E, 0x84, 0x69, 0x8A, 0x66, 0x4D, 0x34, 0x65, 0x0A, 0x00, 0x30, 0x6E, 0xF8, 0x66, 0x10, 0xA0, 0x0E, 0x51,
+			0xF7, 0x4C, 0xD1, 0xCC, 0xB6, 0x32, 0xB8, 0x9B, 0xD0, 0x52, 0xB0, 0x73, 0x80, 0x8F, 0x00, 0x9A, 0x0F, 0x2E, 0x0A, 0x70,
+			0x87, 0x5E, 0x04, 0x24, 0x9F, 0x76, 0x0A, 0xCE, 0x9B, 0xD6, 0xCD, 0xC3, 0x20, 0xC0, 0x62, 0x0B, 0x32, 0x05, 0xB4, 0xB7,
+			0x20, 0x08, 0x66, 0xB3, 0xD9, 0xFF, 0x1B, 0x00, 0x58, 0xC9, 0x31, 0x57, 0xB1, 0xD9, 0xF8, 0x48, 0x00, 0x00, 0x00, 0x00,
+			0x49, 0x45, 0x4E, 0x44, 0xAE, 0x42, 0x60, 0x82,
+		},
+	}
+)
@@ -0,0 +1,93 @@
+package wolfenstein
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+// encodeAtlas encodes a width x height PNG whose pixels hold their own
+// coordinates, (x, y) being color {x, y, 0, 0xff}.
+func encodeAtlas(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetRGBA(x, y, color.RGBA{uint8(x), uint8(y), 0, 0xff})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png.Encode: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestNewTextureAtlasErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		tileSize int
+		err      string
+	}{
+		{"zero tile size", encodeAtlas(t, 8, 8), 0, "invalid tile size 0"},
+		{"negative tile size", encodeAtlas(t, 8, 8), -4, "invalid tile size -4"},
+		{"not a png", []byte("not a png"), 4, "texture atlas: png"},
+		{"width not a multiple", encodeAtlas(t, 10, 8), 4, "size 10x8 is not a multiple of tile size 4"},
+		{"height not a multiple", encodeAtlas(t, 8, 6), 4, "size 8x6 is not a multiple of tile size 4"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewTextureAtlas(test.data, test.tileSize)
+			if err == nil {
+				t.Fatalf("expected an error containing %q", test.err)
+			}
+
+			if !strings.Contains(err.Error(), test.err) {
+				t.Errorf("error %q does not contain %q", err, test.err)
+			}
+		})
+	}
+}
+
+func TestTextureAtlasTexel(t *testing.T) {
+	// 3 columns and 2 rows of 4x4 textures
+	atlas, err := NewTextureAtlas(encodeAtlas(t, 12, 8), 4)
+	if err != nil {
+		t.Fatalf("NewTextureAtlas: %v", err)
+	}
+
+	if atlas.Count() != 6 || atlas.TileSize() != 4 {
+		t.Fatalf("%d textures of %d pixels, expected 6 of 4", atlas.Count(), atlas.TileSize())
+	}
+
+	tests := []struct {
+		name       string
+		tile, x, y int
+		atX, atY   uint8 // where the texel is in the atlas
+	}{
+		{"first texture", 0, 1, 2, 1, 2},
+		{"same row", 2, 3, 0, 11, 0},
+		{"second row", 4, 0, 3, 4, 7},
+		{"past the right edge", 1, 5, 1, 5, 1},
+		{"past the bottom edge", 3, 2, 9, 2, 5},
+		{"negative x", 0, -1, 0, 3, 0},
+		{"negative y", 5, 0, -6, 8, 6},
+		{"whole tiles before", 2, -8, -4, 8, 0},
+	}
+
+	for _, test := range tests {
+		expected := color.RGBA{test.atX, test.atY, 0, 0xff}
+
+		if texel := atlas.Texel(test.tile, test.x, test.y); texel != expected {
+			t.Errorf("%s: texel (%d, %d) of texture %d is %v, expected %v", test.name, test.x, test.y, test.tile, texel, expected)
+		}
+	}
+}